@@ -1,6 +1,12 @@
 package app
 
 import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -9,6 +15,21 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	channelkeeper "github.com/cosmos/ibc-go/v2/modules/core/04-channel/keeper"
 	ibcante "github.com/cosmos/ibc-go/v2/modules/core/ante"
+
+	gravitytypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+const (
+	// OrchestratorFeeExemptionTStoreKey names the transient store used to count fee exempted orchestrator txs
+	OrchestratorFeeExemptionTStoreKey = "transient_orchestrator_fee_exemption"
+	// DefaultMaxFeeExemptTxsPerBlock bounds the number of fee exempted orchestrator txs the mempool accepts per block
+	DefaultMaxFeeExemptTxsPerBlock = 1000
+	// DefaultMaxFeeExemptTxsPerOrchestrator bounds the number of fee exempted txs a single orchestrator may submit per block
+	DefaultMaxFeeExemptTxsPerOrchestrator = 10
+	// DefaultMaxFeeExemptTxGas bounds the gas limit of fee exempted orchestrator txs
+	DefaultMaxFeeExemptTxGas = 1000000
+	// PendingClaimsTStoreKey names the transient store used to track the event nonces of claims already in the mempool
+	PendingClaimsTStoreKey = "transient_gravity_pending_claims"
 )
 
 // Constructs a new sdk.AnteHandler for the Gravity app.
@@ -18,23 +39,44 @@ import (
 // with additional AnteDecorators. This complicated process is desirable because:
 //   1. the default sdk AnteHandler can change on any upgrade (so we do not want to have a stale list of AnteDecorators),
 //   2. it is not possible to modify an AnteHandler once it is constructed
-func newAnteHandler(options ante.HandlerOptions, ibcChannelKeeper channelkeeper.Keeper, cdc codec.BinaryCodec) (*sdk.AnteHandler, error) {
+func newAnteHandler(
+	options ante.HandlerOptions,
+	ibcChannelKeeper channelkeeper.Keeper,
+	orchestratorKeeper OrchestratorKeeper,
+	feeExemptionKey sdk.StoreKey,
+	feeExemptionConfig FeeExemptionConfig,
+	claimKeeper ClaimKeeper,
+	pendingClaimsKey sdk.StoreKey,
+	wasmConfig wasmtypes.WasmConfig,
+	txCounterKey sdk.StoreKey,
+	cdc codec.BinaryCodec,
+) (*sdk.AnteHandler, error) {
 	// Call the default sdk antehandler constructor to avoid auditing our changes in the future
 	baseAnteHandler, err := ante.NewAnteHandler(options)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Unable to create baseAnteHanlder")
 	}
 
+	// The fee exemption must run before the default sdk AnteHandler, it lowers the minimum gas prices checked there
+	feeExemptionDecorator := NewOrchestratorFeeExemptionDecorator(orchestratorKeeper, feeExemptionKey, feeExemptionConfig)
+	feeExemptionHandler := sdk.ChainAnteDecorators(feeExemptionDecorator)
+
 	// Create additional AnteDecorators to chain together
 	ibcAnteDecorator := ibcante.NewAnteDecorator(ibcChannelKeeper)
 	minCommissionDecorator := NewMinCommissionDecorator(cdc)
+	staleClaimDecorator := NewStaleClaimDecorator(claimKeeper, pendingClaimsKey, cdc)
+	// The wasm decorators must run after the default sdk AnteHandler has set up the gas meter
+	simulationGasLimitDecorator := NewSimulationGasLimitDecorator(wasmConfig.SimulationGasLimit)
+	txCounterDecorator := wasmkeeper.NewCountTXDecorator(txCounterKey)
 
-	addlDecorators := []sdk.AnteDecorator{ibcAnteDecorator, minCommissionDecorator}
+	addlDecorators := []sdk.AnteDecorator{
+		simulationGasLimitDecorator, txCounterDecorator, ibcAnteDecorator, minCommissionDecorator, staleClaimDecorator,
+	}
 	// Chain together and terminate the input decorators array
 	customHandler := sdk.ChainAnteDecorators(addlDecorators...)
 
 	// Create and return a function which ties the two handlers together
-	fullHandler := chainHandlers(baseAnteHandler, customHandler)
+	fullHandler := chainHandlers(chainHandlers(feeExemptionHandler, baseAnteHandler), customHandler)
 	return &fullHandler, nil
 }
 
@@ -130,3 +172,291 @@ func (min MinCommissionDecorator) AnteHandle(
 
 	return next(ctx, tx, simulate)
 }
+
+// SimulationGasLimitDecorator bounds the gas a simulated tx may consume, so that a simulation cannot keep a node busy
+// executing a contract indefinitely. The limit is the configured wasm simulation gas limit, or the max block gas if
+// none is configured. This is the wasmd LimitSimulationGasDecorator, except that the gas already consumed by the
+// default sdk AnteHandler is carried over to the limited gas meter, since it runs after the default AnteHandler here
+type SimulationGasLimitDecorator struct {
+	gasLimit *sdk.Gas
+}
+
+func NewSimulationGasLimitDecorator(gasLimit *sdk.Gas) SimulationGasLimitDecorator {
+	if gasLimit != nil && *gasLimit == 0 {
+		panic("simulation gas limit must not be zero")
+	}
+	return SimulationGasLimitDecorator{gasLimit}
+}
+
+func (d SimulationGasLimitDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx,
+	simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	// Contracts are not executed in CheckTx, and tendermint bounds the gas of delivered txs by the max block gas
+	if !simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	var limit sdk.Gas
+	if d.gasLimit != nil {
+		limit = *d.gasLimit
+	} else if maxGas := ctx.ConsensusParams().GetBlock().GetMaxGas(); maxGas > 0 {
+		limit = sdk.Gas(maxGas)
+	} else {
+		return next(ctx, tx, simulate)
+	}
+
+	gasMeter := sdk.NewGasMeter(limit)
+	gasMeter.ConsumeGas(ctx.GasMeter().GasConsumed(), "ante handler")
+	return next(ctx.WithGasMeter(gasMeter), tx, simulate)
+}
+
+// OrchestratorKeeper is the subset of the gravity keeper used to identify orchestrators
+type OrchestratorKeeper interface {
+	GetOrchestratorValidator(ctx sdk.Context, orch sdk.AccAddress) (validator stakingtypes.Validator, found bool)
+}
+
+// OrchestratorFeeExemptionDecorator waives the mempool minimum gas prices for txs which consist only of gravity
+// confirms and claims signed by the orchestrators of bonded validators. These messages are part of the normal duties
+// of a validator, so we do not want orchestrators to pay for them. To prevent a compromised orchestrator from spamming
+// the mempool for free the number of exempted txs is bounded per block and per orchestrator, txs over the quota
+// are charged the usual fees. Txs within the quota must not exceed the configured gas limit, otherwise a single
+// exempted tx could still take up a large share of a block, these are rejected.
+// NOTE: Minimum gas prices are only enforced by CheckTx, so this decorator has no effect on DeliverTx
+type OrchestratorFeeExemptionDecorator struct {
+	orchestratorKeeper OrchestratorKeeper
+	storeKey           sdk.StoreKey
+	config             FeeExemptionConfig
+}
+
+func NewOrchestratorFeeExemptionDecorator(
+	orchestratorKeeper OrchestratorKeeper,
+	storeKey sdk.StoreKey,
+	config FeeExemptionConfig,
+) OrchestratorFeeExemptionDecorator {
+	return OrchestratorFeeExemptionDecorator{orchestratorKeeper, storeKey, config}
+}
+
+// blockExemptionsKey holds the number of txs exempted in the current block, orchestrator counters are keyed by address
+var blockExemptionsKey = []byte{0x00}
+
+func (d OrchestratorFeeExemptionDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx,
+	simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+	orchestrators, ok := d.exemptOrchestrators(ctx, tx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	// The transient store is reset on every commit, making these per block counters
+	store := ctx.TransientStore(d.storeKey)
+	if getCount(store.Get(blockExemptionsKey)) >= d.config.MaxTxsPerBlock {
+		return next(ctx, tx, simulate)
+	}
+	for _, orch := range orchestrators {
+		if getCount(store.Get(orch)) >= d.config.MaxTxsPerOrchestrator {
+			return next(ctx, tx, simulate)
+		}
+	}
+	if feeTx.GetGas() > d.config.MaxTxGas {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "fee exempt orchestrator tx gas limit %d exceeds %d", feeTx.GetGas(), d.config.MaxTxGas,
+		)
+	}
+
+	store.Set(blockExemptionsKey, sdk.Uint64ToBigEndian(getCount(store.Get(blockExemptionsKey))+1))
+	for _, orch := range orchestrators {
+		store.Set(orch, sdk.Uint64ToBigEndian(getCount(store.Get(orch))+1))
+	}
+
+	return next(ctx.WithMinGasPrices(sdk.DecCoins{}), tx, simulate)
+}
+
+// exemptOrchestrators returns the distinct signers of tx if every message is an orchestrator confirm or claim and
+// every signer is the orchestrator of a bonded validator
+func (d OrchestratorFeeExemptionDecorator) exemptOrchestrators(ctx sdk.Context, tx sdk.Tx) ([]sdk.AccAddress, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, false
+	}
+
+	var orchestrators []sdk.AccAddress
+	seen := make(map[string]bool)
+	for _, m := range msgs {
+		if !isOrchestratorMsg(m) {
+			return nil, false
+		}
+		for _, signer := range m.GetSigners() {
+			if seen[signer.String()] {
+				continue
+			}
+			validator, found := d.orchestratorKeeper.GetOrchestratorValidator(ctx, signer)
+			if !found || !validator.IsBonded() {
+				return nil, false
+			}
+			seen[signer.String()] = true
+			orchestrators = append(orchestrators, signer)
+		}
+	}
+
+	return orchestrators, true
+}
+
+// isOrchestratorMsg returns true for the messages orchestrators submit as part of their regular duties
+func isOrchestratorMsg(m sdk.Msg) bool {
+	switch m.(type) {
+	case *gravitytypes.MsgValsetConfirm,
+		*gravitytypes.MsgConfirmBatch,
+		*gravitytypes.MsgConfirmLogicCall,
+		*gravitytypes.MsgSendToCosmosClaim,
+		*gravitytypes.MsgBatchSendToEthClaim,
+		*gravitytypes.MsgERC20DeployedClaim,
+		*gravitytypes.MsgLogicCallExecutedClaim,
+		*gravitytypes.MsgValsetUpdatedClaim:
+		return true
+	default:
+		return false
+	}
+}
+
+func getCount(bz []byte) uint64 {
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// ClaimKeeper is the subset of the gravity keeper used to check claims and confirms before they enter the mempool
+type ClaimKeeper interface {
+	OrchestratorKeeper
+	GetLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress) uint64
+	GetValsetConfirm(ctx sdk.Context, nonce uint64, validator sdk.AccAddress) *gravitytypes.MsgValsetConfirm
+	GetBatchConfirm(ctx sdk.Context, nonce uint64, tokenContract gravitytypes.EthAddress, validator sdk.AccAddress) *gravitytypes.MsgConfirmBatch
+	GetLogicCallConfirm(ctx sdk.Context, invalidationId []byte, invalidationNonce uint64, val sdk.AccAddress) *gravitytypes.MsgConfirmLogicCall
+}
+
+// StaleClaimDecorator rejects gravity claims and confirms in CheckTx and ReCheckTx which are certain to fail
+// once delivered: claims whose event nonce does not directly follow the last one submitted by the validator,
+// confirms the orchestrator has already submitted, and either of them from orchestrators which are not registered.
+// Otherwise misbehaving orchestrators pay for and fill blocks with messages that can only fail.
+// Claims already accepted into the mempool are not yet reflected in the last event nonce of the validator, so the
+// nonces accepted since the last commit are tracked in a transient store, allowing an orchestrator to submit
+// consecutive claims in separate txs within a single block.
+type StaleClaimDecorator struct {
+	claimKeeper ClaimKeeper
+	storeKey    sdk.StoreKey
+	cdc         codec.BinaryCodec
+}
+
+func NewStaleClaimDecorator(claimKeeper ClaimKeeper, storeKey sdk.StoreKey, cdc codec.BinaryCodec) StaleClaimDecorator {
+	return StaleClaimDecorator{claimKeeper, storeKey, cdc}
+}
+
+func (d StaleClaimDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx,
+	simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	// ReCheckTx contexts are also CheckTx contexts
+	if !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, m := range tx.GetMsgs() {
+		if execMsg, ok := m.(*authz.MsgExec); ok {
+			for _, v := range execMsg.Msgs {
+				var innerMsg sdk.Msg
+				if err := d.cdc.UnpackAny(v, &innerMsg); err != nil {
+					return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+				}
+				if err := d.checkMsg(ctx, innerMsg); err != nil {
+					return ctx, err
+				}
+			}
+			continue
+		}
+
+		if err := d.checkMsg(ctx, m); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// checkMsg returns an error if m is a gravity claim or confirm which would be rejected by the msg server
+func (d StaleClaimDecorator) checkMsg(ctx sdk.Context, m sdk.Msg) error {
+	switch msg := m.(type) {
+	case *gravitytypes.MsgValsetConfirm:
+		orch, err := d.registeredOrchestrator(ctx, msg.Orchestrator)
+		if err != nil {
+			return err
+		}
+		if d.claimKeeper.GetValsetConfirm(ctx, msg.Nonce, orch) != nil {
+			return sdkerrors.Wrap(gravitytypes.ErrDuplicate, "signature duplicate")
+		}
+	case *gravitytypes.MsgConfirmBatch:
+		orch, err := d.registeredOrchestrator(ctx, msg.Orchestrator)
+		if err != nil {
+			return err
+		}
+		contract, err := gravitytypes.NewEthAddress(msg.TokenContract)
+		if err != nil {
+			return sdkerrors.Wrap(gravitytypes.ErrInvalid, "eth address invalid")
+		}
+		if d.claimKeeper.GetBatchConfirm(ctx, msg.Nonce, *contract, orch) != nil {
+			return sdkerrors.Wrap(gravitytypes.ErrDuplicate, "duplicate signature")
+		}
+	case *gravitytypes.MsgConfirmLogicCall:
+		orch, err := d.registeredOrchestrator(ctx, msg.Orchestrator)
+		if err != nil {
+			return err
+		}
+		invalidationId, err := hex.DecodeString(msg.InvalidationId)
+		if err != nil {
+			return sdkerrors.Wrap(gravitytypes.ErrInvalid, "invalidation id encoding")
+		}
+		if d.claimKeeper.GetLogicCallConfirm(ctx, invalidationId, msg.InvalidationNonce, orch) != nil {
+			return sdkerrors.Wrap(gravitytypes.ErrDuplicate, "duplicate signature")
+		}
+	case gravitytypes.EthereumClaim:
+		// GetClaimer panics on invalid claims, msgs wrapped by authz have not been validated yet
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		val, found := d.claimKeeper.GetOrchestratorValidator(ctx, msg.GetClaimer())
+		if !found {
+			return sdkerrors.Wrap(gravitytypes.ErrUnknown, "validator")
+		}
+		valAddr := val.GetOperator()
+
+		lastEventNonce := d.claimKeeper.GetLastEventNonceByValidator(ctx, valAddr)
+		store := ctx.TransientStore(d.storeKey)
+		if pending := getCount(store.Get(valAddr)); pending > lastEventNonce {
+			lastEventNonce = pending
+		}
+		if msg.GetEventNonce() != lastEventNonce+1 {
+			return fmt.Errorf(gravitytypes.ErrNonContiguousEventNonce.Error(), lastEventNonce+1, msg.GetEventNonce())
+		}
+		store.Set(valAddr, sdk.Uint64ToBigEndian(msg.GetEventNonce()))
+	}
+
+	return nil
+}
+
+// registeredOrchestrator parses orchestrator and checks that it is the orchestrator of a validator
+func (d StaleClaimDecorator) registeredOrchestrator(ctx sdk.Context, orchestrator string) (sdk.AccAddress, error) {
+	orch, err := sdk.AccAddressFromBech32(orchestrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(gravitytypes.ErrInvalid, "acc address invalid")
+	}
+	if _, found := d.claimKeeper.GetOrchestratorValidator(ctx, orch); !found {
+		return nil, sdkerrors.Wrap(gravitytypes.ErrUnknown, "validator")
+	}
+	return orch, nil
+}
@@ -0,0 +1,215 @@
+package app
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	gravitytypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+type mockOrchestratorKeeper map[string]stakingtypes.Validator
+
+func (m mockOrchestratorKeeper) GetOrchestratorValidator(_ sdk.Context, orch sdk.AccAddress) (stakingtypes.Validator, bool) {
+	val, found := m[orch.String()]
+	return val, found
+}
+
+type mockClaimKeeper struct {
+	mockOrchestratorKeeper
+	lastEventNonces map[string]uint64
+	valsetConfirms  map[string]bool
+}
+
+func (m mockClaimKeeper) GetLastEventNonceByValidator(_ sdk.Context, validator sdk.ValAddress) uint64 {
+	return m.lastEventNonces[validator.String()]
+}
+
+func (m mockClaimKeeper) GetValsetConfirm(_ sdk.Context, nonce uint64, validator sdk.AccAddress) *gravitytypes.MsgValsetConfirm {
+	if !m.valsetConfirms[fmt.Sprintf("%d/%s", nonce, validator)] {
+		return nil
+	}
+	return &gravitytypes.MsgValsetConfirm{Nonce: nonce, Orchestrator: validator.String()}
+}
+
+func (m mockClaimKeeper) GetBatchConfirm(sdk.Context, uint64, gravitytypes.EthAddress, sdk.AccAddress) *gravitytypes.MsgConfirmBatch {
+	return nil
+}
+
+func (m mockClaimKeeper) GetLogicCallConfirm(sdk.Context, []byte, uint64, sdk.AccAddress) *gravitytypes.MsgConfirmLogicCall {
+	return nil
+}
+
+type mockTx []sdk.Msg
+
+func (tx mockTx) GetMsgs() []sdk.Msg         { return tx }
+func (tx mockTx) ValidateBasic() error       { return nil }
+func (tx mockTx) GetGas() uint64             { return 200000 }
+func (tx mockTx) GetFee() sdk.Coins          { return nil }
+func (tx mockTx) FeePayer() sdk.AccAddress   { return nil }
+func (tx mockTx) FeeGranter() sdk.AccAddress { return nil }
+
+// mockGasTx is a mockTx with the given gas limit
+type mockGasTx struct {
+	mockTx
+	gas uint64
+}
+
+func (tx mockGasTx) GetGas() uint64 { return tx.gas }
+
+func newTransientTestContext(t *testing.T, key *sdk.TransientStoreKey) (sdk.Context, sdk.CommitMultiStore) {
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, sdk.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	return sdk.NewContext(ms, tmproto.Header{}, true, log.NewNopLogger()), ms
+}
+
+// nolint: exhaustivestruct
+func TestOrchestratorFeeExemptionDecorator(t *testing.T) {
+	var (
+		bondedOrch   = sdk.AccAddress([]byte("bonded_orchestrator_"))
+		unbondedOrch = sdk.AccAddress([]byte("unbonded_orchestrat_"))
+		stranger     = sdk.AccAddress([]byte("not_an_orchestrator_"))
+		minGasPrices = sdk.NewDecCoins(sdk.NewDecCoin("stake", sdk.OneInt()))
+	)
+	orchKeeper := mockOrchestratorKeeper{
+		bondedOrch.String():   stakingtypes.Validator{Status: stakingtypes.Bonded},
+		unbondedOrch.String(): stakingtypes.Validator{Status: stakingtypes.Unbonded},
+	}
+	confirm := func(orch sdk.AccAddress) sdk.Msg {
+		return &gravitytypes.MsgValsetConfirm{Orchestrator: orch.String()}
+	}
+
+	tkey := sdk.NewTransientStoreKey(OrchestratorFeeExemptionTStoreKey)
+	ctx, ms := newTransientTestContext(t, tkey)
+	ctx = ctx.WithMinGasPrices(minGasPrices)
+
+	decorator := NewOrchestratorFeeExemptionDecorator(orchKeeper, tkey, FeeExemptionConfig{
+		MaxTxsPerBlock:        3,
+		MaxTxsPerOrchestrator: 2,
+		MaxTxGas:              500000,
+	})
+	var exempt bool
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		exempt = ctx.MinGasPrices().IsZero()
+		return ctx, nil
+	}
+	run := func(ctx sdk.Context, tx sdk.Tx, simulate bool) bool {
+		_, err := decorator.AnteHandle(ctx, tx, simulate, next)
+		require.NoError(t, err)
+		return exempt
+	}
+
+	// only txs made entirely of orchestrator messages from bonded orchestrators are exempt
+	require.False(t, run(ctx, mockTx{confirm(stranger)}, false))
+	require.False(t, run(ctx, mockTx{confirm(unbondedOrch)}, false))
+	require.False(t, run(ctx, mockTx{confirm(bondedOrch), &banktypes.MsgSend{FromAddress: bondedOrch.String()}}, false))
+	require.False(t, run(ctx, mockTx{confirm(bondedOrch), confirm(stranger)}, false))
+	// simulation and DeliverTx are untouched and do not consume the quota
+	require.False(t, run(ctx, mockTx{confirm(bondedOrch)}, true))
+	require.False(t, run(ctx.WithIsCheckTx(false), mockTx{confirm(bondedOrch)}, false))
+
+	// exempt txs above the gas limit are rejected without consuming the quota
+	_, err := decorator.AnteHandle(ctx, mockGasTx{mockTx{confirm(bondedOrch)}, 500001}, false, next)
+	require.Error(t, err)
+	require.True(t, run(ctx, mockGasTx{mockTx{confirm(bondedOrch)}, 500000}, false))
+	require.True(t, run(ctx, mockTx{confirm(bondedOrch)}, false))
+	require.False(t, run(ctx, mockTx{confirm(bondedOrch)}, false))
+	// once over the quota the tx pays fees and may use any gas limit
+	require.False(t, run(ctx, mockGasTx{mockTx{confirm(bondedOrch)}, 500001}, false))
+	ms.Commit()
+	ctx = ctx.WithMultiStore(ms)
+
+	// the per orchestrator quota is enforced
+	require.True(t, run(ctx, mockTx{confirm(bondedOrch)}, false))
+	require.True(t, run(ctx, mockTx{confirm(bondedOrch), confirm(bondedOrch)}, false))
+	require.False(t, run(ctx, mockTx{confirm(bondedOrch)}, false))
+
+	// the per block quota is enforced across orchestrators
+	otherOrch := sdk.AccAddress([]byte("another_orchestrator"))
+	orchKeeper[otherOrch.String()] = stakingtypes.Validator{Status: stakingtypes.Bonded}
+	require.True(t, run(ctx, mockTx{confirm(otherOrch)}, false))
+	require.False(t, run(ctx, mockTx{confirm(otherOrch)}, false))
+
+	// counters are reset once the block is committed
+	ms.Commit()
+	ctx = ctx.WithMultiStore(ms)
+	require.True(t, run(ctx, mockTx{confirm(bondedOrch)}, false))
+}
+
+// nolint: exhaustivestruct
+func TestStaleClaimDecorator(t *testing.T) {
+	var (
+		orch       = sdk.AccAddress([]byte("registered_orchestra"))
+		stranger   = sdk.AccAddress([]byte("not_an_orchestrator_"))
+		valAddr    = sdk.ValAddress([]byte("validator_address___"))
+		ethAddress = "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
+	)
+	claimKeeper := mockClaimKeeper{
+		mockOrchestratorKeeper: mockOrchestratorKeeper{
+			orch.String(): stakingtypes.Validator{OperatorAddress: valAddr.String(), Status: stakingtypes.Bonded},
+		},
+		lastEventNonces: map[string]uint64{valAddr.String(): 4},
+		valsetConfirms:  map[string]bool{fmt.Sprintf("%d/%s", 2, orch): true},
+	}
+	claim := func(orch sdk.AccAddress, nonce uint64) sdk.Msg {
+		return &gravitytypes.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    1,
+			TokenContract:  ethAddress,
+			Amount:         sdk.OneInt(),
+			EthereumSender: ethAddress,
+			CosmosReceiver: stranger.String(),
+			Orchestrator:   orch.String(),
+		}
+	}
+	confirm := func(orch sdk.AccAddress, nonce uint64) sdk.Msg {
+		return &gravitytypes.MsgValsetConfirm{Nonce: nonce, Orchestrator: orch.String()}
+	}
+
+	tkey := sdk.NewTransientStoreKey(PendingClaimsTStoreKey)
+	ctx, ms := newTransientTestContext(t, tkey)
+	decorator := NewStaleClaimDecorator(claimKeeper, tkey, MakeEncodingConfig().Marshaler)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	run := func(ctx sdk.Context, tx mockTx) error {
+		// mirror baseapp, which discards the writes of failed ante handlers
+		cacheCtx, write := ctx.CacheContext()
+		_, err := decorator.AnteHandle(cacheCtx, tx, false, next)
+		if err == nil {
+			write()
+		}
+		return err
+	}
+
+	// unregistered orchestrators, duplicate confirms and stale or future nonces are rejected
+	require.Error(t, run(ctx, mockTx{claim(stranger, 5)}))
+	require.Error(t, run(ctx, mockTx{confirm(stranger, 3)}))
+	require.Error(t, run(ctx, mockTx{confirm(orch, 2)}))
+	require.Error(t, run(ctx, mockTx{claim(orch, 4)}))
+	require.Error(t, run(ctx, mockTx{claim(orch, 6)}))
+	// nothing is checked in DeliverTx
+	require.NoError(t, run(ctx.WithIsCheckTx(false), mockTx{claim(orch, 4)}))
+
+	require.NoError(t, run(ctx, mockTx{confirm(orch, 3)}))
+	// consecutive claims are accepted within a tx and across txs of the same block
+	require.NoError(t, run(ctx, mockTx{claim(orch, 5), claim(orch, 6)}))
+	require.NoError(t, run(ctx, mockTx{claim(orch, 7)}))
+	require.Error(t, run(ctx, mockTx{claim(orch, 7)}))
+	// a failed tx does not advance the pending nonce
+	require.Error(t, run(ctx, mockTx{claim(orch, 8), confirm(orch, 2)}))
+	require.NoError(t, run(ctx, mockTx{claim(orch, 8)}))
+
+	// once committed the pending nonces are forgotten, ReCheckTx only sees the nonces stored by the keeper
+	ms.Commit()
+	ctx = ctx.WithMultiStore(ms).WithIsReCheckTx(true)
+	require.Error(t, run(ctx, mockTx{claim(orch, 6)}))
+	require.NoError(t, run(ctx, mockTx{claim(orch, 5)}))
+}
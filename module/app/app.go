@@ -12,10 +12,14 @@ import (
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cast"
 
+	// CosmWasm
+	"github.com/CosmWasm/wasmd/x/wasm"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	// Cosmos SDK
@@ -26,6 +30,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	ccodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -42,6 +47,7 @@ import (
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
@@ -108,12 +114,21 @@ import (
 	gravityparams "github.com/Gravity-Bridge/Gravity-Bridge/module/app/params"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades"
 	v2 "github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades/v2"
+	v3 "github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades/v3"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/bindings"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/streaming"
 	gravitytypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
-const appName = "app"
+const (
+	appName = "app"
+
+	// FlagWasmDir overrides the directory wasm code is stored and compiled in, the wasm directory of the node home
+	// by default
+	FlagWasmDir = "wasm-dir"
+)
 
 var (
 	// DefaultNodeHome sets the folder where the applcation data and configuration will be stored
@@ -150,6 +165,7 @@ var (
 		vesting.AppModuleBasic{},
 		gravity.AppModuleBasic{},
 		bech32ibc.AppModuleBasic{},
+		wasmAppModuleBasic{},
 	)
 
 	// module account permissions
@@ -163,6 +179,7 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		gravitytypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
+		wasm.ModuleName:                {authtypes.Burner},
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -223,11 +240,13 @@ type Gravity struct {
 	ibcTransferKeeper *ibctransferkeeper.Keeper
 	gravityKeeper     *keeper.Keeper
 	bech32IbcKeeper   *bech32ibckeeper.Keeper
+	wasmKeeper        *wasm.Keeper
 
 	// make scoped keepers public for test purposes
 	// NOTE: If you add anything to this struct, add a nil check to ValidateMembers below!
 	ScopedIBCKeeper      *capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper *capabilitykeeper.ScopedKeeper
+	ScopedWasmKeeper     *capabilitykeeper.ScopedKeeper
 
 	// Module Manager
 	mm *module.Manager
@@ -237,6 +256,9 @@ type Gravity struct {
 
 	// configurator
 	configurator *module.Configurator
+
+	// gravityStreamer writes the gravity store changes of every block to files, it is nil unless enabled in app.toml
+	gravityStreamer *streaming.Streamer
 }
 
 // ValidateMembers checks for nil members
@@ -297,6 +319,9 @@ func (app Gravity) ValidateMembers() {
 	if app.bech32IbcKeeper == nil {
 		panic("Nil bech32IbcKeeper!")
 	}
+	if app.wasmKeeper == nil {
+		panic("Nil wasmKeeper!")
+	}
 
 	// scoped keepers
 	if app.ScopedIBCKeeper == nil {
@@ -305,6 +330,9 @@ func (app Gravity) ValidateMembers() {
 	if app.ScopedTransferKeeper == nil {
 		panic("Nil ScopedTransferKeeper!")
 	}
+	if app.ScopedWasmKeeper == nil {
+		panic("Nil ScopedWasmKeeper!")
+	}
 
 	// managers
 	if app.mm == nil {
@@ -333,28 +361,40 @@ func NewGravityApp(
 	legacyAmino := encodingConfig.Amino
 	interfaceRegistry := encodingConfig.InterfaceRegistry
 
-	bApp := *baseapp.NewBaseApp(appName, logger, db, encodingConfig.TxConfig.TxDecoder(), baseAppOptions...)
-	bApp.SetCommitMultiStoreTracer(traceStore)
-	bApp.SetVersion(version.Version)
-	bApp.SetInterfaceRegistry(interfaceRegistry)
-
 	keys := sdk.NewKVStoreKeys(
 		authtypes.StoreKey, authzkeeper.StoreKey, banktypes.StoreKey,
 		stakingtypes.StoreKey, minttypes.StoreKey, distrtypes.StoreKey,
 		slashingtypes.StoreKey, govtypes.StoreKey, paramstypes.StoreKey,
 		ibchost.StoreKey, upgradetypes.StoreKey, evidencetypes.StoreKey,
 		ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
-		gravitytypes.StoreKey, bech32ibctypes.StoreKey,
+		gravitytypes.StoreKey, bech32ibctypes.StoreKey, wasm.StoreKey,
+	)
+	tKeys := sdk.NewTransientStoreKeys(
+		paramstypes.TStoreKey, OrchestratorFeeExemptionTStoreKey, PendingClaimsTStoreKey, gravitytypes.TStoreKey,
 	)
-	tKeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
+	gravityStreamer := newGravityStreamer(appOpts, homePath, keys[gravitytypes.StoreKey], appCodec)
+	if gravityStreamer != nil {
+		// the streaming store must be set before the options configuring the store are applied
+		setCMS := func(bApp *baseapp.BaseApp) {
+			bApp.SetCMS(streaming.NewCommitMultiStore(db, keys[gravitytypes.StoreKey], gravityStreamer))
+		}
+		baseAppOptions = append([]func(*baseapp.BaseApp){setCMS}, baseAppOptions...)
+	}
+
+	bApp := *baseapp.NewBaseApp(appName, logger, db, encodingConfig.TxConfig.TxDecoder(), baseAppOptions...)
+	bApp.SetCommitMultiStoreTracer(traceStore)
+	bApp.SetVersion(version.Version)
+	bApp.SetInterfaceRegistry(interfaceRegistry)
+
 	//nolint: exhaustivestruct
 	var app = &Gravity{
 		BaseApp:           &bApp,
 		legacyAmino:       legacyAmino,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		gravityStreamer:   gravityStreamer,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
 		tKeys:             tKeys,
@@ -379,6 +419,9 @@ func NewGravityApp(
 	scopedTransferKeeper := capabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	app.ScopedTransferKeeper = &scopedTransferKeeper
 
+	scopedWasmKeeper := capabilityKeeper.ScopeToModule(wasm.ModuleName)
+	app.ScopedWasmKeeper = &scopedWasmKeeper
+
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
 	// their scoped modules in `NewApp` with `ScopeToModule`
 	capabilityKeeper.Seal()
@@ -471,6 +514,7 @@ func NewGravityApp(
 
 	gravityKeeper := keeper.NewKeeper(
 		keys[gravitytypes.StoreKey],
+		tKeys[gravitytypes.TStoreKey],
 		app.GetSubspace(gravitytypes.ModuleName),
 		appCodec,
 		&bankKeeper,
@@ -483,6 +527,33 @@ func NewGravityApp(
 	)
 	app.gravityKeeper = &gravityKeeper
 
+	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error while reading wasm config: %s", err))
+	}
+	// Contracts may use the gravity custom messages and queries by requiring the gravity capability
+	supportedFeatures := "iterator,staking,stargate," + bindings.Capability
+	wasmKeeper := wasm.NewKeeper(
+		appCodec,
+		keys[wasm.StoreKey],
+		app.GetSubspace(wasm.ModuleName),
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		distrKeeper,
+		ibcKeeper.ChannelKeeper,
+		&ibcKeeper.PortKeeper,
+		scopedWasmKeeper,
+		ibcTransferKeeper,
+		bApp.MsgServiceRouter(),
+		bApp.GRPCQueryRouter(),
+		wasmDir(homePath, appOpts),
+		wasmConfig,
+		supportedFeatures,
+		bindings.RegisterCustomPlugins(gravityKeeper)...,
+	)
+	app.wasmKeeper = &wasmKeeper
+
 	// Add the staking hooks from distribution, slashing, and gravity to staking
 	stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
@@ -534,7 +605,8 @@ func NewGravityApp(
 	ibcTransferModule := transfer.NewAppModule(ibcTransferKeeper)
 
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, ibcTransferModule)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, ibcTransferModule).
+		AddRoute(wasm.ModuleName, wasm.NewIBCHandler(wasmKeeper, ibcKeeper.ChannelKeeper))
 	ibcKeeper.SetRouter(ibcRouter)
 
 	evidenceKeeper := *evidencekeeper.NewKeeper(
@@ -621,16 +693,25 @@ func NewGravityApp(
 		params.NewAppModule(paramsKeeper),
 		ibcTransferModule,
 		gravity.NewAppModule(
+			appCodec,
 			gravityKeeper,
 			bankKeeper,
+			accountKeeper,
 		),
 		bech32ibc.NewAppModule(
 			appCodec,
 			bech32IbcKeeper,
 		),
+		wasm.NewAppModule(
+			appCodec,
+			&wasmKeeper,
+			stakingKeeper,
+		),
 	)
 	app.mm = &mm
 
+	// NOTE: every module must be listed in the BeginBlocker, EndBlocker and InitGenesis orders, including those
+	// without any work to do there
 	// NOTE: capability module's BeginBlocker must come before any modules using capabilities (e.g. IBC)
 	mm.SetOrderBeginBlockers(
 		upgradetypes.ModuleName,
@@ -641,6 +722,18 @@ func NewGravityApp(
 		evidencetypes.ModuleName,
 		stakingtypes.ModuleName,
 		ibchost.ModuleName,
+		authtypes.ModuleName,
+		authz.ModuleName,
+		banktypes.ModuleName,
+		crisistypes.ModuleName,
+		genutiltypes.ModuleName,
+		govtypes.ModuleName,
+		paramstypes.ModuleName,
+		vestingtypes.ModuleName,
+		ibctransfertypes.ModuleName,
+		gravitytypes.ModuleName,
+		bech32ibctypes.ModuleName,
+		wasm.ModuleName,
 	)
 	mm.SetOrderEndBlockers(
 		crisistypes.ModuleName,
@@ -648,7 +741,24 @@ func NewGravityApp(
 		stakingtypes.ModuleName,
 		gravitytypes.ModuleName,
 		upgradetypes.ModuleName,
+		capabilitytypes.ModuleName,
+		authtypes.ModuleName,
+		authz.ModuleName,
+		banktypes.ModuleName,
+		distrtypes.ModuleName,
+		slashingtypes.ModuleName,
+		minttypes.ModuleName,
+		genutiltypes.ModuleName,
+		evidencetypes.ModuleName,
+		paramstypes.ModuleName,
+		vestingtypes.ModuleName,
+		ibchost.ModuleName,
+		ibctransfertypes.ModuleName,
+		bech32ibctypes.ModuleName,
+		wasm.ModuleName,
 	)
+	// NOTE: wasm must come after the modules contracts may call into during genesis, crisis must come last to check
+	// the invariants of the final genesis state
 	mm.SetOrderInitGenesis(
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
@@ -666,6 +776,9 @@ func NewGravityApp(
 		authz.ModuleName,
 		gravitytypes.ModuleName,
 		bech32ibctypes.ModuleName,
+		paramstypes.ModuleName,
+		vestingtypes.ModuleName,
+		wasm.ModuleName,
 		crisistypes.ModuleName,
 	)
 
@@ -688,6 +801,7 @@ func NewGravityApp(
 		evidence.NewAppModule(evidenceKeeper),
 		ibc.NewAppModule(&ibcKeeper),
 		ibcTransferModule,
+		gravity.NewAppModule(appCodec, gravityKeeper, bankKeeper, accountKeeper),
 	)
 	app.sm = &sm
 
@@ -708,7 +822,22 @@ func NewGravityApp(
 		SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 	}
 
-	ah, err := newAnteHandler(options, ibcKeeper.ChannelKeeper, appCodec)
+	feeExemptionConfig, err := ReadFeeExemptionConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error while reading gravity fee exemption config: %s", err))
+	}
+	ah, err := newAnteHandler(
+		options,
+		ibcKeeper.ChannelKeeper,
+		gravityKeeper,
+		tKeys[OrchestratorFeeExemptionTStoreKey],
+		feeExemptionConfig,
+		gravityKeeper,
+		tKeys[PendingClaimsTStoreKey],
+		wasmConfig,
+		keys[wasm.StoreKey],
+		appCodec,
+	)
 	if err != nil {
 		panic("invalid antehandler created")
 	}
@@ -722,6 +851,19 @@ func NewGravityApp(
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		// Streaming resumes after the last block written, which must be the last block committed
+		if app.gravityStreamer != nil {
+			if err := app.gravityStreamer.CheckHeight(app.LastBlockHeight()); err != nil {
+				tmos.Exit(err.Error())
+			}
+		}
+
+		// The compiled contracts pinned in memory are not persisted by wasmvm, they must be loaded again on startup
+		ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
+		if err := wasmKeeper.InitializePinnedCodes(ctx); err != nil {
+			tmos.Exit(fmt.Sprintf("failed to initialize pinned codes: %s", err))
+		}
 	}
 
 	keeper.RegisterProposalTypes()
@@ -758,6 +900,47 @@ func (app *Gravity) firstBeginBlocker(ctx sdk.Context) {
 	app.assertBech32PrefixMatches(ctx)
 }
 
+// newGravityStreamer creates the gravity store streamer configured in app.toml, it returns nil if streaming is disabled
+func newGravityStreamer(appOpts servertypes.AppOptions, homePath string, storeKey sdk.StoreKey, cdc codec.Codec) *streaming.Streamer {
+	config, err := streaming.ReadConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error while reading gravity streaming config: %s", err))
+	}
+	if !config.Enable {
+		return nil
+	}
+	// the streaming multistore is not a rootmulti store, which baseapp silently stops taking snapshots of
+	if cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval)) != 0 {
+		panic("gravity streaming can not be combined with state sync snapshots, set state-sync.snapshot-interval to 0")
+	}
+	dir := config.Dir
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(homePath, dir)
+	}
+	streamer, err := streaming.NewStreamer(storeKey, cdc, dir, config.MaxFileSize, config.MaxFiles)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create gravity streamer: %s", err))
+	}
+	return streamer
+}
+
+// Commit commits the current block, writing its gravity store changes to the stream files if streaming is enabled
+func (app *Gravity) Commit() abci.ResponseCommit {
+	if app.gravityStreamer == nil {
+		return app.BaseApp.Commit()
+	}
+
+	var res abci.ResponseCommit
+	err := app.gravityStreamer.ListenCommit(func() int64 {
+		res = app.BaseApp.Commit()
+		return app.LastBlockHeight()
+	})
+	if err != nil {
+		app.Logger().Error("failed to write gravity stream, retrying with the next block", "error", err)
+	}
+	return res
+}
+
 // EndBlocker application updates every end block
 func (app *Gravity) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 	return app.mm.EndBlock(ctx, req)
@@ -915,6 +1098,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(gravitytypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(wasm.ModuleName)
 
 	return paramsKeeper
 }
@@ -923,7 +1107,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 func (app *Gravity) registerUpgradeHandlers() {
 	upgrades.RegisterUpgradeHandlers(
 		app.mm, app.configurator, app.accountKeeper, app.bankKeeper, app.bech32IbcKeeper, app.distrKeeper,
-		app.mintKeeper, app.stakingKeeper, app.upgradeKeeper,
+		app.mintKeeper, app.stakingKeeper, app.upgradeKeeper, app.wasmKeeper, WasmParams(),
 	)
 }
 
@@ -935,21 +1119,46 @@ func (app *Gravity) registerStoreLoaders() {
 		panic(fmt.Sprintf("failed to read upgrade info from disk %s", err))
 	}
 
-	// v1->v2 STORE LOADER SETUP
-	// Register the new v2 modules and the special StoreLoader to add them
-	if upgradeInfo.Name == v2.V1ToV2PlanName {
-		if !app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) { // Recognized the plan, need to skip this one though
-			storeUpgrades := storetypes.StoreUpgrades{
-				Added: []string{bech32ibctypes.ModuleName}, // We are adding these modules
-				// Check upgrade docs to see which type of store loader is necessary for deletes/renames
-				// Renamed: []storetypes.StoreRename{{"foo", "bar"}}, example foo to bar rename
-				// Deleted: []string{"bazmodule"}, example deleted bazmodule
-				Renamed: nil,
-				Deleted: nil,
-			}
+	storeUpgrades := planStoreUpgrades(upgradeInfo.Name)
+	if storeUpgrades != nil && !app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) { // Recognized the plan, need to skip this one though
+		// configure store loader that checks if version == upgradeHeight and applies store upgrades
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, storeUpgrades))
+	}
+}
 
-			// configure store loader that checks if version == upgradeHeight and applies store upgrades
-			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+// planStoreUpgrades returns the stores added, deleted or renamed by the upgrade plan with the given name, nil if the
+// plan does not change any stores
+func planStoreUpgrades(planName string) *storetypes.StoreUpgrades {
+	switch planName {
+	// v1->v2 STORE UPGRADES
+	// Register the new v2 modules
+	case v2.V1ToV2PlanName:
+		return &storetypes.StoreUpgrades{
+			Added: []string{bech32ibctypes.ModuleName}, // We are adding these modules
+			// Check upgrade docs to see which type of store loader is necessary for deletes/renames
+			// Renamed: []storetypes.StoreRename{{"foo", "bar"}}, example foo to bar rename
+			// Deleted: []string{"bazmodule"}, example deleted bazmodule
+			Renamed: nil,
+			Deleted: nil,
+		}
+	// v2->v3 STORE UPGRADES
+	// Register the new v3 modules, RunMigrations in the v3 upgrade handler runs their InitGenesis
+	case v3.V2ToV3PlanName:
+		return &storetypes.StoreUpgrades{
+			Added:   []string{wasm.ModuleName},
+			Renamed: nil,
+			Deleted: nil,
 		}
+	default:
+		return nil
+	}
+}
+
+// wasmDir returns the directory wasm code is stored and compiled in, the FlagWasmDir app option if it is set or
+// the wasm directory of the node home otherwise
+func wasmDir(homePath string, appOpts servertypes.AppOptions) string {
+	if dir := cast.ToString(appOpts.Get(FlagWasmDir)); dir != "" {
+		return dir
 	}
+	return filepath.Join(homePath, "wasm")
 }
@@ -0,0 +1,71 @@
+package app
+
+import (
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	flagMaxFeeExemptTxsPerBlock        = "gravity-fee-exemption.max-txs-per-block"
+	flagMaxFeeExemptTxsPerOrchestrator = "gravity-fee-exemption.max-txs-per-orchestrator"
+	flagMaxFeeExemptTxGas              = "gravity-fee-exemption.max-tx-gas"
+)
+
+// FeeExemptionConfig configures the OrchestratorFeeExemptionDecorator, it is read from the [gravity-fee-exemption]
+// section of app.toml. Like the minimum gas prices it only affects which txs this node accepts into its mempool
+type FeeExemptionConfig struct {
+	MaxTxsPerBlock        uint64 `mapstructure:"max-txs-per-block"`
+	MaxTxsPerOrchestrator uint64 `mapstructure:"max-txs-per-orchestrator"`
+	MaxTxGas              uint64 `mapstructure:"max-tx-gas"`
+}
+
+// DefaultFeeExemptionConfig returns the default fee exemption config
+func DefaultFeeExemptionConfig() FeeExemptionConfig {
+	return FeeExemptionConfig{
+		MaxTxsPerBlock:        DefaultMaxFeeExemptTxsPerBlock,
+		MaxTxsPerOrchestrator: DefaultMaxFeeExemptTxsPerOrchestrator,
+		MaxTxGas:              DefaultMaxFeeExemptTxGas,
+	}
+}
+
+// FeeExemptionConfigTemplate is the app.toml section of the fee exemption config
+const FeeExemptionConfigTemplate = `
+###############################################################################
+###                     Gravity Fee Exemption Configuration                 ###
+###############################################################################
+
+[gravity-fee-exemption]
+
+# MaxTxsPerBlock is the number of txs made only of confirms and claims from the orchestrators of bonded validators
+# this node accepts into its mempool per block without fees, further txs are charged the minimum gas prices
+max-txs-per-block = {{ .GravityFeeExemption.MaxTxsPerBlock }}
+
+# MaxTxsPerOrchestrator is the number of fee exempt txs a single orchestrator may submit per block
+max-txs-per-orchestrator = {{ .GravityFeeExemption.MaxTxsPerOrchestrator }}
+
+# MaxTxGas is the highest gas limit of a fee exempt tx, orchestrator txs within the quotas with a higher gas limit
+# are rejected
+max-tx-gas = {{ .GravityFeeExemption.MaxTxGas }}
+`
+
+// ReadFeeExemptionConfig reads the fee exemption config from the app options, with the defaults for unset options
+func ReadFeeExemptionConfig(opts servertypes.AppOptions) (FeeExemptionConfig, error) {
+	cfg := DefaultFeeExemptionConfig()
+	var err error
+	if v := opts.Get(flagMaxFeeExemptTxsPerBlock); v != nil {
+		if cfg.MaxTxsPerBlock, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxFeeExemptTxsPerOrchestrator); v != nil {
+		if cfg.MaxTxsPerOrchestrator, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxFeeExemptTxGas); v != nil {
+		if cfg.MaxTxGas, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	return cfg, nil
+}
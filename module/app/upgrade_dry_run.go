@@ -0,0 +1,206 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// UpgradeDryRunReport describes the outcome of rehearsing an upgrade with UpgradeDryRun
+type UpgradeDryRunReport struct {
+	PlanName string `json:"plan_name"`
+	// Height is the height the upgrade was applied at, one past the last committed block
+	Height int64 `json:"height"`
+	// UpgradeError is set if the upgrade handler failed, in that case no invariants were run
+	UpgradeError     string                  `json:"upgrade_error,omitempty"`
+	BrokenInvariants []string                `json:"broken_invariants"`
+	StoreDiffs       []UpgradeDryRunDiff     `json:"store_diffs"`
+	Timings          []UpgradeDryRunDuration `json:"timings"`
+}
+
+// UpgradeDryRunDiff counts the keys of a module store changed by an upgrade
+type UpgradeDryRunDiff struct {
+	Store    string `json:"store"`
+	Added    uint64 `json:"added"`
+	Modified uint64 `json:"modified"`
+	Deleted  uint64 `json:"deleted"`
+}
+
+// UpgradeDryRunDuration is the time spent on a single step of UpgradeDryRun
+type UpgradeDryRunDuration struct {
+	Step     string `json:"step"`
+	Duration string `json:"duration"`
+}
+
+// Failed returns true if the upgrade handler failed or left any invariant broken
+func (r UpgradeDryRunReport) Failed() bool {
+	return r.UpgradeError != "" || len(r.BrokenInvariants) > 0
+}
+
+// UpgradeDryRun rehearses the upgrade with the given plan name on top of the last committed state: the registered
+// upgrade handler (and with it the module migrations) is applied at the next height, followed by every registered
+// invariant. All of this happens in a cache of the committed stores which is thrown away afterwards, so nothing is
+// ever written to the database.
+// NOTE: The upgrade handler also sets the protocol version of the app, the app should not be used after a dry run
+func (app *Gravity) UpgradeDryRun(planName string, blockTime time.Time) (UpgradeDryRunReport, error) {
+	if !app.upgradeKeeper.HasHandler(planName) {
+		return UpgradeDryRunReport{}, fmt.Errorf("no upgrade handler registered for plan %s", planName)
+	}
+
+	height := app.LastBlockHeight() + 1
+	report := UpgradeDryRunReport{
+		PlanName:         planName,
+		Height:           height,
+		BrokenInvariants: []string{},
+		StoreDiffs:       []UpgradeDryRunDiff{},
+		Timings:          []UpgradeDryRunDuration{},
+	}
+	timed := func(step string, f func()) {
+		start := time.Now()
+		f()
+		report.Timings = append(report.Timings, UpgradeDryRunDuration{Step: step, Duration: time.Since(start).String()})
+	}
+
+	// nolint: exhaustivestruct
+	ctx := app.NewUncachedContext(false, tmproto.Header{Height: height, Time: blockTime})
+	committed := ctx.MultiStore()
+	cache := committed.CacheMultiStore()
+	ctx = ctx.WithMultiStore(cache)
+
+	timed("upgrade handler", func() {
+		report.UpgradeError = applyUpgradeSafely(ctx, app, upgradetypes.Plan{Name: planName, Height: height})
+	})
+
+	if report.UpgradeError == "" {
+		// Group the invariant timings by module, there can be a lot of invariant routes
+		var modules []string
+		durations := make(map[string]time.Duration)
+		for _, route := range app.crisisKeeper.Routes() {
+			start := time.Now()
+			if msg, broken := checkInvariantSafely(ctx, route.Invar); broken {
+				report.BrokenInvariants = append(report.BrokenInvariants, fmt.Sprintf("%s: %s", route.FullRoute(), msg))
+			}
+			if _, ok := durations[route.ModuleName]; !ok {
+				modules = append(modules, route.ModuleName)
+			}
+			durations[route.ModuleName] += time.Since(start)
+		}
+		for _, module := range modules {
+			report.Timings = append(report.Timings, UpgradeDryRunDuration{
+				Step:     fmt.Sprintf("%s invariants", module),
+				Duration: durations[module].String(),
+			})
+		}
+	}
+
+	timed("state diff", func() {
+		var names []string
+		for name := range app.keys {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			key := app.keys[name]
+			diff := diffKVStores(committed.GetKVStore(key), cache.GetKVStore(key))
+			diff.Store = name
+			if diff.Added != 0 || diff.Modified != 0 || diff.Deleted != 0 {
+				report.StoreDiffs = append(report.StoreDiffs, diff)
+			}
+		}
+	})
+
+	return report, nil
+}
+
+// LoadUpgradeDryRunVersion loads the last committed state for a dry run of the plan with the given name, the app
+// must have been created without loading the latest version. The stores the plan adds are mounted as the node would
+// when restarting at the upgrade height, unless the committed state already has them
+func (app *Gravity) LoadUpgradeDryRunVersion(planName string) error {
+	if storeUpgrades := planStoreUpgrades(planName); storeUpgrades != nil {
+		app.SetStoreLoader(func(ms sdk.CommitMultiStore) error {
+			// Stores missing from the committed state are loaded empty at version 0
+			if err := ms.LoadLatestVersion(); err != nil {
+				return err
+			}
+			pending := *storeUpgrades
+			pending.Added = nil
+			for _, name := range storeUpgrades.Added {
+				if key, ok := app.keys[name]; ok && ms.GetCommitKVStore(key).LastCommitID().Version == 0 {
+					pending.Added = append(pending.Added, name)
+				}
+			}
+			return ms.LoadLatestVersionAndUpgrade(&pending)
+		})
+	}
+	if err := app.LoadLatestVersion(); err != nil {
+		return err
+	}
+
+	// nolint: exhaustivestruct
+	return app.wasmKeeper.InitializePinnedCodes(app.NewUncachedContext(true, tmproto.Header{}))
+}
+
+// applyUpgradeSafely runs the upgrade handler, which panics on failure, and returns the failure as a string
+func applyUpgradeSafely(ctx sdk.Context, app *Gravity, plan upgradetypes.Plan) (failure string) {
+	defer func() {
+		if r := recover(); r != nil {
+			failure = fmt.Sprintf("%v", r)
+		}
+	}()
+	app.upgradeKeeper.ApplyUpgrade(ctx, plan)
+	return ""
+}
+
+// checkInvariantSafely runs invariant, treating a panic as a broken invariant
+func checkInvariantSafely(ctx sdk.Context, invariant sdk.Invariant) (msg string, broken bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			msg, broken = fmt.Sprintf("panic: %v", r), true
+		}
+	}()
+	return invariant(ctx)
+}
+
+// diffKVStores walks both stores in key order and counts the keys only in after, in both with different values,
+// and only in before
+func diffKVStores(before, after sdk.KVStore) UpgradeDryRunDiff {
+	var diff UpgradeDryRunDiff
+	a := before.Iterator(nil, nil)
+	defer a.Close()
+	b := after.Iterator(nil, nil)
+	defer b.Close()
+
+	for a.Valid() || b.Valid() {
+		var cmp int
+		switch {
+		case !a.Valid():
+			cmp = 1
+		case !b.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(a.Key(), b.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			diff.Deleted++
+			a.Next()
+		case cmp > 0:
+			diff.Added++
+			b.Next()
+		default:
+			if !bytes.Equal(a.Value(), b.Value()) {
+				diff.Modified++
+			}
+			a.Next()
+			b.Next()
+		}
+	}
+
+	return diff
+}
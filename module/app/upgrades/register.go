@@ -1,6 +1,8 @@
 package upgrades
 
 import (
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
@@ -11,6 +13,7 @@ import (
 	bech32ibckeeper "github.com/osmosis-labs/bech32-ibc/x/bech32ibc/keeper"
 
 	v2 "github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades/v2"
+	v3 "github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades/v3"
 )
 
 // RegisterUpgradeHandlers registers handlers for all upgrades
@@ -20,9 +23,10 @@ func RegisterUpgradeHandlers(
 	mm *module.Manager, configurator *module.Configurator, accountKeeper *authkeeper.AccountKeeper,
 	bankKeeper *bankkeeper.BaseKeeper, bech32IbcKeeper *bech32ibckeeper.Keeper, distrKeeper *distrkeeper.Keeper,
 	mintKeeper *mintkeeper.Keeper, stakingKeeper *stakingkeeper.Keeper, upgradeKeeper *upgradekeeper.Keeper,
+	wasmKeeper *wasm.Keeper, wasmParams wasmtypes.Params,
 ) {
 	if mm == nil || configurator == nil || accountKeeper == nil || bankKeeper == nil || bech32IbcKeeper == nil ||
-		distrKeeper == nil || mintKeeper == nil || stakingKeeper == nil || upgradeKeeper == nil {
+		distrKeeper == nil || mintKeeper == nil || stakingKeeper == nil || upgradeKeeper == nil || wasmKeeper == nil {
 		panic("Nil argument to RegisterUpgradeHandlers()!")
 	}
 	// Mercury aka v1->v2 UPGRADE HANDLER SETUP
@@ -35,4 +39,9 @@ func RegisterUpgradeHandlers(
 		v2.V2FixPlanName, // mercury2.0
 		v2.GetMercury2Dot0UpgradeHandler(),
 	)
+	// v2->v3 UPGRADE HANDLER SETUP
+	upgradeKeeper.SetUpgradeHandler(
+		v3.V2ToV3PlanName,
+		v3.GetV3UpgradeHandler(mm, configurator, wasmKeeper, wasmParams),
+	)
 }
@@ -0,0 +1,3 @@
+package v3
+
+var V2ToV3PlanName = "polaris"
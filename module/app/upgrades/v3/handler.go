@@ -0,0 +1,33 @@
+package v3
+
+import (
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// GetV3UpgradeHandler creates the handler for the v2 -> v3 upgrade, which runs the gravity module's
+// v2 -> v3 store migration adding the new valset creation params and initializes the newly added wasm module.
+// RunMigrations initializes wasm with the wasmd default genesis, which lets everybody upload code, so its params are
+// replaced with wasmParams afterwards.
+func GetV3UpgradeHandler(
+	mm *module.Manager, configurator *module.Configurator, wasmKeeper *wasm.Keeper, wasmParams wasmtypes.Params,
+) func(
+	ctx sdk.Context, plan upgradetypes.Plan, vmap module.VersionMap,
+) (module.VersionMap, error) {
+	if mm == nil || configurator == nil || wasmKeeper == nil {
+		panic("Nil argument to GetV3UpgradeHandler")
+	}
+	return func(ctx sdk.Context, plan upgradetypes.Plan, vmap module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("v3 upgrade: Running all configured module migrations (Should only see Gravity run and wasm initialized)")
+		vmap, err := mm.RunMigrations(ctx, *configurator, vmap)
+		if err != nil {
+			return nil, err
+		}
+		ctx.Logger().Info("v3 upgrade: Setting the wasm params")
+		wasmKeeper.SetParams(ctx, wasmParams)
+		return vmap, nil
+	}
+}
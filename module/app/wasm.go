@@ -0,0 +1,29 @@
+package app
+
+import (
+	"encoding/json"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// WasmParams returns the wasm module params of new chains and of chains adding the wasm module in the v3 upgrade.
+// wasmd defaults to letting everybody upload and instantiate code, here nobody may do either until governance
+// opens them up with a param change proposal on the wasm subspace.
+func WasmParams() wasmtypes.Params {
+	params := wasmtypes.DefaultParams()
+	params.CodeUploadAccess = wasmtypes.AllowNobody
+	params.InstantiateDefaultPermission = wasmtypes.AccessTypeNobody
+	return params
+}
+
+// wasmAppModuleBasic overrides the wasm default genesis with WasmParams
+type wasmAppModuleBasic struct {
+	wasm.AppModuleBasic
+}
+
+// DefaultGenesis returns the wasm genesis state with WasmParams
+func (wasmAppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(&wasmtypes.GenesisState{Params: WasmParams()})
+}
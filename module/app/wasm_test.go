@@ -0,0 +1,19 @@
+package app
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Tests that new chains do not allow anybody to upload or instantiate wasm code
+func TestWasmDefaultGenesis(t *testing.T) {
+	encCfg := MakeEncodingConfig()
+	var genesis wasmtypes.GenesisState
+	encCfg.Marshaler.MustUnmarshalJSON(NewDefaultGenesisState()[wasmtypes.ModuleName], &genesis)
+
+	require.Equal(t, wasmtypes.AllowNobody, genesis.Params.CodeUploadAccess)
+	require.Equal(t, wasmtypes.AccessTypeNobody, genesis.Params.InstantiateDefaultPermission)
+	require.NoError(t, genesis.ValidateBasic())
+}
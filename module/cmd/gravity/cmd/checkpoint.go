@@ -0,0 +1,346 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+const (
+	flagCheckpointFile = "file"
+	flagGravityID      = "gravity-id"
+	flagEthPrivateKey  = "eth-private-key"
+	flagSignature      = "signature"
+)
+
+// CheckpointCommands registers a sub-tree of commands which compute the Ethereum checkpoints of
+// valsets, batches and logic calls, and sign or verify signatures over them
+func CheckpointCommands() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "checkpoint",
+		Short: "Compute, sign and verify the Ethereum checkpoints of valsets, batches and logic calls",
+		Long: `Compute the checkpoint the Gravity contract verifies signatures against.
+
+The signed object is fetched from the chain, or read from a JSON file with --file. The gravity id is
+read from the chain params unless --gravity-id is given, with both --file and --gravity-id (and --offline)
+no node is contacted at all.
+
+With --eth-private-key the checkpoint is signed the same way an orchestrator would sign it. With
+--signature the signer of the given signature is recovered and looked up among the delegate keys.
+The output contains everything needed to assemble a MsgSubmitBadSignatureEvidence.
+`,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CheckpointValsetCmd(),
+		CheckpointBatchCmd(),
+		CheckpointLogicCallCmd(),
+	)
+
+	return cmd
+}
+
+// CheckpointValsetCmd computes the checkpoint of a valset
+func CheckpointValsetCmd() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "valset [nonce]",
+		Short: "Compute the checkpoint of the valset with a particular nonce, or of the valset in --file",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var valset types.Valset
+			read, err := readCheckpointSubject(cmd, clientCtx.Codec, &valset)
+			if err != nil {
+				return err
+			}
+			if !read {
+				if len(args) != 1 {
+					return fmt.Errorf("either a valset nonce or --%s is required", flagCheckpointFile)
+				}
+				nonce, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+				res, err := types.NewQueryClient(clientCtx).ValsetRequest(cmd.Context(), &types.QueryValsetRequestRequest{Nonce: nonce})
+				if err != nil {
+					return err
+				}
+				if res.Valset == nil {
+					return sdkerrors.Wrapf(types.ErrInvalid, "no valset with nonce %d", nonce)
+				}
+				valset = *res.Valset
+			}
+
+			return runCheckpoint(cmd, clientCtx, &valset)
+		},
+	}
+	addCheckpointFlags(cmd)
+	return cmd
+}
+
+// CheckpointBatchCmd computes the checkpoint of an outgoing batch
+func CheckpointBatchCmd() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "batch [nonce] [token contract address]",
+		Short: "Compute the checkpoint of the outgoing batch with a particular nonce and token, or of the batch in --file",
+		Args:  cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var batch types.OutgoingTxBatch
+			read, err := readCheckpointSubject(cmd, clientCtx.Codec, &batch)
+			if err != nil {
+				return err
+			}
+			if !read {
+				if len(args) != 2 {
+					return fmt.Errorf("either a batch nonce and token contract or --%s is required", flagCheckpointFile)
+				}
+				nonce, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+				req := &types.QueryBatchRequestByNonceRequest{Nonce: nonce, ContractAddress: args[1]}
+				res, err := types.NewQueryClient(clientCtx).BatchRequestByNonce(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+				batch = res.Batch
+			}
+			if _, err := batch.ToInternal(); err != nil {
+				return sdkerrors.Wrap(err, "invalid batch")
+			}
+
+			return runCheckpoint(cmd, clientCtx, &batch)
+		},
+	}
+	addCheckpointFlags(cmd)
+	return cmd
+}
+
+// CheckpointLogicCallCmd computes the checkpoint of an outgoing logic call
+func CheckpointLogicCallCmd() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "logic-call [hex invalidation id] [invalidation nonce]",
+		Short: "Compute the checkpoint of the outgoing logic call with a particular invalidation id and nonce, or of the logic call in --file",
+		Args:  cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var call types.OutgoingLogicCall
+			read, err := readCheckpointSubject(cmd, clientCtx.Codec, &call)
+			if err != nil {
+				return err
+			}
+			if !read {
+				if len(args) != 2 {
+					return fmt.Errorf("either an invalidation id and nonce or --%s is required", flagCheckpointFile)
+				}
+				invalidationID, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+				if err != nil {
+					return sdkerrors.Wrapf(err, "Unable to parse invalidation id from %v", args[0])
+				}
+				invalidationNonce, err := strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+				// there is no query for a single logic call, so we search the outgoing ones
+				res, err := types.NewQueryClient(clientCtx).OutgoingLogicCalls(cmd.Context(), &types.QueryOutgoingLogicCallsRequest{})
+				if err != nil {
+					return err
+				}
+				found := false
+				for _, c := range res.Calls {
+					if c.InvalidationNonce == invalidationNonce && bytes.Equal(c.InvalidationId, invalidationID) {
+						call, found = c, true
+						break
+					}
+				}
+				if !found {
+					return sdkerrors.Wrapf(types.ErrInvalid, "no outgoing logic call with invalidation id %x and nonce %d", invalidationID, invalidationNonce)
+				}
+			}
+
+			return runCheckpoint(cmd, clientCtx, &call)
+		},
+	}
+	addCheckpointFlags(cmd)
+	return cmd
+}
+
+func addCheckpointFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagCheckpointFile, "", "read the object from this JSON file instead of querying the chain")
+	cmd.Flags().String(flagGravityID, "", "the gravity id to compute the checkpoint with, read from the chain params when empty")
+	cmd.Flags().String(flagEthPrivateKey, "", "hex encoded Ethereum private key to sign the checkpoint with")
+	cmd.Flags().String(flagSignature, "", "hex encoded signature over the checkpoint to verify")
+	cmd.Flags().Bool(flags.FlagOffline, false, "never contact a node, requires --file and --gravity-id and skips the signer lookup")
+	flags.AddQueryFlagsToCmd(cmd)
+}
+
+// CheckpointOutput is the result of the checkpoint commands, the subject and signature are in the form
+// expected by MsgSubmitBadSignatureEvidence
+type CheckpointOutput struct {
+	GravityID    string          `json:"gravity_id"`
+	Checkpoint   string          `json:"checkpoint"`
+	Subject      json.RawMessage `json:"subject"`
+	Signature    string          `json:"signature,omitempty"`
+	Signer       string          `json:"signer,omitempty"`
+	Validator    string          `json:"validator,omitempty"`
+	Orchestrator string          `json:"orchestrator,omitempty"`
+}
+
+// readCheckpointSubject unmarshals the --file flag into subject, returning false when the flag is not set
+func readCheckpointSubject(cmd *cobra.Command, cdc codec.Codec, subject codec.ProtoMarshaler) (bool, error) {
+	path, err := cmd.Flags().GetString(flagCheckpointFile)
+	if err != nil {
+		return false, err
+	}
+	if path == "" {
+		if offline, _ := cmd.Flags().GetBool(flags.FlagOffline); offline {
+			return false, fmt.Errorf("--%s is required with --%s", flagCheckpointFile, flags.FlagOffline)
+		}
+		return false, nil
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return false, sdkerrors.Wrap(err, "failed to read file")
+	}
+	if err := cdc.UnmarshalJSON(contents, subject); err != nil {
+		return false, sdkerrors.Wrap(err, "file is not a valid object")
+	}
+	return true, nil
+}
+
+// runCheckpoint computes the checkpoint of subject, then signs and/or verifies it as requested by the flags
+func runCheckpoint(cmd *cobra.Command, clientCtx client.Context, subject interface {
+	types.EthereumSigned
+	codec.ProtoMarshaler
+}) error {
+	offline, err := cmd.Flags().GetBool(flags.FlagOffline)
+	if err != nil {
+		return err
+	}
+	gravityID, err := cmd.Flags().GetString(flagGravityID)
+	if err != nil {
+		return err
+	}
+	if gravityID == "" {
+		if offline {
+			return fmt.Errorf("--%s is required with --%s", flagGravityID, flags.FlagOffline)
+		}
+		res, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+		if err != nil {
+			return sdkerrors.Wrap(err, "unable to query the gravity id")
+		}
+		gravityID = res.Params.GravityId
+	}
+
+	checkpoint, err := computeCheckpoint(subject, gravityID)
+	if err != nil {
+		return err
+	}
+	subjectJSON, err := clientCtx.Codec.MarshalJSON(subject)
+	if err != nil {
+		return err
+	}
+	out := CheckpointOutput{
+		GravityID:  gravityID,
+		Checkpoint: hex.EncodeToString(checkpoint),
+		Subject:    subjectJSON,
+	}
+
+	privateKeyHex, err := cmd.Flags().GetString(flagEthPrivateKey)
+	if err != nil {
+		return err
+	}
+	signatureHex, err := cmd.Flags().GetString(flagSignature)
+	if err != nil {
+		return err
+	}
+	if privateKeyHex != "" && signatureHex != "" {
+		return fmt.Errorf("only one of --%s and --%s may be given", flagEthPrivateKey, flagSignature)
+	}
+
+	var signature []byte
+	if privateKeyHex != "" {
+		privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(privateKeyHex, "0x"))
+		if err != nil {
+			return sdkerrors.Wrap(err, "invalid ethereum private key")
+		}
+		if signature, err = types.NewEthereumSignature(checkpoint, privateKey); err != nil {
+			return err
+		}
+	}
+	if signatureHex != "" {
+		if signature, err = hex.DecodeString(strings.TrimPrefix(signatureHex, "0x")); err != nil {
+			return sdkerrors.Wrap(err, "invalid hex signature")
+		}
+	}
+
+	if signature != nil {
+		out.Signature = hex.EncodeToString(signature)
+		// EthAddressFromSignature normalizes the recovery byte in place, so it gets a copy
+		signer, err := types.EthAddressFromSignature(checkpoint, append([]byte{}, signature...))
+		if err != nil {
+			return sdkerrors.Wrap(err, "unable to recover the signer")
+		}
+		out.Signer = signer.GetAddress().Hex()
+
+		if !offline {
+			req := &types.QueryDelegateKeysByEthAddress{EthAddress: out.Signer}
+			res, err := types.NewQueryClient(clientCtx).GetDelegateKeyByEth(cmd.Context(), req)
+			if err != nil {
+				cmd.PrintErrf("signer %s is not a delegate key of any validator: %v\n", out.Signer, err)
+			} else {
+				out.Validator = res.ValidatorAddress
+				out.Orchestrator = res.OrchestratorAddress
+			}
+		}
+	}
+
+	outBytes, err := json.Marshal(out)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintBytes(outBytes)
+}
+
+// computeCheckpoint turns the panics GetCheckpoint raises on malformed objects into errors, since the
+// objects here may come from arbitrary files
+func computeCheckpoint(subject types.EthereumSigned, gravityID string) (checkpoint []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("unable to compute the checkpoint: %v", r)
+		}
+	}()
+	return subject.GetCheckpoint(gravityID), nil
+}
@@ -0,0 +1,76 @@
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/app"
+	gravitycmd "github.com/Gravity-Bridge/Gravity-Bridge/module/cmd/gravity/cmd"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// nolint: exhaustivestruct
+func TestCheckpointValsetSignAndVerify(t *testing.T) {
+	encodingConfig := app.MakeEncodingConfig()
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+
+	valset := types.Valset{
+		Nonce:        7,
+		Members:      []types.BridgeValidator{{Power: 1000, EthereumAddress: signer}},
+		RewardAmount: sdk.ZeroInt(),
+		RewardToken:  "0x0000000000000000000000000000000000000000",
+	}
+	valsetJSON, err := encodingConfig.Marshaler.MarshalJSON(&valset)
+	require.NoError(t, err)
+	file := filepath.Join(t.TempDir(), "valset.json")
+	require.NoError(t, os.WriteFile(file, valsetJSON, 0600))
+
+	run := func(t *testing.T, gravityID string, extraArgs ...string) gravitycmd.CheckpointOutput {
+		out := &bytes.Buffer{}
+		clientCtx := client.Context{}.WithCodec(encodingConfig.Marshaler).WithOutput(out)
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+		cmd := gravitycmd.CheckpointValsetCmd()
+		cmd.SetArgs(append([]string{
+			fmt.Sprintf("--file=%s", file),
+			fmt.Sprintf("--gravity-id=%s", gravityID),
+			fmt.Sprintf("--%s", flags.FlagOffline),
+			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		}, extraArgs...))
+		require.NoError(t, cmd.ExecuteContext(ctx))
+
+		var res gravitycmd.CheckpointOutput
+		require.NoError(t, json.Unmarshal(out.Bytes(), &res))
+		return res
+	}
+
+	signed := run(t, "foo", fmt.Sprintf("--eth-private-key=%s", hexutil.Encode(crypto.FromECDSA(privateKey))))
+	require.Equal(t, hex.EncodeToString(valset.GetCheckpoint("foo")), signed.Checkpoint)
+	require.Equal(t, signer, signed.Signer)
+	require.NotEmpty(t, signed.Signature)
+
+	verified := run(t, "foo", fmt.Sprintf("--signature=%s", signed.Signature))
+	require.Equal(t, signed.Checkpoint, verified.Checkpoint)
+	require.Equal(t, signer, verified.Signer)
+
+	// the same signature over the checkpoint of another gravity id recovers some other address
+	otherID := run(t, "bar", fmt.Sprintf("--signature=%s", signed.Signature))
+	require.NotEqual(t, signed.Checkpoint, otherID.Checkpoint)
+	require.NotEqual(t, signer, otherID.Signer)
+}
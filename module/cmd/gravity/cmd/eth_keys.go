@@ -1,22 +1,40 @@
 package cmd
 
 import (
+	"bufio"
 	"crypto/ecdsa"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/keys"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
-const flagPassphrase = "passphrase"
+const (
+	flagPassphrase    = "passphrase"
+	flagNewPassphrase = "new-passphrase"
+	flagYes           = "yes"
+
+	// ethKeystoreDir is the directory inside the keyring directory holding the encrypted ethereum keys
+	ethKeystoreDir = "eth_keys"
+)
 
 // Commands registers a sub-tree of commands to interact with
 // local private key storage.
@@ -27,14 +45,20 @@ func Commands(defaultNodeHome string) *cobra.Command {
 		Short: "Manage your application's ethereum keys",
 		Long: `Keyring management commands. Generated by the official Ethereum go library.
 
-The keyring supports the following backends:
-    test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
-                and it should be use only for testing purposes.
+Keys are stored as passphrase encrypted geth V3 keystore files in the eth_keys directory of the
+keyring directory, and are referred to by their Ethereum address.
 `,
 	}
 
 	cmd.AddCommand(
 		AddKeyCommand(),
+		ListKeysCommand(),
+		ShowKeyCommand(),
+		DeleteKeyCommand(),
+		ImportHexKeyCommand(),
+		ImportKeystoreCommand(),
+		ExportKeystoreCommand(),
+		SignHashCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
@@ -67,7 +91,7 @@ func AddKeyCommand() *cobra.Command {
 
 type EthereumKeyOutput struct {
 	PublicKey  string `json:"public_key"`
-	PrivateKey string `json:"private_key"`
+	PrivateKey string `json:"private_key,omitempty"`
 	Address    string `json:"address"`
 }
 
@@ -95,11 +119,10 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 		if errDryRun != nil {
 			fmt.Printf("ErrDryRun issue: %v", errDryRun)
 		}
-		clientCtx, err := client.GetClientQueryContext(cmd)
+		ks, err := ethKeystore(cmd)
 		if err != nil {
 			return err
 		}
-		ks := keystore.NewKeyStore(clientCtx.KeyringDir, keystore.StandardScryptN, keystore.StandardScryptP)
 		passphrase, err := cmd.Flags().GetString(flagPassphrase)
 		if err != nil {
 			return err
@@ -136,3 +159,390 @@ func printCreate(cmd *cobra.Command, keyOutput EthereumKeyOutput) error {
 
 	return nil
 }
+
+// EthereumKeyInfo describes a key in the local ethereum keystore
+type EthereumKeyInfo struct {
+	Address string `json:"address"`
+	Path    string `json:"path"`
+}
+
+// EthereumSignatureOutput is the result of signing a hash with a local ethereum key
+type EthereumSignatureOutput struct {
+	Address   string `json:"address"`
+	Hash      string `json:"hash"`
+	Signature string `json:"signature"`
+}
+
+// ListKeysCommand lists the keys in the local ethereum keystore
+func ListKeysCommand() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the ethereum keys in the keystore",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ks, err := ethKeystore(cmd)
+			if err != nil {
+				return err
+			}
+
+			infos := []EthereumKeyInfo{}
+			lines := []string{}
+			for _, acc := range ks.Accounts() {
+				infos = append(infos, EthereumKeyInfo{Address: acc.Address.Hex(), Path: acc.URL.Path})
+				lines = append(lines, acc.Address.Hex())
+			}
+			return printEthKeysOutput(cmd, strings.Join(lines, "\n"), infos)
+		},
+	}
+	return cmd
+}
+
+// ShowKeyCommand shows a key of the local ethereum keystore
+func ShowKeyCommand() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "show [address]",
+		Short: "Show an ethereum key of the keystore, with --passphrase its public key is shown as well",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ks, err := ethKeystore(cmd)
+			if err != nil {
+				return err
+			}
+			acc, err := findEthKey(ks, args[0])
+			if err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed(flagPassphrase) {
+				info := EthereumKeyInfo{Address: acc.Address.Hex(), Path: acc.URL.Path}
+				return printEthKeysOutput(cmd, fmt.Sprintf("address: %s\npath: %s", info.Address, info.Path), info)
+			}
+
+			passphrase, err := cmd.Flags().GetString(flagPassphrase)
+			if err != nil {
+				return err
+			}
+			privateKey, err := decryptEthKey(acc, passphrase)
+			if err != nil {
+				return err
+			}
+			keyOutput := EthereumKeyOutput{
+				PublicKey: hexutil.Encode(crypto.FromECDSAPub(&privateKey.PublicKey)),
+				Address:   acc.Address.Hex(),
+			}
+			return printEthKeysOutput(cmd, fmt.Sprintf("public: %s \naddress: %s", keyOutput.PublicKey, keyOutput.Address), keyOutput)
+		},
+	}
+	cmd.Flags().String(flagPassphrase, "", "Password the ethereum key is encrypted with")
+	return cmd
+}
+
+// DeleteKeyCommand removes a key from the local ethereum keystore
+func DeleteKeyCommand() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "delete [address]",
+		Short: "Delete an ethereum key from the keystore",
+		Long: `Delete an ethereum key from the keystore. The key's passphrase is required, and unless --yes
+is given the deletion has to be confirmed. Export the key first if it may still be needed.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ks, err := ethKeystore(cmd)
+			if err != nil {
+				return err
+			}
+			acc, err := findEthKey(ks, args[0])
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(cmd.InOrStdin())
+			passphrase, err := readPassphrase(cmd, buf)
+			if err != nil {
+				return err
+			}
+
+			if skip, _ := cmd.Flags().GetBool(flagYes); !skip {
+				yes, err := input.GetConfirmation(fmt.Sprintf("Key %s will be deleted. Continue?", acc.Address.Hex()), buf, cmd.ErrOrStderr())
+				if err != nil {
+					return err
+				}
+				if !yes {
+					return nil
+				}
+			}
+
+			if err := ks.Delete(acc, passphrase); err != nil {
+				return sdkerrors.Wrap(err, "unable to delete key")
+			}
+			cmd.PrintErrln("Key deleted forever (uh oh!)")
+			return nil
+		},
+	}
+	cmd.Flags().String(flagPassphrase, "", "Password the ethereum key is encrypted with, prompted for when not given")
+	cmd.Flags().BoolP(flagYes, "y", false, "Skip the confirmation prompt")
+	return cmd
+}
+
+// ImportHexKeyCommand imports a hex encoded private key into the local ethereum keystore
+func ImportHexKeyCommand() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "import-hex [hex private key]",
+		Short: "Import a hex encoded private ethereum key and encrypt it to disk",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(args[0], "0x"))
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid private key")
+			}
+			ks, err := ethKeystore(cmd)
+			if err != nil {
+				return err
+			}
+			passphrase, err := readNewPassphrase(cmd, bufio.NewReader(cmd.InOrStdin()))
+			if err != nil {
+				return err
+			}
+			acc, err := ks.ImportECDSA(privateKey, passphrase)
+			if err != nil {
+				return err
+			}
+
+			info := EthereumKeyInfo{Address: acc.Address.Hex(), Path: acc.URL.Path}
+			return printEthKeysOutput(cmd, fmt.Sprintf("address: %s\npath: %s", info.Address, info.Path), info)
+		},
+	}
+	cmd.Flags().String(flagPassphrase, "", "Password used to encrypt the ethereum key, prompted for with a confirmation when not given")
+	return cmd
+}
+
+// ImportKeystoreCommand imports a geth V3 keystore file into the local ethereum keystore
+func ImportKeystoreCommand() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "import-keystore [keystore json file]",
+		Short: "Import an ethereum key from a geth V3 keystore JSON file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keyJSON, err := os.ReadFile(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read keystore file")
+			}
+			ks, err := ethKeystore(cmd)
+			if err != nil {
+				return err
+			}
+			passphrase, newPassphrase, err := readPassphrases(cmd, bufio.NewReader(cmd.InOrStdin()))
+			if err != nil {
+				return err
+			}
+			acc, err := ks.Import(keyJSON, passphrase, newPassphrase)
+			if err != nil {
+				return sdkerrors.Wrap(err, "unable to import key")
+			}
+
+			info := EthereumKeyInfo{Address: acc.Address.Hex(), Path: acc.URL.Path}
+			return printEthKeysOutput(cmd, fmt.Sprintf("address: %s\npath: %s", info.Address, info.Path), info)
+		},
+	}
+	cmd.Flags().String(flagPassphrase, "", "Password the keystore file is encrypted with, prompted for when not given")
+	cmd.Flags().String(flagNewPassphrase, "", "Password used to encrypt the imported key, the --passphrase when empty")
+	return cmd
+}
+
+// ExportKeystoreCommand prints a key of the local ethereum keystore as geth V3 keystore JSON
+func ExportKeystoreCommand() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "export [address]",
+		Short: "Export an ethereum key as geth V3 keystore JSON",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ks, err := ethKeystore(cmd)
+			if err != nil {
+				return err
+			}
+			acc, err := findEthKey(ks, args[0])
+			if err != nil {
+				return err
+			}
+			passphrase, newPassphrase, err := readPassphrases(cmd, bufio.NewReader(cmd.InOrStdin()))
+			if err != nil {
+				return err
+			}
+			keyJSON, err := ks.Export(acc, passphrase, newPassphrase)
+			if err != nil {
+				return sdkerrors.Wrap(err, "unable to export key")
+			}
+
+			cmd.Println(string(keyJSON))
+			return nil
+		},
+	}
+	cmd.Flags().String(flagPassphrase, "", "Password the ethereum key is encrypted with, prompted for when not given")
+	cmd.Flags().String(flagNewPassphrase, "", "Password used to encrypt the exported key, the --passphrase when empty")
+	return cmd
+}
+
+// SignHashCommand signs a hash with a key of the local ethereum keystore
+func SignHashCommand() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "sign [address] [hex hash]",
+		Short: "Sign a 32 byte hash with an ethereum key",
+		Long: `Sign a 32 byte hash with an ethereum key the same way orchestrators sign checkpoints, that is
+over the hash prefixed with "\x19Ethereum Signed Message:\n32".
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hash, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid hex hash")
+			}
+			if len(hash) != common.HashLength {
+				return fmt.Errorf("hash must be %d bytes long, got %d", common.HashLength, len(hash))
+			}
+			ks, err := ethKeystore(cmd)
+			if err != nil {
+				return err
+			}
+			acc, err := findEthKey(ks, args[0])
+			if err != nil {
+				return err
+			}
+			passphrase, err := readPassphrase(cmd, bufio.NewReader(cmd.InOrStdin()))
+			if err != nil {
+				return err
+			}
+			privateKey, err := decryptEthKey(acc, passphrase)
+			if err != nil {
+				return err
+			}
+			signature, err := types.NewEthereumSignature(hash, privateKey)
+			if err != nil {
+				return err
+			}
+
+			out := EthereumSignatureOutput{
+				Address:   acc.Address.Hex(),
+				Hash:      hex.EncodeToString(hash),
+				Signature: hex.EncodeToString(signature),
+			}
+			return printEthKeysOutput(cmd, out.Signature, out)
+		},
+	}
+	cmd.Flags().String(flagPassphrase, "", "Password the ethereum key is encrypted with, prompted for when not given")
+	return cmd
+}
+
+// ethKeystore opens the ethereum keystore in the keyring directory
+func ethKeystore(cmd *cobra.Command) (*keystore.KeyStore, error) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(clientCtx.KeyringDir, ethKeystoreDir)
+	return keystore.NewKeyStore(dir, keystore.StandardScryptN, keystore.StandardScryptP), nil
+}
+
+func findEthKey(ks *keystore.KeyStore, address string) (accounts.Account, error) {
+	if err := types.ValidateEthAddress(address); err != nil {
+		return accounts.Account{}, err
+	}
+	//nolint: exhaustivestruct
+	acc, err := ks.Find(accounts.Account{Address: common.HexToAddress(address)})
+	if err != nil {
+		return accounts.Account{}, sdkerrors.Wrapf(err, "key %s", address)
+	}
+	return acc, nil
+}
+
+func decryptEthKey(acc accounts.Account, passphrase string) (*ecdsa.PrivateKey, error) {
+	keyJSON, err := os.ReadFile(acc.URL.Path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to decrypt key")
+	}
+	return key.PrivateKey, nil
+}
+
+// readPassphrase returns the --passphrase flag, prompting for the passphrase a key is encrypted with when it is not
+// given. Keys encrypted elsewhere may have passphrases shorter than the minimum required for new ones.
+func readPassphrase(cmd *cobra.Command, buf *bufio.Reader) (string, error) {
+	if cmd.Flags().Changed(flagPassphrase) {
+		return cmd.Flags().GetString(flagPassphrase)
+	}
+	passphrase, err := input.GetPassword("Enter the key passphrase:", buf)
+	if err != nil && len(passphrase) == 0 {
+		return "", err
+	}
+	return passphrase, nil
+}
+
+// readNewPassphrase returns the --passphrase flag, prompting for a new passphrase and its confirmation when it is
+// not given
+func readNewPassphrase(cmd *cobra.Command, buf *bufio.Reader) (string, error) {
+	if cmd.Flags().Changed(flagPassphrase) {
+		return cmd.Flags().GetString(flagPassphrase)
+	}
+	passphrase, err := input.GetPassword("Enter a passphrase to encrypt the key:", buf)
+	if err != nil {
+		return "", err
+	}
+	confirmation, err := input.GetPassword("Repeat the passphrase:", buf)
+	if err != nil {
+		return "", err
+	}
+	if passphrase != confirmation {
+		return "", errors.New("passphrases don't match")
+	}
+	return passphrase, nil
+}
+
+// readPassphrases returns the --passphrase flag, prompted for when not given, and the --new-passphrase flag
+// defaulting to the former
+func readPassphrases(cmd *cobra.Command, buf *bufio.Reader) (string, string, error) {
+	passphrase, err := readPassphrase(cmd, buf)
+	if err != nil {
+		return "", "", err
+	}
+	newPassphrase, err := cmd.Flags().GetString(flagNewPassphrase)
+	if err != nil {
+		return "", "", err
+	}
+	if newPassphrase == "" {
+		newPassphrase = passphrase
+	}
+	return passphrase, newPassphrase, nil
+}
+
+// printEthKeysOutput prints text or obj as JSON depending on the output flag
+func printEthKeysOutput(cmd *cobra.Command, text string, obj interface{}) error {
+	output, err := cmd.Flags().GetString(cli.OutputFlag)
+	if err != nil {
+		return err
+	}
+
+	switch output {
+	case keys.OutputFormatText:
+		cmd.Println(text)
+
+	case keys.OutputFormatJSON:
+		outputBytes, err := json.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		cmd.Println(string(outputBytes))
+
+	default:
+		return fmt.Errorf("invalid output format %s", output)
+	}
+
+	return nil
+}
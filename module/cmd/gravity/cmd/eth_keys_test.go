@@ -0,0 +1,123 @@
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	gravitycmd "github.com/Gravity-Bridge/Gravity-Bridge/module/cmd/gravity/cmd"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+//nolint: exhaustivestruct
+func TestEthKeysLifecycle(t *testing.T) {
+	home := t.TempDir()
+	run := func(t *testing.T, args ...string) []byte {
+		out := &bytes.Buffer{}
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{})
+		cmd := gravitycmd.Commands(home)
+		cmd.SetOut(out)
+		cmd.SetArgs(append(args, "--output=json"))
+		require.NoError(t, cmd.ExecuteContext(ctx))
+		return out.Bytes()
+	}
+	list := func(t *testing.T) []gravitycmd.EthereumKeyInfo {
+		var infos []gravitycmd.EthereumKeyInfo
+		require.NoError(t, json.Unmarshal(run(t, "list"), &infos))
+		return infos
+	}
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+
+	var imported gravitycmd.EthereumKeyInfo
+	require.NoError(t, json.Unmarshal(run(t, "import-hex", hexutil.Encode(crypto.FromECDSA(privateKey)), "--passphrase=foo"), &imported))
+	require.Equal(t, address, imported.Address)
+	require.Equal(t, filepath.Join(home, "eth_keys"), filepath.Dir(imported.Path))
+	require.Equal(t, []gravitycmd.EthereumKeyInfo{imported}, list(t))
+
+	var shown gravitycmd.EthereumKeyOutput
+	require.NoError(t, json.Unmarshal(run(t, "show", address, "--passphrase=foo"), &shown))
+	require.Equal(t, hexutil.Encode(crypto.FromECDSAPub(&privateKey.PublicKey)), shown.PublicKey)
+	require.Empty(t, shown.PrivateKey)
+
+	hash := crypto.Keccak256([]byte("checkpoint"))
+	var signed gravitycmd.EthereumSignatureOutput
+	require.NoError(t, json.Unmarshal(run(t, "sign", address, hex.EncodeToString(hash), "--passphrase=foo"), &signed))
+	signature, err := hex.DecodeString(signed.Signature)
+	require.NoError(t, err)
+	signer, err := types.EthAddressFromSignature(hash, signature)
+	require.NoError(t, err)
+	require.Equal(t, address, signer.GetAddress().Hex())
+
+	keyJSON := run(t, "export", address, "--passphrase=foo", "--new-passphrase=bar")
+	exportFile := filepath.Join(t.TempDir(), "key.json")
+	require.NoError(t, os.WriteFile(exportFile, keyJSON, 0600))
+
+	run(t, "delete", address, "--passphrase=foo", "--yes")
+	require.Empty(t, list(t))
+
+	run(t, "import-keystore", exportFile, "--passphrase=bar")
+	infos := list(t)
+	require.Len(t, infos, 1)
+	require.Equal(t, address, infos[0].Address)
+
+	// the imported key kept the passphrase of the keystore file
+	require.NoError(t, json.Unmarshal(run(t, "show", address, "--passphrase=bar"), &shown))
+	require.Equal(t, address, shown.Address)
+}
+
+// Tests that the passphrases not given as flags are read from the prompt, new ones with a confirmation
+//nolint: exhaustivestruct
+func TestEthKeysPassphrasePrompt(t *testing.T) {
+	home := t.TempDir()
+	run := func(stdin string, args ...string) ([]byte, error) {
+		out := &bytes.Buffer{}
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{})
+		cmd := gravitycmd.Commands(home)
+		cmd.SetOut(out)
+		cmd.SetIn(strings.NewReader(stdin))
+		cmd.SetArgs(append(args, "--output=json"))
+		err := cmd.ExecuteContext(ctx)
+		return out.Bytes(), err
+	}
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+	hexKey := hexutil.Encode(crypto.FromECDSA(privateKey))
+
+	_, err = run("passphrase1\npassphrase2\n", "import-hex", hexKey)
+	require.EqualError(t, err, "passphrases don't match")
+	_, err = run("short\nshort\n", "import-hex", hexKey)
+	require.Error(t, err)
+	_, err = run("passphrase1\npassphrase1\n", "import-hex", hexKey)
+	require.NoError(t, err)
+
+	hash := crypto.Keccak256([]byte("checkpoint"))
+	_, err = run("wrong passphrase\n", "sign", address, hex.EncodeToString(hash))
+	require.Error(t, err)
+	out, err := run("passphrase1\n", "sign", address, hex.EncodeToString(hash))
+	require.NoError(t, err)
+	var signed gravitycmd.EthereumSignatureOutput
+	require.NoError(t, json.Unmarshal(out, &signed))
+	require.Equal(t, address, signed.Address)
+
+	// the passphrase is read before the deletion is confirmed
+	_, err = run("passphrase1\ny\n", "delete", address)
+	require.NoError(t, err)
+	out, err = run("", "list")
+	require.NoError(t, err)
+	require.Equal(t, "[]\n", string(out))
+}
@@ -3,6 +3,8 @@ package cmd
 import (
 	"bufio"
 	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,6 +15,7 @@ import (
 	"strings"
 
 	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -38,6 +41,11 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+const (
+	flagEthSignature  = "eth-signature"
+	flagEthPassphrase = "eth-passphrase"
+)
+
 // GenTxCmd builds the application's gentx command.
 //nolint:gocyclo
 func GenTxCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfig, genBalIterator types.GenesisBalancesIterator, defaultNodeHome string) *cobra.Command {
@@ -55,7 +63,8 @@ func GenTxCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfig, genBalI
 		Long: fmt.Sprintf(`Generate a genesis transaction that creates a validator with a self-delegation, oracle key 
 delegation and orchestrator key delegation that is signed by the key in the Keyring referenced by a given name. A node 
 ID and Bech32 consensus pubkey may optionally be provided. If they are omitted, they will be retrieved from the 
-priv_validator.json file. The following default parameters are included:
+priv_validator.json file. The ethereum key proves that the validator controls it by signing the delegate keys
+sign hash, by default the key is taken from the eth_keys keystore. The following default parameters are included:
     %s
 
 Example:
@@ -197,10 +206,16 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 				return errors.Wrap(err, "failed to build create-validator message")
 			}
 
+			ethSignature, err := genTxEthSignature(cmd, cdc, genesisState, sdk.ValAddress(key.GetAddress()), orchAddress, ethAddress)
+			if err != nil {
+				return errors.Wrap(err, "failed to prove possession of the ethereum key")
+			}
+
 			delegateKeySetMsg := &gravitytypes.MsgSetOrchestratorAddress{
 				Validator:    sdk.ValAddress(key.GetAddress()).String(),
 				Orchestrator: orchAddress.String(),
 				EthAddress:   ethAddress,
+				EthSignature: ethSignature,
 			}
 
 			msgs := []sdk.Msg{msg, delegateKeySetMsg}
@@ -258,12 +273,66 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the genesis transaction JSON document to the given file instead of the default location")
 	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.Flags().String(flagEthPrivateKey, "", "Hex encoded private key of the ethereum address, used instead of the eth_keys keystore")
+	cmd.Flags().String(flagEthSignature, "", "Hex encoded signature of the ethereum address over the delegate keys sign hash, used instead of signing")
+	cmd.Flags().String(flagEthPassphrase, "default", "Password of the ethereum key in the eth_keys keystore")
 	cmd.Flags().AddFlagSet(fsCreateValidator)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// genTxEthSignature returns the hex encoded proof of possession of the ethereum delegate key. It is taken from
+// --eth-signature, made with --eth-private-key, or made with the key of the eth_keys keystore
+func genTxEthSignature(
+	cmd *cobra.Command, cdc codec.JSONCodec, genesisState map[string]json.RawMessage,
+	val sdk.ValAddress, orch sdk.AccAddress, ethAddress string,
+) (string, error) {
+	if signature, err := cmd.Flags().GetString(flagEthSignature); err != nil || signature != "" {
+		return strings.TrimPrefix(signature, "0x"), err
+	}
+
+	var gravityGenesis gravitytypes.GenesisState
+	if err := cdc.UnmarshalJSON(genesisState[gravitytypes.ModuleName], &gravityGenesis); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal gravity genesis state")
+	}
+	// the delegate key nonce of every validator is zero at genesis
+	hash := gravitytypes.GetDelegateKeysSignHash(gravityGenesis.Params.GravityId, val, orch, 0)
+
+	var privateKey *ecdsa.PrivateKey
+	privateKeyHex, err := cmd.Flags().GetString(flagEthPrivateKey)
+	if err != nil {
+		return "", err
+	}
+	if privateKeyHex != "" {
+		if privateKey, err = ethcrypto.HexToECDSA(strings.TrimPrefix(privateKeyHex, "0x")); err != nil {
+			return "", errors.Wrap(err, "invalid ethereum private key")
+		}
+	} else {
+		ks, err := ethKeystore(cmd)
+		if err != nil {
+			return "", err
+		}
+		acc, err := findEthKey(ks, ethAddress)
+		if err != nil {
+			return "", errors.Wrapf(err, "pass --%s or --%s if the key is not in the eth_keys keystore", flagEthPrivateKey, flagEthSignature)
+		}
+		passphrase, err := cmd.Flags().GetString(flagEthPassphrase)
+		if err != nil {
+			return "", err
+		}
+		if privateKey, err = decryptEthKey(acc, passphrase); err != nil {
+			return "", err
+		}
+	}
+
+	signature, err := gravitytypes.NewEthereumSignature(hash, privateKey)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(signature), nil
+}
+
 func makeOutputFilepath(rootDir, nodeID string) (string, error) {
 	writePath := filepath.Join(rootDir, "config", "gentx")
 	if err := tmos.EnsureDir(writePath, 0700); err != nil {
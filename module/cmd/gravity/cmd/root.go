@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/CosmWasm/wasmd/x/wasm"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/debug"
@@ -32,6 +33,7 @@ import (
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/app"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/app/params"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/streaming"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -74,6 +76,9 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 func initAppConfig() (string, interface{}) {
 	type GravityAppConfig struct {
 		serverconfig.Config
+
+		GravityStreaming    streaming.Config       `mapstructure:"gravity-streaming"`
+		GravityFeeExemption app.FeeExemptionConfig `mapstructure:"gravity-fee-exemption"`
 	}
 
 	// DEFAULT SERVER CONFIGURATIONS
@@ -82,12 +87,14 @@ func initAppConfig() (string, interface{}) {
 	// CUSTOM APP CONFIG - add members to this struct to add gravity-specific configuration options
 	// NOTE: Make sure config options are explained with their default values in gravityAppTemplate
 	gravityAppConfig := GravityAppConfig{
-		Config: *srvConfig,
+		Config:              *srvConfig,
+		GravityStreaming:    streaming.DefaultConfig(),
+		GravityFeeExemption: app.DefaultFeeExemptionConfig(),
 	}
 
 	// CUSTOM CONFIG TEMPLATE - add to this string when adding gravity-specific configurations have been added to
 	// GravityAppConfig above, an example can be seen at https://github.com/cosmos/cosmos-sdk/blob/master/simapp/simd/cmd/root.go
-	gravityAppTemplate := serverconfig.DefaultConfigTemplate
+	gravityAppTemplate := serverconfig.DefaultConfigTemplate + streaming.ConfigTemplate + app.FeeExemptionConfigTemplate
 
 	return gravityAppTemplate, gravityAppConfig
 }
@@ -125,6 +132,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		testnetCmd(app.ModuleBasics, banktypes.GenesisBalancesIterator{}),
 		debug.Cmd(),
 		MigrateGravityGenesisCmd(),
+		UpgradeDryRunCmd(app.DefaultNodeHome),
 	)
 
 	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, createSimappAndExport, addModuleInitFlags)
@@ -136,11 +144,13 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		txCommand(),
 		keys.Commands(app.DefaultNodeHome),
 		Commands(app.DefaultNodeHome),
+		CheckpointCommands(),
 	)
 }
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	wasm.AddModuleInitFlags(startCmd)
 }
 
 func queryCommand() *cobra.Command {
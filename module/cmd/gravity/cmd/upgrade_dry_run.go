@@ -0,0 +1,210 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/cli"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/app"
+)
+
+const (
+	flagGenesis   = "genesis"
+	flagBlockTime = "block-time"
+)
+
+// UpgradeDryRunCmd rehearses an upgrade against the state of a node or an exported genesis without committing anything
+func UpgradeDryRunCmd(defaultNodeHome string) *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "upgrade-dry-run [plan-name]",
+		Short: "Rehearse an upgrade against the current state without committing anything",
+		Long: `Rehearse the upgrade with the given plan name offline.
+
+The application database in the data directory of --home is opened read-only, or with --genesis the state is
+initialized in memory from an exported genesis file. The upgrade handler registered for the plan, and with it
+the module migrations, is then applied at the height after the last committed block, followed by every
+registered invariant. The report lists the failure of the upgrade handler if any, the broken invariants,
+the number of keys added, modified and deleted in each module store, and how long each step took.
+
+The stores added, deleted or renamed by the plan are upgraded in memory, as the node would when restarting at the
+upgrade height. Wasm code is compiled in a copy of the wasm directory of --home which is removed afterwards.
+
+The node must be stopped while it is rehearsed, the command exits with an error if the rehearsal failed.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			home, err := cmd.Flags().GetString(flags.FlagHome)
+			if err != nil {
+				return err
+			}
+			genesisFile, err := cmd.Flags().GetString(flagGenesis)
+			if err != nil {
+				return err
+			}
+			blockTime, err := cmd.Flags().GetString(flagBlockTime)
+			if err != nil {
+				return err
+			}
+			upgradeTime := time.Now().UTC()
+			if blockTime != "" {
+				upgradeTime, err = time.Parse(time.RFC3339, blockTime)
+				if err != nil {
+					return fmt.Errorf("invalid --%s: %v", flagBlockTime, err)
+				}
+			}
+
+			var db dbm.DB
+			if genesisFile != "" {
+				db = dbm.NewMemDB()
+			} else {
+				// nolint: exhaustivestruct
+				db, err = dbm.NewGoLevelDBWithOpts("application", filepath.Join(home, "data"), &opt.Options{ReadOnly: true})
+				if err != nil {
+					return fmt.Errorf("unable to open the application database of %s: %v", home, err)
+				}
+			}
+			defer db.Close()
+
+			// The wasm VM writes to its directory, keep the node's wasm directory untouched
+			wasmDir, err := ioutil.TempDir("", "gravity-upgrade-dry-run-wasm")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(wasmDir)
+			if err := copyDir(filepath.Join(home, "wasm"), wasmDir); err != nil {
+				return fmt.Errorf("unable to copy the wasm directory of %s: %v", home, err)
+			}
+			serverCtx.Viper.Set(app.FlagWasmDir, wasmDir)
+
+			gravity := app.NewGravityApp(
+				serverCtx.Logger, db, nil, false, map[int64]bool{}, home, 0, app.MakeEncodingConfig(), serverCtx.Viper,
+			)
+			if genesisFile != "" {
+				if err := gravity.LoadLatestVersion(); err != nil {
+					return err
+				}
+				if err := initChainFromGenesis(gravity, genesisFile); err != nil {
+					return err
+				}
+			} else if err := gravity.LoadUpgradeDryRunVersion(args[0]); err != nil {
+				return fmt.Errorf("unable to load the application database of %s: %v", home, err)
+			}
+
+			report, err := gravity.UpgradeDryRun(args[0], upgradeTime)
+			if err != nil {
+				return err
+			}
+			if err := printUpgradeDryRunReport(cmd, report); err != nil {
+				return err
+			}
+			if report.Failed() {
+				return fmt.Errorf("upgrade %s failed", report.PlanName)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The node's home directory")
+	cmd.Flags().String(flagGenesis, "", "Rehearse against the state in this exported genesis file instead of the node's database")
+	cmd.Flags().String(flagBlockTime, "", "The RFC3339 block time to run the upgrade at, defaults to now")
+	cmd.Flags().String(cli.OutputFlag, "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// initChainFromGenesis initializes the empty gravity app from the genesis file and commits the result
+func initChainFromGenesis(gravity *app.Gravity, genesisFile string) error {
+	genDoc, err := tmtypes.GenesisDocFromFile(genesisFile)
+	if err != nil {
+		return fmt.Errorf("unable to read genesis file: %v", err)
+	}
+
+	// nolint: exhaustivestruct
+	req := abci.RequestInitChain{
+		Time:          genDoc.GenesisTime,
+		ChainId:       genDoc.ChainID,
+		AppStateBytes: genDoc.AppState,
+		InitialHeight: genDoc.InitialHeight,
+	}
+	if genDoc.ConsensusParams != nil {
+		req.ConsensusParams = tmtypes.TM2PB.ConsensusParams(genDoc.ConsensusParams)
+	}
+	gravity.InitChain(req)
+	gravity.Commit()
+	return nil
+}
+
+// copyDir copies the files in src and its subdirectories to dst, nothing is copied if src does not exist
+func copyDir(src, dst string) error {
+	if _, err := os.Stat(src); os.IsNotExist(err) {
+		return nil
+	}
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode())
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(target, data, info.Mode())
+	})
+}
+
+func printUpgradeDryRunReport(cmd *cobra.Command, report app.UpgradeDryRunReport) error {
+	output, err := cmd.Flags().GetString(cli.OutputFlag)
+	if err != nil {
+		return err
+	}
+
+	if output == "json" {
+		reportJSON, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+		cmd.Println(string(reportJSON))
+		return nil
+	}
+
+	cmd.Printf("upgrade %s at height %d\n", report.PlanName, report.Height)
+	if report.UpgradeError != "" {
+		cmd.Printf("upgrade handler failed: %s\n", report.UpgradeError)
+	}
+	for _, broken := range report.BrokenInvariants {
+		cmd.Printf("broken invariant %s\n", broken)
+	}
+	cmd.Println("\nstore diffs (added/modified/deleted keys):")
+	for _, diff := range report.StoreDiffs {
+		cmd.Printf("  %-20s %d/%d/%d\n", diff.Store, diff.Added, diff.Modified, diff.Deleted)
+	}
+	cmd.Println("\ntimings:")
+	for _, timing := range report.Timings {
+		cmd.Printf("  %-30s %s\n", timing.Step, timing.Duration)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package cmd_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/app"
+	v3 "github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades/v3"
+	gravitycmd "github.com/Gravity-Bridge/Gravity-Bridge/module/cmd/gravity/cmd"
+)
+
+// nolint: exhaustivestruct
+func TestUpgradeDryRunFromGenesis(t *testing.T) {
+	appState, err := json.Marshal(app.NewDefaultGenesisState())
+	require.NoError(t, err)
+	genDoc := tmtypes.GenesisDoc{
+		GenesisTime: time.Now().UTC(),
+		ChainID:     "gravity-test",
+		AppState:    appState,
+	}
+	genesisFile := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, genDoc.SaveAs(genesisFile))
+
+	run := func(planName string) (app.UpgradeDryRunReport, error) {
+		out := &bytes.Buffer{}
+		cmd := gravitycmd.UpgradeDryRunCmd(t.TempDir())
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{
+			planName,
+			fmt.Sprintf("--genesis=%s", genesisFile),
+			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		})
+		err := cmd.Execute()
+
+		var report app.UpgradeDryRunReport
+		if err == nil {
+			require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+		}
+		return report, err
+	}
+
+	report, err := run(v3.V2ToV3PlanName)
+	require.NoError(t, err)
+	require.Equal(t, v3.V2ToV3PlanName, report.PlanName)
+	require.Equal(t, int64(2), report.Height)
+	require.Empty(t, report.UpgradeError)
+	require.Empty(t, report.BrokenInvariants)
+	require.NotEmpty(t, report.Timings)
+	// marking the plan as done and bumping the protocol version add to the upgrade store
+	require.Contains(t, report.StoreDiffs, app.UpgradeDryRunDiff{Store: "upgrade", Added: 2})
+
+	_, err = run("unknown")
+	require.Error(t, err)
+}
+
+// nolint: exhaustivestruct
+func TestUpgradeDryRunFromDatabase(t *testing.T) {
+	appState, err := json.Marshal(app.NewDefaultGenesisState())
+	require.NoError(t, err)
+
+	// commit the genesis state to the database of a node home
+	home := t.TempDir()
+	db, err := dbm.NewGoLevelDB("application", filepath.Join(home, "data"))
+	require.NoError(t, err)
+	gravity := app.NewGravityApp(
+		log.NewNopLogger(), db, nil, true, map[int64]bool{}, t.TempDir(), 0, app.MakeEncodingConfig(), viper.New(),
+	)
+	gravity.InitChain(abci.RequestInitChain{
+		Time:          time.Now().UTC(),
+		ChainId:       "gravity-test",
+		AppStateBytes: appState,
+	})
+	gravity.Commit()
+	require.NoError(t, db.Close())
+
+	out := &bytes.Buffer{}
+	cmd := gravitycmd.UpgradeDryRunCmd(home)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{v3.V2ToV3PlanName, fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	require.NoError(t, cmd.Execute())
+
+	var report app.UpgradeDryRunReport
+	require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+	require.Equal(t, int64(2), report.Height)
+	require.Empty(t, report.UpgradeError)
+	require.Empty(t, report.BrokenInvariants)
+
+	// the wasm VM did not write to the node home
+	_, err = os.Stat(filepath.Join(home, "wasm"))
+	require.True(t, os.IsNotExist(err))
+}
@@ -1,41 +1,47 @@
 package gravity
 
 import (
+
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// EndBlocker is called at the end of every block
+// EndBlocker is called at the end of every block. Each phase processes at most MaxEndBlockerItems items, so that a
+// backlog can not make a block arbitrarily slow, and continues with the remaining items in the next block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	params := k.GetParams(ctx)
 	slashing(ctx, k)
 	attestationTally(ctx, k)
-	cleanupTimedOutBatches(ctx, k)
-	cleanupTimedOutLogicCalls(ctx, k)
-	createValsets(ctx, k)
+	cleanupTimedOutBatches(ctx, k, params)
+	cleanupTimedOutLogicCalls(ctx, k, params)
+	createValsets(ctx, k, params)
 	pruneValsets(ctx, k, params)
-	pruneAttestations(ctx, k)
+	pruneAttestations(ctx, k, params)
+	k.PrunePastEthSignatureCheckpoints(ctx)
+	k.UpdateBridgeGauges(ctx)
 }
 
-func createValsets(ctx sdk.Context, k keeper.Keeper) {
+func createValsets(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	// Auto ValsetRequest Creation.
 	// WARNING: do not use k.GetLastObservedValset in this function, it *will* result in losing control of the bridge
 	// 1. If there are no valset requests, create a new one.
 	// 2. If there is at least one validator who started unbonding in current block. (we persist last unbonded block height in hooks.go)
 	//      This will make sure the unbonding validator has to provide an attestation to a new Valset
 	//	    that excludes him before he completely Unbonds.  Otherwise he will be slashed
-	// 3. If power change between validators of CurrentValset and latest valset request is > 5%
+	// 3. If power change between validators of CurrentValset and latest valset request is > ValsetPowerDiffThreshold
+	// 4. If the latest valset request is older than MaxValsetAgeBlocks or MaxValsetAgeTime, so that slow drift
+	//      below the power threshold still reaches Ethereum eventually
 
 	// get the last valsets to compare against
 	latestValset := k.GetLatestValset(ctx)
 	lastUnbondingHeight := k.GetLastUnBondingBlockHeight(ctx)
 
 	significantPowerDiff := false
+	valsetExpired := false
 	if latestValset != nil {
-		vs, err := k.GetCurrentValset(ctx)
+		powerDiff, err := k.GetValsetPowerDiff(ctx, *latestValset)
 		if err != nil {
 			// this condition should only occur in the simulator
 			// ref : https://github.com/Gravity-Bridge/Gravity-Bridge/issues/35
@@ -47,19 +53,12 @@ func createValsets(ctx sdk.Context, k keeper.Keeper) {
 			}
 			panic(err)
 		}
-		intCurrMembers, err := types.BridgeValidators(vs.Members).ToInternal()
-		if err != nil {
-			panic(sdkerrors.Wrap(err, "invalid current valset members"))
-		}
-		intLatestMembers, err := types.BridgeValidators(latestValset.Members).ToInternal()
-		if err != nil {
-			panic(sdkerrors.Wrap(err, "invalid latest valset members"))
-		}
 
-		significantPowerDiff = intCurrMembers.PowerDiff(*intLatestMembers) > 0.05
+		significantPowerDiff = powerDiff > params.ValsetPowerDiffThreshold.MustFloat64()
+		valsetExpired = k.IsValsetExpired(ctx, *latestValset, params)
 	}
 
-	if (latestValset == nil) || (lastUnbondingHeight == uint64(ctx.BlockHeight())) || significantPowerDiff {
+	if (latestValset == nil) || (lastUnbondingHeight == uint64(ctx.BlockHeight())) || significantPowerDiff || valsetExpired {
 		// if the conditions are true, put in a new validator set request to be signed and submitted to Ethereum
 		k.SetValsetRequest(ctx)
 	}
@@ -77,12 +76,19 @@ func pruneValsets(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	tooEarly := currentBlock < params.SignedValsetsWindow
 	if lastObserved != nil && !tooEarly {
 		earliestToPrune := currentBlock - params.SignedValsetsWindow
-		sets := k.GetValsets(ctx)
-		for _, set := range sets {
-			if set.Nonce < lastObserved.Nonce && set.Height < earliestToPrune {
-				k.DeleteValset(ctx, set.Nonce)
-				k.DeleteValsetConfirms(ctx, set.Nonce)
+		// valsets are iterated in nonce order and created in height order, so the iteration stops at the first
+		// valset which must be kept
+		var prunable []uint64
+		k.IterateValsetBySlashedValsetNonce(ctx, 0, func(_ []byte, set *types.Valset) bool {
+			if uint64(len(prunable)) >= params.MaxEndBlockerItems || set.Nonce >= lastObserved.Nonce || set.Height >= earliestToPrune {
+				return true
 			}
+			prunable = append(prunable, set.Nonce)
+			return false
+		})
+		for _, nonce := range prunable {
+			k.DeleteValset(ctx, nonce)
+			k.DeleteValsetConfirms(ctx, nonce)
 		}
 	}
 }
@@ -96,9 +102,11 @@ func slashing(ctx sdk.Context, k keeper.Keeper) {
 	logicCallSlashing(ctx, k, params)
 }
 
-// Iterate over all attestations currently being voted on in order of nonce and
-// "Observe" those who have passed the threshold. Break the loop once we see
-// an attestation that has not passed the threshold
+// Iterate over the attestations currently being voted on in order of nonce and
+// "Observe" those who have passed the threshold. Stop once we see a nonce at which
+// no attestation has passed the threshold. Only the attestations above the last
+// observed event nonce are read and at most MaxEndBlockerItems of them are tallied,
+// the last observed event nonce is where the next block continues
 func attestationTally(ctx sdk.Context, k keeper.Keeper) {
 	params := k.GetParams(ctx)
 	// bridge is currently disabled, do not process attestations from Ethereum
@@ -106,35 +114,25 @@ func attestationTally(ctx sdk.Context, k keeper.Keeper) {
 		return
 	}
 
-	attmap, keys := k.GetAttestationMapping(ctx)
-
-	// This iterates over all keys (event nonces) in the attestation mapping. Each value contains
-	// a slice with one or more attestations at that event nonce. There can be multiple attestations
-	// at one event nonce when validators disagree about what event happened at that nonce.
-	for _, nonce := range keys {
-		// This iterates over all attestations at a particular event nonce.
-		// They are ordered by when the first attestation at the event nonce was received.
-		// This order is not important.
-		for _, att := range attmap[nonce] {
-			// We check if the event nonce is exactly 1 higher than the last attestation that was
-			// observed. If it is not, we just move on to the next nonce. This will skip over all
-			// attestations that have already been observed.
-			//
-			// Once we hit an event nonce that is one higher than the last observed event, we stop
-			// skipping over this conditional and start calling tryAttestation (counting votes)
-			// Once an attestation at a given event nonce has enough votes and becomes observed,
-			// every other attestation at that nonce will be skipped, since the lastObservedEventNonce
-			// will be incremented.
-			//
-			// Then we go to the next event nonce in the attestation mapping, if there is one. This
-			// nonce will once again be one higher than the lastObservedEventNonce.
-			// If there is an attestation at this event nonce which has enough votes to be observed,
-			// we skip the other attestations and move on to the next nonce again.
-			// If no attestation becomes observed, when we get to the next nonce, every attestation in
-			// it will be skipped. The same will happen for every nonce after that.
-			if nonce == uint64(k.GetLastObservedEventNonce(ctx))+1 {
-				k.TryAttestation(ctx, &att)
+	tallied := uint64(0)
+	for tallied < params.MaxEndBlockerItems {
+		nonce := k.GetLastObservedEventNonce(ctx) + 1
+		// There can be multiple attestations at one event nonce when validators disagree about
+		// what event happened at that nonce. They are ordered by claim hash, this order is not important.
+		attestations := k.GetAttestationsAtNonce(ctx, nonce)
+		for _, att := range attestations {
+			// Once an attestation at this nonce has enough votes and becomes observed, every
+			// other attestation at the nonce is skipped
+			if tallied >= params.MaxEndBlockerItems || k.GetLastObservedEventNonce(ctx) >= nonce {
+				break
 			}
+			att := att
+			k.TryAttestation(ctx, &att)
+			tallied++
+		}
+		// If no attestation became observed no later nonce can be observed either
+		if k.GetLastObservedEventNonce(ctx) < nonce {
+			return
 		}
 	}
 }
@@ -148,17 +146,18 @@ func attestationTally(ctx sdk.Context, k keeper.Keeper) {
 //    here is the Ethereum block height at the time of the last Deposit or Withdraw to be observed. It's very important we do not
 //    project, if we do a slowdown on ethereum could cause a double spend. Instead timeouts will *only* occur after the timeout period
 //    AND any deposit or withdraw has occurred to update the Ethereum block height.
-func cleanupTimedOutBatches(ctx sdk.Context, k keeper.Keeper) {
+// D) At most MaxEndBlockerItems batches are checked per block, the next block continues after the last one checked
+func cleanupTimedOutBatches(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight
-	batches := k.GetOutgoingTxBatches(ctx)
-	for _, batch := range batches {
+	k.IterateOutgoingTXBatchesWithCursor(ctx, types.BatchTimeoutCursorKey, params.MaxEndBlockerItems, func(batch types.InternalOutgoingTxBatch) {
 		if batch.BatchTimeout < ethereumHeight {
 			err := k.CancelOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce)
 			if err != nil {
 				panic("Failed to cancel outgoing txbatch!")
 			}
+			keeper.IncrTimedOutBatchCounter(ctx, batch.TokenContract)
 		}
-	}
+	})
 }
 
 // cleanupTimedOutBatches deletes logic calls that have passed their expiration on Ethereum
@@ -170,17 +169,17 @@ func cleanupTimedOutBatches(ctx sdk.Context, k keeper.Keeper) {
 //    here is the Ethereum block height at the time of the last Deposit or Withdraw to be observed. It's very important we do not
 //    project, if we do a slowdown on ethereum could cause a double spend. Instead timeouts will *only* occur after the timeout period
 //    AND any deposit or withdraw has occurred to update the Ethereum block height.
-func cleanupTimedOutLogicCalls(ctx sdk.Context, k keeper.Keeper) {
+// D) At most MaxEndBlockerItems calls are checked per block, the next block continues after the last one checked
+func cleanupTimedOutLogicCalls(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight
-	calls := k.GetOutgoingLogicCalls(ctx)
-	for _, call := range calls {
+	k.IterateOutgoingLogicCallsWithCursor(ctx, types.LogicCallTimeoutCursorKey, params.MaxEndBlockerItems, func(call types.OutgoingLogicCall) {
 		if call.Timeout < ethereumHeight {
 			err := k.CancelOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce)
 			if err != nil {
 				panic("Failed to cancel outgoing logic call!")
 			}
 		}
-	}
+	})
 }
 
 // prepValsetConfirms loads all confirmations into a hashmap indexed by validatorAddr
@@ -213,7 +212,8 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 		return
 	}
 
-	unslashedValsets := k.GetUnSlashedValsets(ctx, params.SignedValsetsWindow)
+	// the valsets are in nonce order and the last slashed valset nonce is where the next block continues
+	unslashedValsets := k.GetUnSlashedValsets(ctx, params.SignedValsetsWindow, params.MaxEndBlockerItems)
 
 	currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	unbondingValidators := getUnbondingValidators(ctx, k)
@@ -242,6 +242,7 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 					val = updateValidator(ctx, k, val.GetOperator())
 					if !val.IsJailed() {
 						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionValset)
+						keeper.IncrSlashCounter(ctx, keeper.SlashReasonValset)
 						ctx.EventManager().EmitTypedEvent(
 							&types.EventSignatureSlashing{
 								Type:    types.AttributeKeyValsetSignatureSlashing,
@@ -287,6 +288,7 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 					validator = updateValidator(ctx, k, validator.GetOperator())
 					if !validator.IsJailed() {
 						k.StakingKeeper.Slash(ctx, valConsAddr, ctx.BlockHeight(), validator.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionValset)
+						keeper.IncrSlashCounter(ctx, keeper.SlashReasonUnbondingValset)
 						ctx.EventManager().EmitTypedEvent(
 							&types.EventSignatureSlashing{
 								Type:    types.AttributeKeyValsetSignatureSlashing,
@@ -372,7 +374,8 @@ func batchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	}
 
 	currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
-	unslashedBatches := k.GetUnSlashedBatches(ctx, maxHeight)
+	// the last slashed batch block is where the next block continues, so the batches are slashed in block order
+	unslashedBatches := k.GetUnSlashedBatches(ctx, maxHeight, params.MaxEndBlockerItems)
 	for _, batch := range unslashedBatches {
 		// SLASH BONDED VALIDTORS who didn't attest batch requests
 		confirms := prepBatchConfirms(ctx, k, batch)
@@ -394,6 +397,7 @@ func batchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 					val = updateValidator(ctx, k, val.GetOperator())
 					if !val.IsJailed() {
 						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionBatch)
+						keeper.IncrSlashCounter(ctx, keeper.SlashReasonBatch)
 						ctx.EventManager().EmitTypedEvent(
 							&types.EventSignatureSlashing{
 								Type:    types.AttributeKeyBatchSignatureSlashing,
@@ -450,7 +454,8 @@ func logicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	}
 
 	currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
-	unslashedLogicCalls := k.GetUnSlashedLogicCalls(ctx, maxHeight)
+	// the last slashed logic call block is where the next block continues, so the calls are slashed in block order
+	unslashedLogicCalls := k.GetUnSlashedLogicCalls(ctx, maxHeight, params.MaxEndBlockerItems)
 	for _, call := range unslashedLogicCalls {
 
 		// SLASH BONDED VALIDTORS who didn't attest batch requests
@@ -471,6 +476,7 @@ func logicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 					val = updateValidator(ctx, k, val.GetOperator())
 					if !val.IsJailed() {
 						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionLogicCall)
+						keeper.IncrSlashCounter(ctx, keeper.SlashReasonLogicCall)
 						ctx.EventManager().EmitTypedEvent(
 							&types.EventSignatureSlashing{
 								Type:    types.AttributeKeyLogicCallSignatureSlashing,
@@ -487,38 +493,53 @@ func logicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	}
 }
 
-// Iterate over all attestations currently being voted on in order of nonce
-// and prune those that are older than the current nonce and no longer have any
-// use. This could be combined with create attestation and save some computation
-// but (A) pruning keeps the iteration small in the first place and (B) there is
-// already enough nuance in the other handler that it's best not to complicate it further
-func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
-	attmap, keys := k.GetAttestationMapping(ctx)
-
+// Iterate over the oldest attestations in order of nonce and prune those that
+// are older than the current nonce and no longer have any use. This could be
+// combined with create attestation and save some computation but (A) pruning
+// keeps the iteration small in the first place and (B) there is already enough
+// nuance in the other handler that it's best not to complicate it further.
+// At most MaxEndBlockerItems attestations are pruned per block, the attestations of a nonce are pruned together.
+func pruneAttestations(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	// we delete all attestations earlier than the current event nonce
-	// minus some buffer value. This buffer value is purely to allow
-	// frontends and other UI components to view recent oracle history
-	const eventsToKeep = 1000
+	// minus some buffer value, see keeper.AttestationEventsToKeep
 	lastNonce := uint64(k.GetLastObservedEventNonce(ctx))
 	var cutoff uint64
-	if lastNonce <= eventsToKeep {
+	if lastNonce <= keeper.AttestationEventsToKeep {
 		return
 	} else {
-		cutoff = lastNonce - eventsToKeep
+		cutoff = lastNonce - keeper.AttestationEventsToKeep
 	}
 
-	// This iterates over all keys (event nonces) in the attestation mapping. Each value contains
-	// a slice with one or more attestations at that event nonce. There can be multiple attestations
-	// at one event nonce when validators disagree about what event happened at that nonce.
-	for _, nonce := range keys {
-		// This iterates over all attestations at a particular event nonce.
-		// They are ordered by when the first attestation at the event nonce was received.
-		// This order is not important.
-		for _, att := range attmap[nonce] {
-			// delete all before the cutoff
-			if nonce < cutoff {
-				k.DeleteAttestation(ctx, att)
+	// collect the attestations first, the store must not be written to while it is iterated. Only whole nonces
+	// are pruned, a nonce whose observed attestation is gone while others are left would look unobserved
+	var (
+		nonces  []uint64
+		byNonce = make(map[uint64][]types.Attestation)
+		count   uint64
+	)
+	k.IterateAttestationsFromNonce(ctx, 0, func(nonce uint64, att types.Attestation) bool {
+		if nonce >= cutoff {
+			return true
+		}
+		if _, ok := byNonce[nonce]; !ok {
+			// every nonce collected so far is complete
+			if count >= params.MaxEndBlockerItems {
+				return true
 			}
+			nonces = append(nonces, nonce)
+		}
+		byNonce[nonce] = append(byNonce[nonce], att)
+		count++
+		return false
+	})
+	// the last nonce is left for the next block if it does not fit, unless it is the only one so that a nonce with
+	// more attestations than MaxEndBlockerItems does not stop pruning altogether
+	if count > params.MaxEndBlockerItems && len(nonces) > 1 {
+		nonces = nonces[:len(nonces)-1]
+	}
+	for _, nonce := range nonces {
+		for _, att := range byNonce[nonce] {
+			k.DeleteAttestation(ctx, att)
 		}
 	}
 }
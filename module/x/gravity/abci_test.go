@@ -2,9 +2,11 @@ package gravity
 
 import (
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/stretchr/testify/assert"
@@ -341,7 +343,7 @@ func TestNonValidatorBatchConfirm(t *testing.T) {
 	})
 	require.NoError(t, err)
 	pk.StoreBatch(ctx, *batch)
-	unslashedBatches := pk.GetUnSlashedBatches(ctx, uint64(ctx.BlockHeight()))
+	unslashedBatches := pk.GetUnSlashedBatches(ctx, uint64(ctx.BlockHeight()), math.MaxUint64)
 	assert.True(t, len(unslashedBatches) == 1 && unslashedBatches[0].BatchNonce == 1)
 
 	for i, orch := range keeper.OrchAddrs {
@@ -394,7 +396,7 @@ func TestBatchSlashing(t *testing.T) {
 	})
 	require.NoError(t, err)
 	pk.StoreBatch(ctx, *batch)
-	unslashedBatches := pk.GetUnSlashedBatches(ctx, uint64(ctx.BlockHeight()))
+	unslashedBatches := pk.GetUnSlashedBatches(ctx, uint64(ctx.BlockHeight()), math.MaxUint64)
 	assert.True(t, len(unslashedBatches) == 1 && unslashedBatches[0].BatchNonce == 1)
 
 	for i, orch := range keeper.OrchAddrs {
@@ -440,7 +442,7 @@ func TestBatchSlashing(t *testing.T) {
 	// Ensure that the last slashed valset nonce is set properly
 	lastSlashedBatchBlock := input.GravityKeeper.GetLastSlashedBatchBlock(ctx)
 	assert.Equal(t, lastSlashedBatchBlock, batch.Block)
-	assert.True(t, len(pk.GetUnSlashedBatches(ctx, uint64(ctx.BlockHeight()))) == 0)
+	assert.True(t, len(pk.GetUnSlashedBatches(ctx, uint64(ctx.BlockHeight()), math.MaxUint64)) == 0)
 
 }
 
@@ -468,6 +470,81 @@ func TestValsetEmission(t *testing.T) {
 	require.True(t, len(valsets) == 2)
 }
 
+func TestValsetEmissionBelowPowerDiffThreshold(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.ValsetPowerDiffThreshold = sdk.NewDecWithPrec(10, 2)
+	pk.SetParams(ctx, params)
+
+	// Store a validator set with a 5% power change, which is below the configured 10% threshold
+	vs, err := pk.GetCurrentValset(ctx)
+	require.NoError(t, err)
+	vs.Nonce--
+	internalMembers, err := types.BridgeValidators(vs.Members).ToInternal()
+	require.NoError(t, err)
+	delta := float64(internalMembers.TotalPower()) * 0.05
+	vs.Members[0].Power = uint64(float64(vs.Members[0].Power) - delta/2)
+	vs.Members[1].Power = uint64(float64(vs.Members[1].Power) + delta/2)
+	pk.StoreValset(ctx, vs)
+	pk.SetLatestValsetNonce(ctx, vs.Nonce)
+
+	// EndBlocker should not set a new validator set
+	EndBlocker(ctx, pk)
+	require.Equal(t, vs.Nonce, pk.GetLatestValsetNonce(ctx))
+	require.Len(t, pk.GetValsets(ctx), 1)
+}
+
+func TestValsetEmissionOnMaxAgeBlocks(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.MaxValsetAgeBlocks = 100
+	pk.SetParams(ctx, params)
+
+	// create an up to date valset, nothing should happen until it is old enough
+	EndBlocker(ctx, pk)
+	require.Len(t, pk.GetValsets(ctx), 1)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 99)
+	EndBlocker(ctx, pk)
+	require.Len(t, pk.GetValsets(ctx), 1)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	EndBlocker(ctx, pk)
+	require.Len(t, pk.GetValsets(ctx), 2)
+	require.Equal(t, uint64(ctx.BlockHeight()), pk.GetLatestValset(ctx).Height)
+}
+
+func TestValsetEmissionOnMaxAgeTime(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.MaxValsetAgeTime = uint64(time.Hour.Milliseconds())
+	pk.SetParams(ctx, params)
+
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+	EndBlocker(ctx, pk)
+	require.Len(t, pk.GetValsets(ctx), 1)
+	require.Equal(t, start, pk.GetLatestValsetTime(ctx))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(start.Add(time.Hour - time.Second))
+	EndBlocker(ctx, pk)
+	require.Len(t, pk.GetValsets(ctx), 1)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(start.Add(time.Hour))
+	EndBlocker(ctx, pk)
+	require.Len(t, pk.GetValsets(ctx), 2)
+	require.Equal(t, start.Add(time.Hour), pk.GetLatestValsetTime(ctx))
+}
+
 func TestValsetSetting(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
@@ -503,7 +580,7 @@ func TestBatchTimeout(t *testing.T) {
 	require.Greater(t, params.AverageEthereumBlockTime, uint64(0))
 
 	// mint some vouchers first
-	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	require.NoError(t, keeper.MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers))
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
@@ -641,3 +718,224 @@ func TestValsetPruning(t *testing.T) {
 	require.Nil(t, pk.GetValset(ctx, firstValsetNonce))
 	require.Equal(t, 0, len(pk.GetValsetConfirms(ctx, firstValsetNonce)))
 }
+
+// Tests that the attestation tally observes at most MaxEndBlockerItems attestations per block and continues with the
+// next event nonce in the following block
+func TestAttestationTallyBounded(t *testing.T) {
+	var (
+		myCosmosAddr, _ = sdk.AccAddressFromBech32("gravity16ahjkfqxpp6lvfy9fpfnfjg39xr96qet0l08hu")
+		anyETHAddr      = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		tokenETHAddr    = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+	)
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.MaxEndBlockerItems = 1
+	pk.SetParams(ctx, params)
+
+	h := NewHandler(pk)
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		claim := types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    nonce,
+			TokenContract:  tokenETHAddr,
+			Amount:         sdk.NewInt(12),
+			EthereumSender: anyETHAddr,
+			CosmosReceiver: myCosmosAddr.String(),
+			Orchestrator:   "",
+		}
+		for _, orch := range keeper.OrchAddrs {
+			claim.Orchestrator = orch.String()
+			_, err := h(ctx, &claim)
+			require.NoError(t, err)
+		}
+	}
+	require.Len(t, pk.GetAttestationsAtNonce(ctx, 2), 1)
+	require.Equal(t, uint64(0), pk.GetLastObservedEventNonce(ctx))
+
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		EndBlocker(ctx, pk)
+		require.Equal(t, nonce, pk.GetLastObservedEventNonce(ctx))
+	}
+	EndBlocker(ctx, pk)
+	require.Equal(t, uint64(3), pk.GetLastObservedEventNonce(ctx))
+	assert.Equal(t, sdk.NewInt(36), input.BankKeeper.GetBalance(ctx, myCosmosAddr, "gravity0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e").Amount)
+}
+
+// Tests that timed out batches are cancelled over several blocks when there are more than MaxEndBlockerItems batches
+func TestBatchTimeoutCursor(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	pk := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, _       = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		tokenContract, _    = types.NewEthAddress(myTokenContractAddr)
+		token, err          = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
+		allVouchers         = sdk.NewCoins(token.GravityCoin())
+	)
+	require.NoError(t, err)
+	require.NoError(t, keeper.MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+	// three batches without an ethereum height have a timeout of zero, each one more profitable than the last
+	var batches []*types.InternalOutgoingTxBatch
+	for i := 0; i < 3; i++ {
+		amount, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
+		require.NoError(t, err)
+		fee, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+1)), myTokenContractAddr)
+		require.NoError(t, err)
+		_, err = pk.AddToOutgoingPool(ctx, mySender, *myReceiver, amount.GravityCoin(), fee.GravityCoin())
+		require.NoError(t, err)
+		batch, err := pk.BuildOutgoingTXBatch(ctx, *tokenContract, 1)
+		require.NoError(t, err)
+		batches = append(batches, batch)
+	}
+	require.Len(t, pk.GetOutgoingTxBatches(ctx), 3)
+	pk.SetLastObservedEthereumBlockHeight(ctx, 500)
+
+	params := pk.GetParams(ctx)
+	params.MaxEndBlockerItems = 2
+	cleanupTimedOutBatches(ctx, pk, params)
+	require.Len(t, pk.GetOutgoingTxBatches(ctx), 1)
+	require.NotNil(t, ctx.KVStore(input.GravityStoreKey).Get(types.BatchTimeoutCursorKey))
+	require.NotNil(t, pk.GetOutgoingTXBatch(ctx, *tokenContract, batches[2].BatchNonce))
+
+	// the next block continues after the cursor and starts over once every batch was checked
+	cleanupTimedOutBatches(ctx, pk, params)
+	require.Len(t, pk.GetOutgoingTxBatches(ctx), 0)
+	require.Nil(t, ctx.KVStore(input.GravityStoreKey).Get(types.BatchTimeoutCursorKey))
+}
+
+// A cancel batch proposal passes long after it is submitted, while gov's EndBlocker runs before the gravity
+// EndBlocker which may have cancelled the batch at its timeout in the meantime. The removals of the proposal must
+// be carried out either way
+//nolint: exhaustivestruct
+func TestCancelBatchProposalEndBlocker(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	pk := input.GravityKeeper
+	handler := keeper.NewGravityProposalHandler(pk)
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, _       = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		tokenContract, _    = types.NewEthAddress(myTokenContractAddr)
+		token, err          = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
+		allVouchers         = sdk.NewCoins(token.GravityCoin())
+		denom               = types.GravityDenom(*tokenContract)
+	)
+	require.NoError(t, err)
+	require.NoError(t, keeper.MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+	for i := 0; i < 3; i++ {
+		amount, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
+		require.NoError(t, err)
+		fee, err := types.NewInternalERC20Token(sdk.NewInt(1), myTokenContractAddr)
+		require.NoError(t, err)
+		_, err = pk.AddToOutgoingPool(ctx, mySender, *myReceiver, amount.GravityCoin(), fee.GravityCoin())
+		require.NoError(t, err)
+	}
+	balance := input.BankKeeper.GetBalance(ctx, mySender, denom)
+	pooled := func() (ids []uint64) {
+		for _, tx := range pk.GetUnbatchedTransactions(ctx) {
+			ids = append(ids, tx.Id)
+		}
+		return ids
+	}
+
+	pk.SetLastObservedEthereumBlockHeight(ctx, 500)
+	batch, err := pk.BuildOutgoingTXBatch(ctx, *tokenContract, 3)
+	require.NoError(t, err)
+	proposal := &types.CancelBatchProposal{
+		Title:         "cancel batch",
+		Description:   "the batch always reverts",
+		TokenContract: tokenContract.GetAddress().Hex(),
+		BatchNonce:    batch.BatchNonce,
+		RemoveTxIds:   []uint64{2},
+	}
+
+	// the proposal passes before the timeout, the EndBlocker refunds the removed tx when it cancels the batch
+	require.NoError(t, handler(ctx, proposal))
+	EndBlocker(ctx, pk)
+	require.NotNil(t, pk.GetOutgoingTXBatch(ctx, *tokenContract, batch.BatchNonce))
+	pk.SetLastObservedEthereumBlockHeight(ctx, batch.BatchTimeout+1)
+	EndBlocker(ctx, pk)
+	require.Nil(t, pk.GetOutgoingTXBatch(ctx, *tokenContract, batch.BatchNonce))
+	require.ElementsMatch(t, []uint64{1, 3}, pooled())
+	require.Equal(t, balance.Amount.AddRaw(102), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+
+	// the proposal passes after the EndBlocker cancelled the batch and its txs were batched again, the removed tx is
+	// refunded once the new batch is cancelled
+	batch, err = pk.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	require.NoError(t, err)
+	pk.SetLastObservedEthereumBlockHeight(ctx, batch.BatchTimeout+1)
+	EndBlocker(ctx, pk)
+	require.Nil(t, pk.GetOutgoingTXBatch(ctx, *tokenContract, batch.BatchNonce))
+	rebatched, err := pk.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	require.NoError(t, err)
+	proposal.BatchNonce = batch.BatchNonce
+	proposal.RemoveTxIds = []uint64{3}
+	require.NoError(t, handler(ctx, proposal))
+	require.Equal(t, []uint64{3}, pk.GetBatchTxRemovals(ctx, *tokenContract, rebatched.BatchNonce))
+	pk.SetLastObservedEthereumBlockHeight(ctx, rebatched.BatchTimeout+1)
+	EndBlocker(ctx, pk)
+	require.Nil(t, pk.GetOutgoingTXBatch(ctx, *tokenContract, rebatched.BatchNonce))
+	require.ElementsMatch(t, []uint64{1}, pooled())
+	require.Equal(t, balance.Amount.AddRaw(205), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+}
+
+// Tests that attestations are pruned by whole nonces, the observed attestation of a nonce must not be pruned while
+// the other attestations at the nonce are left
+//nolint: exhaustivestruct
+func TestPruneAttestationsWholeNonces(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	pk := input.GravityKeeper
+
+	// nonce 1 and 2 have two attestations and nonce 3 has three, one of them observed
+	for nonce, count := range map[uint64]int{1: 2, 2: 2, 3: 3} {
+		for i := 0; i < count; i++ {
+			claim := types.MsgSendToCosmosClaim{
+				EventNonce:     nonce,
+				BlockHeight:    nonce,
+				TokenContract:  "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e",
+				Amount:         sdk.NewInt(int64(i + 1)),
+				EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+				CosmosReceiver: keeper.AccAddrs[0].String(),
+				Orchestrator:   keeper.OrchAddrs[0].String(),
+			}
+			any, err := codectypes.NewAnyWithValue(&claim)
+			require.NoError(t, err)
+			hash, err := claim.ClaimHash()
+			require.NoError(t, err)
+			pk.SetAttestation(ctx, nonce, hash, &types.Attestation{Observed: i == 0, Height: nonce, Claim: any})
+		}
+	}
+	// every nonce is more than 1000 nonces below the last observed one
+	ctx.KVStore(input.GravityStoreKey).Set(types.LastObservedEventNonceKey, types.UInt64Bytes(1004))
+	pk.SetLastEventNonceByValidator(ctx, keeper.ValAddrs[0], 1004)
+	counts := func() []int {
+		return []int{len(pk.GetAttestationsAtNonce(ctx, 1)), len(pk.GetAttestationsAtNonce(ctx, 2)), len(pk.GetAttestationsAtNonce(ctx, 3))}
+	}
+
+	params := pk.GetParams(ctx)
+	params.MaxEndBlockerItems = 3
+	// nonce 2 does not fit after nonce 1
+	pruneAttestations(ctx, pk, params)
+	require.Equal(t, []int{0, 2, 3}, counts())
+	_, broken := keeper.ObservedAttestationsInvariant(pk)(ctx)
+	require.False(t, broken)
+	// nor does nonce 3 after nonce 2
+	pruneAttestations(ctx, pk, params)
+	require.Equal(t, []int{0, 0, 3}, counts())
+	// a nonce with more attestations than the limit is still pruned as a whole
+	params.MaxEndBlockerItems = 1
+	pruneAttestations(ctx, pk, params)
+	require.Equal(t, []int{0, 0, 0}, counts())
+}
@@ -0,0 +1,212 @@
+package bindings
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+const (
+	testERC20   = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	testEthDest = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+)
+
+// setupWasm builds a wasm keeper with the gravity bindings on top of the keeper test env, supporting the given
+// capabilities, the gravity msg server is the only message route available to contracts
+func setupWasm(t *testing.T, supportedFeatures string) (keeper.TestInput, wasmkeeper.Keeper) {
+	input := keeper.CreateTestEnv(t)
+
+	registry := input.Marshaler.(codec.ProtoCodecMarshaler).InterfaceRegistry()
+	msgRouter := baseapp.NewMsgServiceRouter()
+	msgRouter.SetInterfaceRegistry(registry)
+	types.RegisterMsgServer(msgRouter, keeper.NewMsgServerImpl(input.GravityKeeper))
+	queryRouter := baseapp.NewGRPCQueryRouter()
+	queryRouter.SetInterfaceRegistry(registry)
+
+	subspace, found := input.ParamsKeeper.GetSubspace(wasmtypes.ModuleName)
+	require.True(t, found)
+	wasmKeeper := wasmkeeper.NewKeeper(
+		input.Marshaler,
+		input.WasmStoreKey,
+		subspace,
+		input.AccountKeeper,
+		input.BankKeeper,
+		input.StakingKeeper,
+		input.DistKeeper,
+		input.IbcKeeper.ChannelKeeper,
+		&input.IbcKeeper.PortKeeper,
+		input.CapabilityKeeper.ScopeToModule(wasmtypes.ModuleName),
+		input.IbcTransferKeeper,
+		msgRouter,
+		queryRouter,
+		t.TempDir(),
+		wasmtypes.DefaultWasmConfig(),
+		supportedFeatures,
+		RegisterCustomPlugins(input.GravityKeeper)...,
+	)
+	wasmKeeper.SetParams(input.Context, wasmtypes.DefaultParams())
+	return input, wasmKeeper
+}
+
+// instantiateEcho stores and instantiates the gravity_echo test contract, which dispatches its execute message as
+// a gravity custom message and answers its query message with the result of the same gravity custom query
+func instantiateEcho(t *testing.T, input keeper.TestInput, wasmKeeper wasmkeeper.Keeper) sdk.AccAddress {
+	code, err := ioutil.ReadFile("testdata/gravity_echo.wasm")
+	require.NoError(t, err)
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(wasmKeeper)
+	creator := keeper.AccAddrs[0]
+	codeID, err := contractKeeper.Create(input.Context, creator, code, nil)
+	require.NoError(t, err)
+	contract, _, err := contractKeeper.Instantiate(input.Context, codeID, creator, nil, []byte("{}"), "echo", nil)
+	require.NoError(t, err)
+	return contract
+}
+
+func execute(input keeper.TestInput, wasmKeeper wasmkeeper.Keeper, contract sdk.AccAddress, msg GravityMsg) error {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(wasmKeeper)
+	_, err = contractKeeper.Execute(input.Context, contract, keeper.AccAddrs[0], bz, nil)
+	return err
+}
+
+func querySmart(t *testing.T, input keeper.TestInput, wasmKeeper wasmkeeper.Keeper, contract sdk.AccAddress, q GravityQuery, res interface{}) {
+	bz, err := json.Marshal(q)
+	require.NoError(t, err)
+	resBz, err := wasmKeeper.QuerySmart(input.Context, contract, bz)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(resBz, res))
+}
+
+// Tests a contract bridging its own funds through the gravity custom messages and following them with the gravity
+// custom queries
+func TestContractSendToEth(t *testing.T) {
+	input, wasmKeeper := setupWasm(t, "iterator,staking,stargate,"+Capability)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	ctx := input.Context
+	contract := instantiateEcho(t, input, wasmKeeper)
+
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), testERC20)
+	require.NoError(t, err)
+	voucher := keeper.MintVouchersFromAir(t, ctx, input.GravityKeeper, contract, *token)
+	denom := voucher.Denom
+
+	var denomRes DenomToErc20Response
+	querySmart(t, input, wasmKeeper, contract, GravityQuery{DenomToErc20: &DenomToErc20{Denom: denom}}, &denomRes)
+	assert.Equal(t, DenomToErc20Response{Erc20: testERC20, CosmosOriginated: false}, denomRes)
+	var erc20Res Erc20ToDenomResponse
+	querySmart(t, input, wasmKeeper, contract, GravityQuery{Erc20ToDenom: &Erc20ToDenom{Erc20: testERC20}}, &erc20Res)
+	assert.Equal(t, Erc20ToDenomResponse{Denom: denom, CosmosOriginated: false}, erc20Res)
+
+	// send twice, the second transfer pays the higher fee
+	for i := 1; i <= 2; i++ {
+		//nolint: exhaustivestruct
+		err = execute(input, wasmKeeper, contract, GravityMsg{SendToEth: &SendToEth{
+			EthDest:   testEthDest,
+			Amount:    wasmvmtypes.NewCoin(100, denom),
+			BridgeFee: wasmvmtypes.NewCoin(uint64(i), denom),
+		}})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, sdk.NewInt(1000-2*100-1-2), input.BankKeeper.GetBalance(ctx, contract, denom).Amount)
+
+	var pending PendingTransfersResponse
+	pendingQuery := GravityQuery{PendingTransfers: &PendingTransfers{Sender: contract.String(), Limit: 0}}
+	querySmart(t, input, wasmKeeper, contract, pendingQuery, &pending)
+	require.Len(t, pending.UnbatchedTransfers, 2)
+	assert.Empty(t, pending.TransfersInBatches)
+	assert.Equal(t, uint64(2), pending.UnbatchedTransfers[0].ID)
+	assert.Equal(t, contract.String(), pending.UnbatchedTransfers[0].Sender)
+	assert.Equal(t, testEthDest, pending.UnbatchedTransfers[0].DestAddress)
+	assert.Equal(t, Erc20Token{Contract: testERC20, Amount: "100"}, pending.UnbatchedTransfers[0].Erc20Token)
+	assert.Equal(t, Erc20Token{Contract: testERC20, Amount: "2"}, pending.UnbatchedTransfers[0].Erc20Fee)
+
+	var fees BatchFeesResponse
+	querySmart(t, input, wasmKeeper, contract, GravityQuery{BatchFees: &BatchFees{}}, &fees)
+	assert.Equal(t, []BatchFee{{Token: testERC20, TotalFees: "3", TxCount: 2}}, fees.BatchFees)
+
+	// cancelling refunds the transfer and its fee
+	err = execute(input, wasmKeeper, contract, GravityMsg{CancelSendToEth: &CancelSendToEth{TransactionID: 1}})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(1000-100-2), input.BankKeeper.GetBalance(ctx, contract, denom).Amount)
+	// a transfer can only be cancelled once
+	err = execute(input, wasmKeeper, contract, GravityMsg{CancelSendToEth: &CancelSendToEth{TransactionID: 1}})
+	require.Error(t, err)
+
+	err = execute(input, wasmKeeper, contract, GravityMsg{RequestBatch: &RequestBatch{Denom: denom}})
+	require.NoError(t, err)
+	pending = PendingTransfersResponse{}
+	querySmart(t, input, wasmKeeper, contract, pendingQuery, &pending)
+	assert.Empty(t, pending.UnbatchedTransfers)
+	require.Len(t, pending.TransfersInBatches, 1)
+	assert.Equal(t, uint64(2), pending.TransfersInBatches[0].ID)
+	batches := input.GravityKeeper.GetOutgoingTxBatches(ctx)
+	require.Len(t, batches, 1)
+	assert.Equal(t, testERC20, batches[0].TokenContract.GetAddress().Hex())
+}
+
+// Tests that the gravity bindings fail cleanly on bad input instead of dispatching anything
+func TestContractInvalidGravityMsg(t *testing.T) {
+	input, wasmKeeper := setupWasm(t, "iterator,staking,stargate,"+Capability)
+	contract := instantiateEcho(t, input, wasmKeeper)
+
+	// amounts the contract does not hold
+	//nolint: exhaustivestruct
+	err := execute(input, wasmKeeper, contract, GravityMsg{SendToEth: &SendToEth{
+		EthDest:   testEthDest,
+		Amount:    wasmvmtypes.NewCoin(100, fmt.Sprintf("gravity%s", testERC20)),
+		BridgeFee: wasmvmtypes.NewCoin(1, fmt.Sprintf("gravity%s", testERC20)),
+	}})
+	require.Error(t, err)
+	// no variant set
+	err = execute(input, wasmKeeper, contract, GravityMsg{})
+	require.Error(t, err)
+
+	_, err = EncodeGravityMsg(contract, []byte(`{"send_to_eth":{"eth_dest":"","amount":{"denom":"a","amount":"x"}}}`))
+	require.Error(t, err)
+	_, err = EncodeGravityMsg(contract, []byte(`{"unknown":{}}`))
+	require.Error(t, err)
+
+	_, err = NewGravityQuerier(input.GravityKeeper)(input.Context, []byte(`{"unknown":{}}`))
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+	_, err = NewGravityQuerier(input.GravityKeeper)(input.Context, []byte(`{"pending_transfers":{"sender":"x"}}`))
+	require.Error(t, err)
+}
+
+// Tests that the json response of a gravity query is charged like a store read of the same size
+func TestGravityQueryGas(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	querier := NewGravityQuerier(input.GravityKeeper)
+
+	ctx := input.Context.WithGasMeter(sdk.NewInfiniteGasMeter())
+	bz, err := querier(ctx, []byte(`{"erc20_to_denom":{"erc20":"`+testERC20+`"}}`))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), storetypes.KVGasConfig().ReadCostPerByte*uint64(len(bz)))
+}
+
+// Tests that a contract requiring the gravity capability can not be stored on a chain without the bindings
+func TestContractRequiresGravityCapability(t *testing.T) {
+	input, wasmKeeper := setupWasm(t, "iterator,staking,stargate")
+	code, err := ioutil.ReadFile("testdata/gravity_echo.wasm")
+	require.NoError(t, err)
+
+	_, err = wasmkeeper.NewDefaultPermissionKeeper(wasmKeeper).Create(input.Context, keeper.AccAddrs[0], code, nil)
+	require.Error(t, err)
+}
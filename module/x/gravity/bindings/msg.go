@@ -0,0 +1,90 @@
+package bindings
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// GravityMsg is the custom message a contract can return, exactly one of the fields must be set. The json
+// encoding follows the CosmWasm conventions, e.g. {"send_to_eth":{"eth_dest":"0x...","amount":{...},...}}
+type GravityMsg struct {
+	SendToEth       *SendToEth       `json:"send_to_eth,omitempty"`
+	CancelSendToEth *CancelSendToEth `json:"cancel_send_to_eth,omitempty"`
+	RequestBatch    *RequestBatch    `json:"request_batch,omitempty"`
+}
+
+// SendToEth adds a transfer of amount from the contract to eth_dest to the outgoing pool, see MsgSendToEth
+type SendToEth struct {
+	EthDest   string           `json:"eth_dest"`
+	Amount    wasmvmtypes.Coin `json:"amount"`
+	BridgeFee wasmvmtypes.Coin `json:"bridge_fee"`
+}
+
+// CancelSendToEth removes an unbatched transfer of the contract from the outgoing pool, see MsgCancelSendToEth
+type CancelSendToEth struct {
+	TransactionID uint64 `json:"transaction_id"`
+}
+
+// RequestBatch requests a batch of the transfers of denom in the outgoing pool, see MsgRequestBatch
+type RequestBatch struct {
+	Denom string `json:"denom"`
+}
+
+// EncodeGravityMsg is a wasm custom message encoder, it turns the GravityMsg returned by a contract into the
+// equivalent gravity sdk.Msg sent by the contract. The resulting messages are validated, checked to be signed
+// by the contract and routed to the gravity msg server by wasmd exactly like a transaction would be, so they
+// consume the same gas and emit the same events as their transaction counterparts
+func EncodeGravityMsg(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+	var gravityMsg GravityMsg
+	if err := json.Unmarshal(msg, &gravityMsg); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	switch {
+	case gravityMsg.SendToEth != nil:
+		amount, err := convertCoin(gravityMsg.SendToEth.Amount)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "amount")
+		}
+		fee, err := convertCoin(gravityMsg.SendToEth.BridgeFee)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "bridge fee")
+		}
+		return []sdk.Msg{&types.MsgSendToEth{
+			Sender:    sender.String(),
+			EthDest:   gravityMsg.SendToEth.EthDest,
+			Amount:    amount,
+			BridgeFee: fee,
+		}}, nil
+	case gravityMsg.CancelSendToEth != nil:
+		return []sdk.Msg{&types.MsgCancelSendToEth{
+			TransactionId: gravityMsg.CancelSendToEth.TransactionID,
+			Sender:        sender.String(),
+		}}, nil
+	case gravityMsg.RequestBatch != nil:
+		return []sdk.Msg{&types.MsgRequestBatch{
+			Sender: sender.String(),
+			Denom:  gravityMsg.RequestBatch.Denom,
+		}}, nil
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown gravity message variant")
+	}
+}
+
+// convertCoin converts a CosmWasm coin, whose amount is a decimal string, into an sdk.Coin
+func convertCoin(coin wasmvmtypes.Coin) (sdk.Coin, error) {
+	amount, ok := sdk.NewIntFromString(coin.Amount)
+	if !ok {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%s%s", coin.Amount, coin.Denom)
+	}
+	ret := sdk.Coin{Denom: coin.Denom, Amount: amount}
+	if err := ret.Validate(); err != nil {
+		return sdk.Coin{}, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	return ret, nil
+}
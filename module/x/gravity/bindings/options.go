@@ -0,0 +1,23 @@
+// Package bindings exposes the gravity module to CosmWasm contracts through wasm custom messages and queries.
+// Contracts using the bindings must declare the gravity capability by exporting a requires_gravity function.
+package bindings
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+)
+
+// Capability is the wasm capability providing the gravity custom messages and queries, it must be added to
+// the supported features of the wasm keeper
+const Capability = "gravity"
+
+// RegisterCustomPlugins returns the wasm keeper options registering the gravity custom message encoder and
+// custom querier
+func RegisterCustomPlugins(k keeper.Keeper) []wasmkeeper.Option {
+	//nolint: exhaustivestruct
+	return []wasmkeeper.Option{
+		wasmkeeper.WithMessageEncoders(&wasmkeeper.MessageEncoders{Custom: EncodeGravityMsg}),
+		wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{Custom: NewGravityQuerier(k)}),
+	}
+}
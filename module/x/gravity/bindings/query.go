@@ -0,0 +1,175 @@
+package bindings
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// GravityQuery is the custom query a contract can make, exactly one of the fields must be set
+type GravityQuery struct {
+	DenomToErc20     *DenomToErc20     `json:"denom_to_erc20,omitempty"`
+	Erc20ToDenom     *Erc20ToDenom     `json:"erc20_to_denom,omitempty"`
+	BatchFees        *BatchFees        `json:"batch_fees,omitempty"`
+	PendingTransfers *PendingTransfers `json:"pending_transfers,omitempty"`
+}
+
+// DenomToErc20 looks up the ERC20 representing a cosmos denom, answered with a DenomToErc20Response
+type DenomToErc20 struct {
+	Denom string `json:"denom"`
+}
+
+// Erc20ToDenom looks up the cosmos denom representing an ERC20, answered with an Erc20ToDenomResponse
+type Erc20ToDenom struct {
+	Erc20 string `json:"erc20"`
+}
+
+// BatchFees lists the fees a batch of every token in the outgoing pool would pay, answered with a
+// BatchFeesResponse
+type BatchFees struct{}
+
+// PendingTransfers lists the transfers of sender that have not reached Ethereum yet, answered with a
+// PendingTransfersResponse. Limit bounds the number of unbatched transfers returned, highest fee first, zero
+// uses the default page size of the PendingSendToEth query
+type PendingTransfers struct {
+	Sender string `json:"sender"`
+	Limit  uint64 `json:"limit,omitempty"`
+}
+
+// DenomToErc20Response is the answer to a DenomToErc20 query
+type DenomToErc20Response struct {
+	Erc20            string `json:"erc20"`
+	CosmosOriginated bool   `json:"cosmos_originated"`
+}
+
+// Erc20ToDenomResponse is the answer to an Erc20ToDenom query
+type Erc20ToDenomResponse struct {
+	Denom            string `json:"denom"`
+	CosmosOriginated bool   `json:"cosmos_originated"`
+}
+
+// BatchFeesResponse is the answer to a BatchFees query
+type BatchFeesResponse struct {
+	BatchFees []BatchFee `json:"batch_fees"`
+}
+
+// BatchFee is the total fee of the most profitable batch of token and the number of transfers in it
+type BatchFee struct {
+	Token     string `json:"token"`
+	TotalFees string `json:"total_fees"`
+	TxCount   uint64 `json:"tx_count"`
+}
+
+// PendingTransfersResponse is the answer to a PendingTransfers query
+type PendingTransfersResponse struct {
+	TransfersInBatches []Transfer `json:"transfers_in_batches"`
+	UnbatchedTransfers []Transfer `json:"unbatched_transfers"`
+}
+
+// Transfer is a transfer to Ethereum in the outgoing pool or in a batch
+type Transfer struct {
+	ID          uint64     `json:"id"`
+	Sender      string     `json:"sender"`
+	DestAddress string     `json:"dest_address"`
+	Erc20Token  Erc20Token `json:"erc20_token"`
+	Erc20Fee    Erc20Token `json:"erc20_fee"`
+}
+
+// Erc20Token is an amount of an ERC20, the amount is a decimal string like all CosmWasm integers
+type Erc20Token struct {
+	Contract string `json:"contract"`
+	Amount   string `json:"amount"`
+}
+
+// NewGravityQuerier returns a wasm custom querier answering GravityQuery requests from the gravity keeper.
+// The store reads of a query are charged to the gas meter of the querying contract by wasmd, on top of that the
+// json response is charged per byte like a store read of the same size, since encoding a large response is
+// not covered by the reads it took to collect it
+func NewGravityQuerier(k keeper.Keeper) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var gravityQuery GravityQuery
+		if err := json.Unmarshal(request, &gravityQuery); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+
+		res, err := handleGravityQuery(ctx, k, gravityQuery)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+		ctx.GasMeter().ConsumeGas(storetypes.KVGasConfig().ReadCostPerByte*uint64(len(bz)), "gravity query response")
+		return bz, nil
+	}
+}
+
+// handleGravityQuery answers the single query variant set in gravityQuery with its response type
+func handleGravityQuery(ctx sdk.Context, k keeper.Keeper, gravityQuery GravityQuery) (interface{}, error) {
+	c := sdk.WrapSDKContext(ctx)
+	switch {
+	case gravityQuery.DenomToErc20 != nil:
+		res, err := k.DenomToERC20(c, &types.QueryDenomToERC20Request{Denom: gravityQuery.DenomToErc20.Denom})
+		if err != nil {
+			return nil, err
+		}
+		return DenomToErc20Response{Erc20: res.Erc20, CosmosOriginated: res.CosmosOriginated}, nil
+	case gravityQuery.Erc20ToDenom != nil:
+		res, err := k.ERC20ToDenom(c, &types.QueryERC20ToDenomRequest{Erc20: gravityQuery.Erc20ToDenom.Erc20})
+		if err != nil {
+			return nil, err
+		}
+		return Erc20ToDenomResponse{Denom: res.Denom, CosmosOriginated: res.CosmosOriginated}, nil
+	case gravityQuery.BatchFees != nil:
+		res, err := k.BatchFees(c, &types.QueryBatchFeeRequest{})
+		if err != nil {
+			return nil, err
+		}
+		fees := make([]BatchFee, len(res.BatchFees))
+		for i, fee := range res.BatchFees {
+			fees[i] = BatchFee{Token: fee.Token, TotalFees: fee.TotalFees.String(), TxCount: fee.TxCount}
+		}
+		return BatchFeesResponse{BatchFees: fees}, nil
+	case gravityQuery.PendingTransfers != nil:
+		if _, err := sdk.AccAddressFromBech32(gravityQuery.PendingTransfers.Sender); err != nil {
+			return nil, sdkerrors.Wrap(err, "sender")
+		}
+		//nolint: exhaustivestruct
+		res, err := k.GetPendingSendToEth(c, &types.QueryPendingSendToEth{
+			SenderAddress: gravityQuery.PendingTransfers.Sender,
+			Pagination:    &query.PageRequest{Limit: gravityQuery.PendingTransfers.Limit},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return PendingTransfersResponse{
+			TransfersInBatches: convertTransfers(res.TransfersInBatches),
+			UnbatchedTransfers: convertTransfers(res.UnbatchedTransfers),
+		}, nil
+	default:
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown gravity query variant"}
+	}
+}
+
+// convertTransfers converts the transfers of a PendingSendToEth query response to their contract facing type
+func convertTransfers(txs []types.OutgoingTransferTx) []Transfer {
+	ret := make([]Transfer, len(txs))
+	for i, tx := range txs {
+		ret[i] = Transfer{
+			ID:          tx.Id,
+			Sender:      tx.Sender,
+			DestAddress: tx.DestAddress,
+			Erc20Token:  Erc20Token{Contract: tx.Erc20Token.Contract, Amount: tx.Erc20Token.Amount.String()},
+			Erc20Fee:    Erc20Token{Contract: tx.Erc20Fee.Contract, Amount: tx.Erc20Fee.Amount.String()},
+		}
+	}
+	return ret
+}
@@ -0,0 +1,203 @@
+;; gravity_echo is a minimal CosmWasm 1.0 contract used to test the gravity bindings without a Rust toolchain.
+;; execute dispatches its message unchanged as a gravity custom message, query forwards its message unchanged as a
+;; gravity custom query and returns the response. Assemble with any WebAssembly text format assembler, e.g.
+;; wat2wasm gravity_echo.wat -o gravity_echo.wasm
+;;
+;; All memory is handed out by a bump allocator and never freed, a contract instance only lives for one call.
+;; A Region is the CosmWasm { offset: u32, capacity: u32, length: u32 } struct describing a buffer.
+(module
+  (import "env" "query_chain" (func $query_chain (param i32) (result i32)))
+
+  (memory (export "memory") 1)
+
+  ;; {"ok":{"messages":[],"attributes":[],"events":[],"data":null}}
+  (data (i32.const 0) "{\"ok\":{\"messages\":[],\"attributes\":[],\"events\":[],\"data\":null}}")
+  ;; execute response around the custom message
+  (data (i32.const 64) "{\"ok\":{\"messages\":[{\"id\":0,\"msg\":{\"custom\":")
+  (data (i32.const 128) "},\"gas_limit\":null,\"reply_on\":\"never\"}],\"attributes\":[],\"events\":[],\"data\":null}}")
+  ;; query request around the custom query
+  (data (i32.const 256) "{\"custom\":")
+  (data (i32.const 272) "}")
+
+  (global $heap (mut i32) (i32.const 1024))
+
+  ;; alloc returns size bytes of fresh memory, growing the memory as needed
+  (func $alloc (param $size i32) (result i32)
+    (local $ptr i32)
+    (local $end i32)
+    global.get $heap
+    local.set $ptr
+    ;; keep allocations 8 byte aligned
+    local.get $ptr
+    local.get $size
+    i32.add
+    i32.const 7
+    i32.add
+    i32.const -8
+    i32.and
+    local.set $end
+    (block $enough
+      (loop $grow
+        local.get $end
+        memory.size
+        i32.const 16
+        i32.shl
+        i32.le_u
+        br_if $enough
+        i32.const 1
+        memory.grow
+        i32.const -1
+        i32.eq
+        (if (then unreachable))
+        br $grow))
+    local.get $end
+    global.set $heap
+    local.get $ptr)
+
+  ;; new_region returns an empty Region with the given capacity
+  (func $new_region (param $capacity i32) (result i32)
+    (local $region i32)
+    i32.const 12
+    call $alloc
+    local.set $region
+    local.get $region
+    local.get $capacity
+    call $alloc
+    i32.store
+    local.get $region
+    local.get $capacity
+    i32.store offset=4
+    local.get $region
+    i32.const 0
+    i32.store offset=8
+    local.get $region)
+
+  (func $copy (param $dst i32) (param $src i32) (param $len i32)
+    (block $done
+      (loop $next
+        local.get $len
+        i32.eqz
+        br_if $done
+        local.get $dst
+        local.get $src
+        i32.load8_u
+        i32.store8
+        local.get $dst
+        i32.const 1
+        i32.add
+        local.set $dst
+        local.get $src
+        i32.const 1
+        i32.add
+        local.set $src
+        local.get $len
+        i32.const 1
+        i32.sub
+        local.set $len
+        br $next)))
+
+  ;; wrap returns a Region holding the concatenation of the three given byte ranges
+  (func $wrap
+    (param $pre i32) (param $preLen i32)
+    (param $src i32) (param $srcLen i32)
+    (param $post i32) (param $postLen i32)
+    (result i32)
+    (local $region i32)
+    (local $len i32)
+    (local $dst i32)
+    local.get $preLen
+    local.get $srcLen
+    i32.add
+    local.get $postLen
+    i32.add
+    local.set $len
+    local.get $len
+    call $new_region
+    local.set $region
+    local.get $region
+    i32.load
+    local.set $dst
+    local.get $dst
+    local.get $pre
+    local.get $preLen
+    call $copy
+    local.get $dst
+    local.get $preLen
+    i32.add
+    local.get $src
+    local.get $srcLen
+    call $copy
+    local.get $dst
+    local.get $preLen
+    i32.add
+    local.get $srcLen
+    i32.add
+    local.get $post
+    local.get $postLen
+    call $copy
+    local.get $region
+    local.get $len
+    i32.store offset=8
+    local.get $region)
+
+  (func (export "interface_version_8"))
+
+  ;; declares the gravity capability, the chain must support the gravity bindings to store this contract
+  (func (export "requires_gravity"))
+
+  (func (export "allocate") (param $size i32) (result i32)
+    local.get $size
+    call $new_region)
+
+  (func (export "deallocate") (param $region i32))
+
+  (func (export "instantiate") (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    i32.const 0
+    i32.const 62
+    i32.const 0
+    i32.const 0
+    i32.const 0
+    i32.const 0
+    call $wrap)
+
+  ;; responds with a single custom message, the execute message itself
+  (func (export "execute") (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    i32.const 64
+    i32.const 43
+    local.get $msg
+    i32.load
+    local.get $msg
+    i32.load offset=8
+    i32.const 128
+    i32.const 81
+    call $wrap)
+
+  ;; forwards the query message as a custom query, the querier answers {"ok":{"ok":"<base64>"}} on success
+  ;; which is turned into the {"ok":"<base64>"} query result by dropping the outer object
+  (func (export "query") (param $env i32) (param $msg i32) (result i32)
+    (local $response i32)
+    i32.const 256
+    i32.const 10
+    local.get $msg
+    i32.load
+    local.get $msg
+    i32.load offset=8
+    i32.const 272
+    i32.const 1
+    call $wrap
+    call $query_chain
+    local.set $response
+    i32.const 0
+    i32.const 0
+    local.get $response
+    i32.load
+    i32.const 6
+    i32.add
+    local.get $response
+    i32.load offset=8
+    i32.const 7
+    i32.sub
+    i32.const 0
+    i32.const 0
+    call $wrap)
+)
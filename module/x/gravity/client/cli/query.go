@@ -1,10 +1,12 @@
 package cli
 
 import (
+	"encoding/hex"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/spf13/cobra"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -12,6 +14,19 @@ import (
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
+// Flags for the filters of the attestations and outgoing-batches queries
+const (
+	FlagOrderBy       = "order-by"
+	FlagClaimType     = "claim-type"
+	FlagNonce         = "nonce"
+	FlagHeight        = "height-filter"
+	FlagObserved      = "observed"
+	FlagMinHeight     = "min-height"
+	FlagMaxHeight     = "max-height"
+	FlagVoter         = "voter"
+	FlagTokenContract = "token-contract"
+)
+
 func GetQueryCmd() *cobra.Command {
 	//nolint: exhaustivestruct
 	gravityQueryCmd := &cobra.Command{
@@ -26,10 +41,31 @@ func GetQueryCmd() *cobra.Command {
 		CmdGetValsetRequest(),
 		CmdGetValsetConfirm(),
 		CmdGetPendingValsetRequest(),
+		CmdGetValsetPowerDiff(),
 		CmdGetPendingOutgoingTXBatchRequest(),
 		CmdGetPendingSendToEth(),
 		GetCmdPendingIbcAutoForwards(),
 		GetCmdQueryParams(),
+		CmdGetBridgeStatus(),
+		CmdGetBridgeReserves(),
+		CmdGetEthereumBlockTime(),
+		CmdGetValsetConfirms(),
+		CmdGetLastValsetRequests(),
+		CmdGetPendingLogicCall(),
+		CmdGetLastEventNonce(),
+		CmdGetBatchFees(),
+		CmdGetOutgoingTxBatches(),
+		CmdGetOutgoingLogicCalls(),
+		CmdGetBatchRequest(),
+		CmdGetBatchConfirms(),
+		CmdGetLogicConfirms(),
+		CmdGetERC20ToDenom(),
+		CmdGetDenomToERC20(),
+		CmdGetAttestations(),
+		CmdGetDelegateKeysByValidator(),
+		CmdGetDelegateKeysByEth(),
+		CmdGetDelegateKeysByOrchestrator(),
+		CmdGetDelegateKeys(),
 	}...)
 
 	return gravityQueryCmd
@@ -42,7 +78,10 @@ func CmdGetCurrentValset() *cobra.Command {
 		Short: "Query current valset",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
 			queryClient := types.NewQueryClient(clientCtx)
 
 			req := &types.QueryCurrentValsetRequest{}
@@ -66,7 +105,10 @@ func CmdGetValsetRequest() *cobra.Command {
 		Short: "Get requested valset with a particular nonce",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
 			queryClient := types.NewQueryClient(clientCtx)
 
 			nonce, err := strconv.ParseUint(args[0], 10, 64)
@@ -97,7 +139,10 @@ func CmdGetValsetConfirm() *cobra.Command {
 		Short: "Get valset confirmation with a particular nonce from a particular validator",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
 			queryClient := types.NewQueryClient(clientCtx)
 
 			nonce, err := strconv.ParseUint(args[0], 10, 64)
@@ -129,7 +174,10 @@ func CmdGetPendingValsetRequest() *cobra.Command {
 		Short: "Get the latest valset request which has not been signed by a particular orchestrator",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
 			queryClient := types.NewQueryClient(clientCtx)
 
 			req := &types.QueryLastPendingValsetRequestByAddrRequest{
@@ -148,6 +196,33 @@ func CmdGetPendingValsetRequest() *cobra.Command {
 	return cmd
 }
 
+func CmdGetValsetPowerDiff() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "valset-power-diff",
+		Short: "Query the power drift between the current validator set and the latest valset request",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryValsetPowerDiffRequest{}
+
+			res, err := queryClient.ValsetPowerDiff(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdGetPendingOutgoingTXBatchRequest() *cobra.Command {
 	//nolint: exhaustivestruct
 	cmd := &cobra.Command{
@@ -155,7 +230,10 @@ func CmdGetPendingOutgoingTXBatchRequest() *cobra.Command {
 		Short: "Get the latest outgoing TX batch request which has not been signed by a particular orchestrator",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
 			queryClient := types.NewQueryClient(clientCtx)
 
 			req := &types.QueryLastPendingBatchRequestByAddrRequest{
@@ -181,11 +259,20 @@ func CmdGetPendingSendToEth() *cobra.Command {
 		Short: "Query transactions waiting to go to Ethereum",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
 			queryClient := types.NewQueryClient(clientCtx)
 
+			pageReq, err := readOptionalPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryPendingSendToEth{
 				SenderAddress: args[0],
+				Pagination:    pageReq,
 			}
 
 			res, err := queryClient.GetPendingSendToEth(cmd.Context(), req)
@@ -197,6 +284,7 @@ func CmdGetPendingSendToEth() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbatched transfers")
 	return cmd
 }
 
@@ -207,12 +295,14 @@ func GetCmdPendingIbcAutoForwards() *cobra.Command {
 		Short: "Query SendToCosmos transactions waiting to be forwarded over IBC",
 		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
 			queryClient := types.NewQueryClient(clientCtx)
 
 			var limit uint64 = 0
-			if args[0] != "" {
-				var err error
+			if len(args) > 0 {
 				limit, err = strconv.ParseUint(args[0], 10, 0)
 				if err != nil {
 					return sdkerrors.Wrapf(err, "Unable to parse limit from %v", args[0])
@@ -232,6 +322,90 @@ func GetCmdPendingIbcAutoForwards() *cobra.Command {
 	return cmd
 }
 
+func CmdGetBridgeStatus() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bridge-status",
+		Short: "Query a summary of the bridge health",
+		Long: "Query a summary of the bridge health: the last observed event nonce and Ethereum height, the event nonce lag of " +
+			"each bonded validator, the oldest unsigned valset, batch and logic call, the pool per token, pending IBC " +
+			"auto forwards, whether the bridge is active and the projected batch timeout height",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BridgeStatus(cmd.Context(), &types.QueryBridgeStatusRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Status)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBridgeReserves() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bridge-reserves",
+		Short: "Query the amounts held by the bridge of every bridged token",
+		Long: "Query the amounts held by the bridge of every bridged token: the cosmos originated amount locked in the " +
+			"module, the Ethereum originated voucher supply, the amounts pending in the pool, batches and IBC auto forward " +
+			"queue, and the lifetime totals deposited, withdrawn and sent to the community pool",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BridgeReserves(cmd.Context(), &types.QueryBridgeReservesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetEthereumBlockTime() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "ethereum-block-time",
+		Short: "Query the Ethereum block time used to project batch timeout heights",
+		Long: "Query the moving average of the Ethereum block time sampled from observed attestations, and the block " +
+			"time in milliseconds used to project batch timeout heights, which is the estimate bounded by the " +
+			"average_ethereum_block_time param",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EthereumBlockTime(cmd.Context(), &types.QueryEthereumBlockTimeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "params",
@@ -256,3 +430,602 @@ func GetCmdQueryParams() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdGetValsetConfirms() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "valset-confirms [nonce]",
+		Short: "Get every confirmation of the valset with a particular nonce",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryValsetConfirmsByNonceRequest{
+				Nonce: nonce,
+			}
+
+			res, err := queryClient.ValsetConfirmsByNonce(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetLastValsetRequests() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "last-valset-requests",
+		Short: "Get the most recent valset requests, newest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := readOptionalPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryLastValsetRequestsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.LastValsetRequests(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "valset requests")
+	return cmd
+}
+
+func CmdGetPendingLogicCall() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "pending-logic-call [bech32 orchestrator address]",
+		Short: "Get the outgoing logic calls which have not been signed by a particular orchestrator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryLastPendingLogicCallByAddrRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.LastPendingLogicCallByAddr(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetLastEventNonce() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "last-event-nonce [bech32 orchestrator address]",
+		Short: "Get the last event nonce submitted by the validator of a particular orchestrator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryLastEventNonceByAddrRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.LastEventNonceByAddr(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBatchFees() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "batch-fees",
+		Short: "Get the fees a batch of each token would collect if it were created now",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBatchFeeRequest{}
+
+			res, err := queryClient.BatchFees(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetOutgoingTxBatches() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "outgoing-batches",
+		Short: "Get the outgoing batches which have not been executed on Ethereum",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := readOptionalPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+			tokenContract, err := cmd.Flags().GetString(FlagTokenContract)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryOutgoingTxBatchesRequest{
+				Pagination:    pageReq,
+				TokenContract: tokenContract,
+			}
+
+			res, err := queryClient.OutgoingTxBatches(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(FlagTokenContract, "", "only return the batches of this ERC20 token contract")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "outgoing batches")
+	return cmd
+}
+
+func CmdGetOutgoingLogicCalls() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "outgoing-logic-calls",
+		Short: "Get the outgoing logic calls which have not been executed on Ethereum",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := readOptionalPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryOutgoingLogicCallsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.OutgoingLogicCalls(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "outgoing logic calls")
+	return cmd
+}
+
+func CmdGetBatchRequest() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "batch-request [nonce] [token contract address]",
+		Short: "Get the outgoing batch with a particular nonce of a particular ERC20 token",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBatchRequestByNonceRequest{
+				Nonce:           nonce,
+				ContractAddress: args[1],
+			}
+
+			res, err := queryClient.BatchRequestByNonce(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBatchConfirms() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "batch-confirms [nonce] [token contract address]",
+		Short: "Get every confirmation of the outgoing batch with a particular nonce of a particular ERC20 token",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBatchConfirmsRequest{
+				Nonce:           nonce,
+				ContractAddress: args[1],
+			}
+
+			res, err := queryClient.BatchConfirms(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetLogicConfirms() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "logic-confirms [hex invalidation id] [invalidation nonce]",
+		Short: "Get every confirmation of the outgoing logic call with a particular invalidation id and nonce",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			invalidationID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return sdkerrors.Wrapf(err, "Unable to parse invalidation id from %v", args[0])
+			}
+			invalidationNonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryLogicConfirmsRequest{
+				InvalidationId:    invalidationID,
+				InvalidationNonce: invalidationNonce,
+			}
+
+			res, err := queryClient.LogicConfirms(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetERC20ToDenom() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "erc20-to-denom [erc20 address]",
+		Short: "Get the cosmos denom representing a particular ERC20 token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryERC20ToDenomRequest{
+				Erc20: args[0],
+			}
+
+			res, err := queryClient.ERC20ToDenom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDenomToERC20() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "denom-to-erc20 [denom]",
+		Short: "Get the ERC20 token representing a particular cosmos denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomToERC20Request{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.DenomToERC20(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetAttestations() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "attestations",
+		Short: "Get recent attestations, optionally filtered",
+		Long: "Get recent attestations ordered by event nonce. An attestation is returned if it matches any of the " +
+			"--claim-type, --nonce and --height-filter filters provided, and all of the --observed, --min-height, " +
+			"--max-height and --voter filters provided",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := readOptionalPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryAttestationsRequest{Pagination: pageReq}
+			if req.OrderBy, err = cmd.Flags().GetString(FlagOrderBy); err != nil {
+				return err
+			}
+			if req.ClaimType, err = cmd.Flags().GetString(FlagClaimType); err != nil {
+				return err
+			}
+			if req.Nonce, err = cmd.Flags().GetUint64(FlagNonce); err != nil {
+				return err
+			}
+			if req.Height, err = cmd.Flags().GetUint64(FlagHeight); err != nil {
+				return err
+			}
+			if req.Observed, err = cmd.Flags().GetString(FlagObserved); err != nil {
+				return err
+			}
+			if req.MinHeight, err = cmd.Flags().GetUint64(FlagMinHeight); err != nil {
+				return err
+			}
+			if req.MaxHeight, err = cmd.Flags().GetUint64(FlagMaxHeight); err != nil {
+				return err
+			}
+			if req.Voter, err = cmd.Flags().GetString(FlagVoter); err != nil {
+				return err
+			}
+
+			res, err := queryClient.GetAttestations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(FlagOrderBy, "", "order by event nonce, either asc or desc (default asc)")
+	cmd.Flags().String(FlagClaimType, "", "filter by claim type, e.g. CLAIM_TYPE_SEND_TO_COSMOS")
+	cmd.Flags().Uint64(FlagNonce, 0, "filter by event nonce")
+	cmd.Flags().Uint64(FlagHeight, 0, "filter by Ethereum block height")
+	cmd.Flags().String(FlagObserved, "", "filter by observed status, either true or false")
+	cmd.Flags().Uint64(FlagMinHeight, 0, "filter by a minimum (inclusive) Ethereum block height")
+	cmd.Flags().Uint64(FlagMaxHeight, 0, "filter by a maximum (inclusive) Ethereum block height")
+	cmd.Flags().String(FlagVoter, "", "filter by the bech32 operator address of a voting validator")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "attestations")
+	return cmd
+}
+
+func CmdGetDelegateKeysByValidator() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "delegate-keys-by-validator [bech32 validator operator address]",
+		Short: "Get the delegate Ethereum and orchestrator addresses of a particular validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDelegateKeysByValidatorAddress{
+				ValidatorAddress: args[0],
+			}
+
+			res, err := queryClient.GetDelegateKeyByValidator(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDelegateKeysByEth() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "delegate-keys-by-eth [eth address]",
+		Short: "Get the validator and orchestrator addresses of a particular delegate Ethereum address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDelegateKeysByEthAddress{
+				EthAddress: args[0],
+			}
+
+			res, err := queryClient.GetDelegateKeyByEth(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDelegateKeysByOrchestrator() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "delegate-keys-by-orchestrator [bech32 orchestrator address]",
+		Short: "Get the validator and Ethereum addresses of a particular orchestrator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDelegateKeysByOrchestratorAddress{
+				OrchestratorAddress: args[0],
+			}
+
+			res, err := queryClient.GetDelegateKeyByOrchestrator(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDelegateKeys() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "delegate-keys",
+		Short: "Get the delegate keys of every validator",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := readOptionalPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDelegateKeysRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DelegateKeys(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegate keys")
+	return cmd
+}
+
+// readOptionalPageRequest reads the pagination flags, returning nil when none of them were set so that
+// the query applies its own default page
+func readOptionalPageRequest(cmd *cobra.Command) (*query.PageRequest, error) {
+	for _, flag := range []string{
+		flags.FlagPage, flags.FlagPageKey, flags.FlagOffset, flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
+	} {
+		if cmd.Flags().Changed(flag) {
+			return client.ReadPageRequest(cmd.Flags())
+		}
+	}
+	return nil, nil
+}
@@ -1,10 +1,12 @@
 package cli
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -13,12 +15,21 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
+// Flags used to prove possession of an ethereum delegate key
+const (
+	FlagEthPrivateKey    = "eth-private-key"
+	FlagEthSignature     = "eth-signature"
+	FlagGravityID        = "gravity-id"
+	FlagDelegateKeyNonce = "delegate-key-nonce"
+)
+
 func GetTxCmd(storeKey string) *cobra.Command {
 	// needed for governance proposal txs in cli case
 	// internal check prevents double registration in node case
@@ -38,9 +49,12 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		CmdCancelSendToEth(),
 		CmdRequestBatch(),
 		CmdSetOrchestratorAddress(),
+		CmdRotateDelegateKeys(),
 		CmdGovIbcMetadataProposal(),
 		CmdGovAirdropProposal(),
 		CmdGovUnhaltBridgeProposal(),
+		CmdGovCancelBatchProposal(),
+		CmdGovRemovePendingTransfersProposal(),
 		CmdExecutePendingIbcAutoForwards(),
 	}...)
 
@@ -260,6 +274,120 @@ func CmdGovUnhaltBridgeProposal() *cobra.Command {
 	return cmd
 }
 
+func CmdGovCancelBatchProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gov-cancel-batch [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to cancel a batch once it has timed out on Ethereum, optionally refunding some of its transactions",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.CancelBatchProposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid metadata or proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGovRemovePendingTransfersProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gov-remove-pending-transfers [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to remove the pending transfers of some senders or destinations from the pool, sending their funds to the community pool or back to the senders",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.RemovePendingTransfersProposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid metadata or proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdSendToEth() *cobra.Command {
 	//nolint: exhaustivestruct
 	cmd := &cobra.Command{
@@ -377,16 +505,41 @@ func CmdSetOrchestratorAddress() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set-orchestrator-address [validator-address] [orchestrator-address] [ethereum-address]",
 		Short: "Allows validators to delegate their voting responsibilities to a given key.",
-		Args:  cobra.ExactArgs(3),
+		Long: `Allows validators to delegate their voting responsibilities to a given key.
+
+The ethereum key has to prove that the validator controls it by signing the delegate keys sign hash, either
+pass its private key with --eth-private-key or a signature made elsewhere with --eth-signature. The gravity id
+signed over is read from the chain unless --gravity-id is given.`,
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
+			val, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid validator address")
+			}
+			orch, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid orchestrator address")
+			}
+			ethAddr, err := types.NewEthAddress(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid ethereum address")
+			}
+
+			// a validator can only set its delegate keys while it has none, so its delegate key nonce is zero
+			signature, err := readDelegateKeysSignature(cmd, cliCtx, val, orch, 0)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgSetOrchestratorAddress{
-				Validator:    args[0],
-				Orchestrator: args[1],
-				EthAddress:   args[2],
+				Validator:    val.String(),
+				Orchestrator: orch.String(),
+				EthAddress:   ethAddr.GetAddress().Hex(),
+				EthSignature: strings.TrimPrefix(signature, "0x"),
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -395,10 +548,117 @@ func CmdSetOrchestratorAddress() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
 		},
 	}
+	cmd.Flags().String(FlagEthPrivateKey, "", "hex encoded private key of the ethereum address, used to sign the delegate keys sign hash")
+	cmd.Flags().String(FlagEthSignature, "", "hex encoded signature of the ethereum address over the delegate keys sign hash")
+	cmd.Flags().String(FlagGravityID, "", "the gravity id to sign over, read from the chain params when empty")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// CmdRotateDelegateKeys replaces the delegate keys of a validator
+func CmdRotateDelegateKeys() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "rotate-delegate-keys [validator-address] [orchestrator-address] [ethereum-address]",
+		Short: "Allows validators to replace the delegate keys they have set.",
+		Long: `Allows validators to replace the delegate keys they have set, for example because the orchestrator
+or ethereum key has been lost or compromised. Either key may stay unchanged.
+
+The new ethereum key has to prove that the validator controls it like in set-orchestrator-address. The delegate
+key nonce signed over is the number of times the validator has set or rotated its keys, so it is 1 for the first
+rotation and must be passed with --delegate-key-nonce for every later one.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			val, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid validator address")
+			}
+			orch, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid orchestrator address")
+			}
+			ethAddr, err := types.NewEthAddress(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid ethereum address")
+			}
+			nonce, err := cmd.Flags().GetUint64(FlagDelegateKeyNonce)
+			if err != nil {
+				return err
+			}
+
+			signature, err := readDelegateKeysSignature(cmd, cliCtx, val, orch, nonce)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRotateDelegateKeys{
+				Validator:    val.String(),
+				Orchestrator: orch.String(),
+				EthAddress:   ethAddr.GetAddress().Hex(),
+				EthSignature: strings.TrimPrefix(signature, "0x"),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().String(FlagEthPrivateKey, "", "hex encoded private key of the new ethereum address, used to sign the delegate keys sign hash")
+	cmd.Flags().String(FlagEthSignature, "", "hex encoded signature of the new ethereum address over the delegate keys sign hash")
+	cmd.Flags().String(FlagGravityID, "", "the gravity id to sign over, read from the chain params when empty")
+	cmd.Flags().Uint64(FlagDelegateKeyNonce, 1, "the delegate key nonce of the validator to sign over")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// readDelegateKeysSignature returns the --eth-signature flag, or signs the delegate keys sign hash with the
+// --eth-private-key flag if it is not set
+func readDelegateKeysSignature(cmd *cobra.Command, clientCtx client.Context, val sdk.ValAddress, orch sdk.AccAddress, nonce uint64) (string, error) {
+	signature, err := cmd.Flags().GetString(FlagEthSignature)
+	if err != nil || signature != "" {
+		return signature, err
+	}
+	privateKeyHex, err := cmd.Flags().GetString(FlagEthPrivateKey)
+	if err != nil {
+		return "", err
+	}
+	if privateKeyHex == "" {
+		return "", fmt.Errorf("either --%s or --%s is required", FlagEthPrivateKey, FlagEthSignature)
+	}
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(privateKeyHex, "0x"))
+	if err != nil {
+		return "", sdkerrors.Wrap(err, "invalid ethereum private key")
+	}
+	gravityID, err := readGravityID(cmd, clientCtx)
+	if err != nil {
+		return "", err
+	}
+	hash := types.GetDelegateKeysSignHash(gravityID, val, orch, nonce)
+	sigBytes, err := types.NewEthereumSignature(hash, privateKey)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(sigBytes), nil
+}
+
+// readGravityID returns the --gravity-id flag, or the gravity id of the chain if it is not set
+func readGravityID(cmd *cobra.Command, clientCtx client.Context) (string, error) {
+	gravityID, err := cmd.Flags().GetString(FlagGravityID)
+	if err != nil || gravityID != "" {
+		return gravityID, err
+	}
+	res, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+	if err != nil {
+		return "", sdkerrors.Wrap(err, "unable to query the gravity id")
+	}
+	return res.Params.GravityId, nil
+}
+
 func CmdExecutePendingIbcAutoForwards() *cobra.Command {
 	//nolint: exhaustivestruct
 	cmd := &cobra.Command{
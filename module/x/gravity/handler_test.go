@@ -2,12 +2,15 @@ package gravity
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -39,7 +42,7 @@ func TestHandleMsgSendToEth(t *testing.T) {
 
 	ctx := input.Context
 	h := NewHandler(input.GravityKeeper)
-	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, startingCoins))
+	require.NoError(t, keeper.MintDepositedVouchers(ctx, input.GravityKeeper, startingCoins))
 	input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, userCosmosAddr, startingCoins)
 	balance1 := input.BankKeeper.GetAllBalances(ctx, userCosmosAddr)
 	assert.Equal(t, sdk.Coins{sdk.NewCoin(denom, startingCoinAmount)}, balance1)
@@ -545,9 +548,11 @@ func TestMsgSendToCosmosForeignPrefixedAddress(t *testing.T) {
 //nolint: exhaustivestruct
 func TestMsgSetOrchestratorAddresses(t *testing.T) {
 	var (
-		ethAddress, _                 = types.NewEthAddress("0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255")
+		ethKey, _                     = crypto.GenerateKey()
+		ethAddress, _                 = types.NewEthAddress(crypto.PubkeyToAddress(ethKey.PublicKey).Hex())
 		cosmosAddress  sdk.AccAddress = bytes.Repeat([]byte{0x1}, 20)
-		ethAddress2, _                = types.NewEthAddress("0x26126048c706fB45a5a6De8432F428e794d0b952")
+		ethKey2, _                    = crypto.GenerateKey()
+		ethAddress2, _                = types.NewEthAddress(crypto.PubkeyToAddress(ethKey2.PublicKey).Hex())
 		cosmosAddress2 sdk.AccAddress = bytes.Repeat([]byte{0x2}, 20)
 		blockTime                     = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
 		blockTime2                    = time.Date(2020, 9, 15, 15, 20, 10, 0, time.UTC)
@@ -563,12 +568,33 @@ func TestMsgSetOrchestratorAddresses(t *testing.T) {
 	ctx = ctx.WithBlockTime(blockTime)
 	valAddress, err := sdk.ValAddressFromBech32(input.StakingKeeper.GetValidators(ctx, 10)[0].OperatorAddress)
 	require.NoError(t, err)
+	sign := func(key *ecdsa.PrivateKey, gravityID string, nonce uint64) []byte {
+		sig, err := types.NewEthereumSignature(types.GetDelegateKeysSignHash(gravityID, valAddress, cosmosAddress, nonce), key)
+		require.NoError(t, err)
+		return sig
+	}
+	gravityID := k.GetGravityID(ctx)
+
+	// the eth key has to prove that the validator controls it
+	for name, sig := range map[string][]byte{
+		"signed by another key":         sign(ethKey2, gravityID, 0),
+		"signed for another gravity id": sign(ethKey, gravityID+"x", 0),
+		"signed for another nonce":      sign(ethKey, gravityID, 1),
+	} {
+		msg := types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, *ethAddress, sig)
+		_, err = h(ctx, msg)
+		require.Error(t, err, name)
+	}
+	_, found := k.GetEthAddressByValidator(ctx, valAddress)
+	require.False(t, found)
+	require.Equal(t, uint64(0), k.GetDelegateKeyNonce(ctx, valAddress))
 
 	// test setting keys
-	msg := types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, *ethAddress)
+	msg := types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, *ethAddress, sign(ethKey, gravityID, 0))
 	ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(blockHeight)
 	_, err = h(ctx, msg)
 	require.NoError(t, err)
+	require.Equal(t, uint64(1), k.GetDelegateKeyNonce(ctx, valAddress))
 
 	// test all lookup methods
 
@@ -596,12 +622,133 @@ func TestMsgSetOrchestratorAddresses(t *testing.T) {
 
 	// try to set values again. This should fail see issue #344 for why allowing this
 	// would require keeping a history of all validators delegate keys forever
-	msg = types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress2, *ethAddress2)
+	sig2, err := types.NewEthereumSignature(types.GetDelegateKeysSignHash(gravityID, valAddress, cosmosAddress2, 1), ethKey2)
+	require.NoError(t, err)
+	msg = types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress2, *ethAddress2, sig2)
 	ctx = ctx.WithBlockTime(blockTime2).WithBlockHeight(blockHeight2)
 	_, err = h(ctx, msg)
 	require.Error(t, err)
 }
 
+// TestMsgRotateDelegateKeys ensures that a validator can replace its delegate keys while the confirms of
+// the previous keys stay valid for what was created before the rotation
+func TestMsgRotateDelegateKeys(t *testing.T) {
+	var (
+		ethKey, _                     = crypto.GenerateKey()
+		ethAddress, _                 = types.NewEthAddress(crypto.PubkeyToAddress(ethKey.PublicKey).Hex())
+		cosmosAddress  sdk.AccAddress = bytes.Repeat([]byte{0x1}, 20)
+		ethKey2, _                    = crypto.GenerateKey()
+		ethAddress2, _                = types.NewEthAddress(crypto.PubkeyToAddress(ethKey2.PublicKey).Hex())
+		cosmosAddress2 sdk.AccAddress = bytes.Repeat([]byte{0x2}, 20)
+		blockHeight    int64          = 200
+		blockHeight2   int64          = 210
+	)
+	input, ctx := keeper.SetupTestChain(t, []uint64{1000000000}, false)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	k := input.GravityKeeper
+	h := NewHandler(input.GravityKeeper)
+	valAddress, err := sdk.ValAddressFromBech32(input.StakingKeeper.GetValidators(ctx, 10)[0].OperatorAddress)
+	require.NoError(t, err)
+	gravityID := k.GetGravityID(ctx)
+	sign := func(key *ecdsa.PrivateKey, orch sdk.AccAddress, nonce uint64) []byte {
+		sig, err := types.NewEthereumSignature(types.GetDelegateKeysSignHash(gravityID, valAddress, orch, nonce), key)
+		require.NoError(t, err)
+		return sig
+	}
+	confirm := func(ctx sdk.Context, valset types.Valset, key *ecdsa.PrivateKey, orch sdk.AccAddress) error {
+		sig, err := types.NewEthereumSignature(valset.GetCheckpoint(gravityID), key)
+		require.NoError(t, err)
+		ethAddr, err := types.NewEthAddress(crypto.PubkeyToAddress(key.PublicKey).Hex())
+		require.NoError(t, err)
+		_, err = h(ctx, types.NewMsgValsetConfirm(valset.Nonce, *ethAddr, orch, hex.EncodeToString(sig)))
+		return err
+	}
+
+	// keys can only be rotated once they are set
+	_, err = h(ctx, types.NewMsgRotateDelegateKeys(valAddress, cosmosAddress2, *ethAddress2, sign(ethKey2, cosmosAddress2, 0)))
+	require.Error(t, err)
+
+	ctx = ctx.WithBlockHeight(blockHeight)
+	_, err = h(ctx, types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, *ethAddress, sign(ethKey, cosmosAddress, 0)))
+	require.NoError(t, err)
+	inFlight := k.SetValsetRequest(ctx)
+
+	// the new eth key has to prove that the validator controls it
+	ctx = ctx.WithBlockHeight(blockHeight2)
+	for name, msg := range map[string]*types.MsgRotateDelegateKeys{
+		"signed by the old key":    types.NewMsgRotateDelegateKeys(valAddress, cosmosAddress2, *ethAddress2, sign(ethKey, cosmosAddress2, 1)),
+		"signed for another nonce": types.NewMsgRotateDelegateKeys(valAddress, cosmosAddress2, *ethAddress2, sign(ethKey2, cosmosAddress2, 0)),
+		"signed for another orch":  types.NewMsgRotateDelegateKeys(valAddress, cosmosAddress2, *ethAddress2, sign(ethKey2, cosmosAddress, 1)),
+		"unchanged keys":           types.NewMsgRotateDelegateKeys(valAddress, cosmosAddress, *ethAddress, sign(ethKey, cosmosAddress, 1)),
+	} {
+		_, err = h(ctx, msg)
+		require.Error(t, err, name)
+	}
+
+	_, err = h(ctx, types.NewMsgRotateDelegateKeys(valAddress, cosmosAddress2, *ethAddress2, sign(ethKey2, cosmosAddress2, 1)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), k.GetDelegateKeyNonce(ctx, valAddress))
+
+	// the new keys replace the old ones
+	ethLookup, found := k.GetEthAddressByValidator(ctx, valAddress)
+	require.True(t, found)
+	require.Equal(t, ethAddress2, ethLookup)
+	valLookup, found := k.GetOrchestratorValidator(ctx, cosmosAddress2)
+	require.True(t, found)
+	require.Equal(t, valAddress, valLookup.GetOperator())
+	_, found = k.GetOrchestratorValidator(ctx, cosmosAddress)
+	require.False(t, found)
+	require.Equal(t, []types.MsgSetOrchestratorAddress{{
+		Validator:    valAddress.String(),
+		Orchestrator: cosmosAddress2.String(),
+		EthAddress:   ethAddress2.GetAddress().Hex(),
+	}}, k.GetDelegateKeys(ctx))
+
+	// the old keys can still be attributed to the validator, but not be registered again
+	valAddrLookup, found := k.GetOrchestratorValidatorAddr(ctx, cosmosAddress)
+	require.True(t, found)
+	require.Equal(t, valAddress, valAddrLookup)
+	valLookup, found = k.GetValidatorByEthAddress(ctx, *ethAddress)
+	require.True(t, found)
+	require.Equal(t, valAddress, valLookup.GetOperator())
+	_, err = h(ctx, types.NewMsgRotateDelegateKeys(valAddress, cosmosAddress, *ethAddress, sign(ethKey, cosmosAddress, 2)))
+	require.Error(t, err)
+
+	// a valset with the new eth address was requested
+	latest := k.GetLatestValset(ctx)
+	require.Greater(t, latest.Nonce, inFlight.Nonce)
+	require.Equal(t, ethAddress2.GetAddress().Hex(), latest.Members[0].EthereumAddress)
+
+	// the retired orchestrator can not confirm anything, the new one may confirm the in flight valset
+	// with either key but the new valset only with the new key
+	require.Error(t, confirm(ctx, inFlight, ethKey, cosmosAddress))
+	require.NoError(t, confirm(ctx, inFlight, ethKey, cosmosAddress2))
+	require.Error(t, confirm(ctx, *latest, ethKey, cosmosAddress2))
+	require.NoError(t, confirm(ctx, *latest, ethKey2, cosmosAddress2))
+
+	// the keys may only be rotated once per block, the record of the first rotation must not be overwritten
+	ethKey3, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddress3, err := types.NewEthAddress(crypto.PubkeyToAddress(ethKey3.PublicKey).Hex())
+	require.NoError(t, err)
+	cosmosAddress3 := sdk.AccAddress(bytes.Repeat([]byte{0x3}, 20))
+	_, err = h(ctx, types.NewMsgRotateDelegateKeys(valAddress, cosmosAddress3, *ethAddress3, sign(ethKey3, cosmosAddress3, 2)))
+	require.Error(t, err)
+	require.Equal(t, uint64(2), k.GetDelegateKeyNonce(ctx, valAddress))
+	rotations := k.GetDelegateKeyRotations(ctx)
+	require.Len(t, rotations, 1)
+	require.Equal(t, cosmosAddress.String(), rotations[0].PreviousOrchestrator)
+
+	ctx = ctx.WithBlockHeight(blockHeight2 + 1)
+	_, err = h(ctx, types.NewMsgRotateDelegateKeys(valAddress, cosmosAddress3, *ethAddress3, sign(ethKey3, cosmosAddress3, 2)))
+	require.NoError(t, err)
+	rotations = k.GetDelegateKeyRotations(ctx)
+	require.Len(t, rotations, 2)
+	require.Equal(t, cosmosAddress.String(), rotations[0].PreviousOrchestrator)
+	require.Equal(t, cosmosAddress2.String(), rotations[1].PreviousOrchestrator)
+}
+
 // TestMsgValsetConfirm ensures that the valset confirm message sets a validator set confirm
 // in the store and validates the signature
 func TestMsgValsetConfirm(t *testing.T) {
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -13,6 +14,10 @@ import (
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
+// AttestationEventsToKeep is the number of event nonces below the last observed one whose attestations are kept,
+// purely to let frontends view recent oracle history. The EndBlocker prunes the attestations of older nonces
+const AttestationEventsToKeep = 1000
+
 // TODO-JT: carefully look at atomicity of this function
 func (k Keeper) Attest(
 	ctx sdk.Context,
@@ -60,6 +65,8 @@ func (k Keeper) Attest(
 
 	k.SetAttestation(ctx, claim.GetEventNonce(), hash, att)
 	k.SetLastEventNonceByValidator(ctx, valAddr, claim.GetEventNonce())
+	_, lag := k.getEventNonceLag(ctx, valAddr, k.GetLastObservedEventNonce(ctx))
+	setEventNonceLagGauge(ctx, valAddr, lag)
 
 	return att, nil
 }
@@ -109,6 +116,7 @@ func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 
 				k.processAttestation(ctx, att, claim)
 				k.emitObservedEvent(ctx, att, claim)
+				incrAttestationsObservedCounter(ctx, claim.GetType())
 
 				break
 			}
@@ -160,11 +168,18 @@ func (k Keeper) emitObservedEvent(ctx sdk.Context, att *types.Attestation, claim
 	)
 }
 
-// SetAttestation sets the attestation in the store
+// SetAttestation sets the attestation in the store and counts it as pending until it is observed
 func (k Keeper) SetAttestation(ctx sdk.Context, eventNonce uint64, claimHash []byte, att *types.Attestation) {
 	store := ctx.KVStore(k.storeKey)
 	aKey := types.GetAttestationKey(eventNonce, claimHash)
+	wasPending := false
+	if prev := k.GetAttestation(ctx, eventNonce, claimHash); prev != nil {
+		wasPending = !prev.Observed
+	}
 	store.Set(aKey, k.cdc.MustMarshal(att))
+	if pending := !att.Observed; pending != wasPending {
+		k.updatePendingAttestations(ctx, att, pending)
+	}
 }
 
 // GetAttestation return an attestation given a nonce
@@ -192,9 +207,79 @@ func (k Keeper) DeleteAttestation(ctx sdk.Context, att types.Attestation) {
 	}
 	store := ctx.KVStore(k.storeKey)
 
+	if prev := k.GetAttestation(ctx, claim.GetEventNonce(), hash); prev != nil && !prev.Observed {
+		k.updatePendingAttestations(ctx, prev, false)
+	}
 	store.Delete(types.GetAttestationKey(claim.GetEventNonce(), hash))
 }
 
+// GetPendingAttestations returns the number of attestations of a claim type which have not been observed yet
+func (k Keeper) GetPendingAttestations(ctx sdk.Context, claimType types.ClaimType) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPendingAttestationsKey(claimType))
+	if bz == nil {
+		return 0
+	}
+	return types.UInt64FromBytes(bz)
+}
+
+// updatePendingAttestations counts the attestation as pending or no longer pending and updates the pending
+// attestations gauge of its claim type
+func (k Keeper) updatePendingAttestations(ctx sdk.Context, att *types.Attestation, pending bool) {
+	claim, err := k.UnpackAttestationClaim(att)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "unable to unpack attestation claim"))
+	}
+	count := k.GetPendingAttestations(ctx, claim.GetType())
+	if pending {
+		count++
+	} else {
+		if count == 0 {
+			panic(fmt.Sprintf("no pending %s attestation left to count as observed or deleted", claim.GetType()))
+		}
+		count--
+	}
+	if count == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.GetPendingAttestationsKey(claim.GetType()))
+	} else {
+		ctx.KVStore(k.storeKey).Set(types.GetPendingAttestationsKey(claim.GetType()), types.UInt64Bytes(count))
+	}
+	setPendingAttestationsGauge(ctx, claim.GetType(), count)
+}
+
+// claimTypes returns every claim type but the unspecified one in order
+func claimTypes() []types.ClaimType {
+	claimTypes := make([]types.ClaimType, 0, len(types.ClaimType_name))
+	for claimType := range types.ClaimType_name {
+		if types.ClaimType(claimType) != types.CLAIM_TYPE_UNSPECIFIED {
+			claimTypes = append(claimTypes, types.ClaimType(claimType))
+		}
+	}
+	sort.Slice(claimTypes, func(i, j int) bool { return claimTypes[i] < claimTypes[j] })
+	return claimTypes
+}
+
+// recountPendingAttestations sets the number of pending attestations of every claim type from the attestations in
+// the store, this is done once when the counts start to be kept
+func (k Keeper) recountPendingAttestations(ctx sdk.Context) {
+	counts := make(map[types.ClaimType]uint64)
+	k.IterateAttestations(ctx, false, func(_ []byte, att types.Attestation) bool {
+		if att.Observed {
+			return false
+		}
+		claim, err := k.UnpackAttestationClaim(&att)
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "unable to unpack attestation claim"))
+		}
+		counts[claim.GetType()]++
+		return false
+	})
+	for _, claimType := range claimTypes() {
+		if counts[claimType] != 0 {
+			ctx.KVStore(k.storeKey).Set(types.GetPendingAttestationsKey(claimType), types.UInt64Bytes(counts[claimType]))
+		}
+	}
+}
+
 // GetAttestationMapping returns a mapping of eventnonce -> attestations at that nonce
 // it also returns a pre-sorted array of the keys, this assists callers of this function
 // by providing a deterministic iteration order. You should always iterate over ordered keys
@@ -257,6 +342,37 @@ func (k Keeper) IterateAttestations(ctx sdk.Context, reverse bool, cb func([]byt
 	}
 }
 
+// IterateAttestationsFromNonce iterates through the attestations at and above the given event nonce in ascending
+// nonce order. Attestations are keyed by event nonce first, so those below the nonce are never read and the
+// nonce passed to cb is read from the key without unpacking the claim
+func (k Keeper) IterateAttestationsFromNonce(ctx sdk.Context, nonce uint64, cb func(nonce uint64, att types.Attestation) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OracleAttestationKey)
+	iter := prefixStore.Iterator(types.UInt64Bytes(nonce), nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var att types.Attestation
+		k.cdc.MustUnmarshal(iter.Value(), &att)
+		// cb returns true to stop early
+		if cb(types.UInt64FromBytes(iter.Key()[:8]), att) {
+			return
+		}
+	}
+}
+
+// GetAttestationsAtNonce returns every attestation at the given event nonce, there is more than one if validators
+// disagree about the event at the nonce
+func (k Keeper) GetAttestationsAtNonce(ctx sdk.Context, nonce uint64) (out []types.Attestation) {
+	k.IterateAttestationsFromNonce(ctx, nonce, func(attNonce uint64, att types.Attestation) bool {
+		if attNonce != nonce {
+			return true
+		}
+		out = append(out, att)
+		return false
+	})
+	return
+}
+
 // GetMostRecentAttestations returns sorted (by nonce) attestations up to a provided limit number of attestations
 // Note: calls GetAttestationMapping in the hopes that there are potentially many attestations
 // which are distributed between few nonces to minimize sorting time
@@ -313,8 +429,10 @@ func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context) types.LastOb
 	return height
 }
 
-// SetLastObservedEthereumBlockHeight sets the block height in the store.
+// SetLastObservedEthereumBlockHeight sets the block height in the store and samples the Ethereum block time
+// from it, see sampleEthereumBlockTime
 func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, ethereumHeight uint64) {
+	k.sampleEthereumBlockTime(ctx, ethereumHeight)
 	store := ctx.KVStore(k.storeKey)
 	height := types.LastObservedEthereumBlockHeight{
 		EthereumBlockHeight: ethereumHeight,
@@ -323,6 +441,13 @@ func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, ethereumHeig
 	store.Set(types.LastObservedEthereumBlockHeightKey, k.cdc.MustMarshal(&height))
 }
 
+// setLastObservedEthereumBlockHeightWithCosmos sets both the Ethereum and Cosmos heights of the last
+// observed Ethereum block, only to be used when restoring the value from genesis
+func (k Keeper) setLastObservedEthereumBlockHeightWithCosmos(ctx sdk.Context, height types.LastObservedEthereumBlockHeight) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastObservedEthereumBlockHeightKey, k.cdc.MustMarshal(&height))
+}
+
 // GetLastObservedValset retrieves the last observed validator set from the store
 // WARNING: This value is not an up to date validator set on Ethereum, it is a validator set
 // that AT ONE POINT was the one in the Gravity bridge on Ethereum. If you assume that it's up
@@ -380,6 +505,21 @@ func (k Keeper) GetLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValA
 	return types.UInt64FromBytes(bytes)
 }
 
+// IterateLastEventNonceByValidator iterates through the stored last event nonce of every validator, validators which
+// have never submitted a claim are not included
+func (k Keeper) IterateLastEventNonceByValidator(ctx sdk.Context, cb func(validator sdk.ValAddress, nonce uint64) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.LastEventNonceByValidatorKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(sdk.ValAddress(iter.Key()), types.UInt64FromBytes(iter.Value())) {
+			break
+		}
+	}
+}
+
 // setLastEventNonceByValidator sets the latest event nonce for a give validator
 func (k Keeper) SetLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress, nonce uint64) {
 	if err := sdk.VerifyAddressFormat(validator); err != nil {
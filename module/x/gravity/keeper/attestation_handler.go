@@ -124,6 +124,9 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 			return err
 		}
 	}
+	a.keeper.updateBridgeTotals(ctx, *tokenAddress, func(totals *types.BridgeTotals) {
+		totals.Deposited = addToTotal(totals.Deposited, claim.Amount)
+	})
 
 	if !invalidAddress { // address appears valid, attempt to send minted/locked coins to receiver
 		preSendBalance := a.keeper.bankKeeper.GetBalance(ctx, moduleAddr, denom)
@@ -345,6 +348,10 @@ func (a AttestationHandler) handleValsetUpdated(ctx sdk.Context, claim types.Msg
 				}
 				return sdkerrors.Wrapf(err, "unable to mint cosmos originated coins %v", coins)
 			}
+			// the reward is now in circulation on Ethereum just like the tokens of an executed batch
+			a.keeper.updateBridgeTotals(ctx, *rewardAddress, func(totals *types.BridgeTotals) {
+				totals.Withdrawn = addToTotal(totals.Withdrawn, claim.RewardAmount)
+			})
 		} else {
 			// // If it is not cosmos originated, burn the coins (aka Vouchers)
 			// // so that we don't think we have more in the bridge than we actually do
@@ -420,6 +427,7 @@ func (a AttestationHandler) mintEthereumOriginatedVouchers(
 		)
 		return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
 	}
+	incrTokenCounter(ctx, MetricKeyTokensMinted, coins)
 
 	postMintBalance := a.keeper.bankKeeper.GetBalance(ctx, moduleAddr, coin.Denom)
 	if !postMintBalance.Sub(preMintBalance).Amount.Equal(claim.Amount) {
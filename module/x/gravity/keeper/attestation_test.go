@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -25,6 +26,13 @@ func TestGetAndDeleteAttestation(t *testing.T) {
 	}
 	recentAttestations := k.GetMostRecentAttestations(ctx, uint64(length))
 	require.True(t, len(recentAttestations) == length)
+	require.Equal(t, uint64(length), k.GetPendingAttestations(ctx, types.CLAIM_TYPE_SEND_TO_COSMOS))
+
+	// Observing an attestation no longer counts it as pending
+	att := k.GetAttestation(ctx, 1, hashes[0])
+	att.Observed = true
+	k.SetAttestation(ctx, 1, hashes[0], att)
+	require.Equal(t, uint64(length-1), k.GetPendingAttestations(ctx, types.CLAIM_TYPE_SEND_TO_COSMOS))
 
 	// Delete last 3 attestations
 	var nilAtt *types.Attestation
@@ -38,6 +46,11 @@ func TestGetAndDeleteAttestation(t *testing.T) {
 	}
 	recentAttestations = k.GetMostRecentAttestations(ctx, uint64(10))
 	require.True(t, len(recentAttestations) == 7)
+	require.Equal(t, uint64(6), k.GetPendingAttestations(ctx, types.CLAIM_TYPE_SEND_TO_COSMOS))
+
+	// Recounting the attestations in the store finds the same number
+	k.recountPendingAttestations(ctx)
+	require.Equal(t, uint64(6), k.GetPendingAttestations(ctx, types.CLAIM_TYPE_SEND_TO_COSMOS))
 
 	// Check all attestations again
 	for i := 0; i < 7; i++ {
@@ -119,6 +132,52 @@ func TestGetSetLastObservedEthereumBlockHeight(t *testing.T) {
 	require.Equal(t, ethereumHeight, ethHeight.EthereumBlockHeight)
 }
 
+// nolint: exhaustivestruct
+func TestEthereumBlockTimeEstimate(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	start := time.Unix(1600000000, 0)
+	ctx := input.Context.WithBlockTime(start)
+
+	// the first observation only starts the first sample, the param is used until then
+	k.SetLastObservedEthereumBlockHeight(ctx, 100)
+	require.Equal(t, uint64(15000), k.GetEthereumBlockTime(ctx))
+	// a height observed in the same Cosmos block can not be timed
+	k.SetLastObservedEthereumBlockHeight(ctx, 101)
+	require.Equal(t, types.EthereumBlockTimeEstimate{
+		EthereumBlockHeight: 100,
+		CosmosBlockTime:     uint64(start.Unix() * 1000),
+	}, k.GetEthereumBlockTimeEstimate(ctx))
+
+	// 10 blocks in 2 minutes
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 24).WithBlockTime(start.Add(2 * time.Minute))
+	k.SetLastObservedEthereumBlockHeight(ctx, 110)
+	require.Equal(t, uint64(12000), k.GetEthereumBlockTime(ctx))
+
+	// 10 blocks in 10 seconds is bounded to half the param and moves the estimate by a tenth of the difference
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 2).WithBlockTime(start.Add(130 * time.Second))
+	k.SetLastObservedEthereumBlockHeight(ctx, 120)
+	estimate := k.GetEthereumBlockTimeEstimate(ctx)
+	require.Equal(t, uint64(11550), estimate.AverageEthereumBlockTime)
+	require.Equal(t, uint64(2), estimate.Samples)
+
+	// 10 Cosmos blocks of 5 seconds are 4 Ethereum blocks, and the timeout of 60001 milliseconds 5 more
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	require.Equal(t, uint64(129), k.getBatchTimeoutHeight(ctx))
+
+	res, err := k.EthereumBlockTime(sdktypes.WrapSDKContext(ctx), &types.QueryEthereumBlockTimeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, estimate, res.Estimate)
+	require.Equal(t, uint64(11550), res.EthereumBlockTime)
+
+	// the estimate is bounded by the current param
+	params := k.GetParams(ctx)
+	params.AverageEthereumBlockTime = 40000
+	k.SetParams(ctx, params)
+	require.Equal(t, uint64(20000), k.GetEthereumBlockTime(ctx))
+}
+
 func TestGetSetLastObservedValset(t *testing.T) {
 	input := CreateTestEnv(t)
 	k := input.GravityKeeper
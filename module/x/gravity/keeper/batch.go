@@ -65,10 +65,7 @@ func (k Keeper) BuildOutgoingTXBatch(
 	// set the current block height when storing the batch
 	batch.Block = uint64(ctx.BlockHeight())
 	k.StoreBatch(ctx, *batch)
-
-	// Get the checkpoint and store it as a legit past batch
-	checkpoint := batch.GetCheckpoint(k.GetGravityID(ctx))
-	k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
+	incrTokenContractCounter(ctx, MetricKeyBatchesCreated, contract)
 
 	ctx.EventManager().EmitTypedEvent(
 		&types.EventOutgoingBatch{
@@ -83,22 +80,7 @@ func (k Keeper) BuildOutgoingTXBatch(
 
 // This gets the batch timeout height in Ethereum blocks.
 func (k Keeper) getBatchTimeoutHeight(ctx sdk.Context) uint64 {
-	params := k.GetParams(ctx)
-	currentCosmosHeight := ctx.BlockHeight()
-	// we store the last observed Cosmos and Ethereum heights, we do not concern ourselves if these values are zero because
-	// no batch can be produced if the last Ethereum block height is not first populated by a deposit event.
-	heights := k.GetLastObservedEthereumBlockHeight(ctx)
-	if heights.CosmosBlockHeight == 0 || heights.EthereumBlockHeight == 0 {
-		return 0
-	}
-	// we project how long it has been in milliseconds since the last Ethereum block height was observed
-	projectedMillis := (uint64(currentCosmosHeight) - heights.CosmosBlockHeight) * params.AverageBlockTime
-	// we convert that projection into the current Ethereum height using the average Ethereum block time in millis
-	projectedCurrentEthereumHeight := (projectedMillis / params.AverageEthereumBlockTime) + heights.EthereumBlockHeight
-	// we convert our target time for block timeouts (lets say 12 hours) into a number of blocks to
-	// place on top of our projection of the current Ethereum block height.
-	blocksToAdd := params.TargetBatchTimeout / params.AverageEthereumBlockTime
-	return projectedCurrentEthereumHeight + blocksToAdd
+	return k.GetEthereumTimeoutHeight(ctx, k.GetParams(ctx).TargetBatchTimeout)
 }
 
 // OutgoingTxBatchExecuted is run when the Cosmos chain detects that a batch has been executed on Ethereum
@@ -110,14 +92,17 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.Eth
 		panic(fmt.Sprintf("unknown batch nonce for outgoing tx batch %s %d", tokenContract.GetAddress().Hex(), nonce))
 	}
 	contract := b.TokenContract
+	totalWithdrawn := sdk.NewInt(0)
+	for _, tx := range b.Transactions {
+		totalWithdrawn = totalWithdrawn.Add(tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount))
+	}
+	k.updateBridgeTotals(ctx, contract, func(totals *types.BridgeTotals) {
+		totals.Withdrawn = addToTotal(totals.Withdrawn, totalWithdrawn)
+	})
 	// Burn tokens if they're Ethereum originated
 	if isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, contract); !isCosmosOriginated {
-		totalToBurn := sdk.NewInt(0)
-		for _, tx := range b.Transactions {
-			totalToBurn = totalToBurn.Add(tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount))
-		}
 		// burn vouchers to send them back to ETH
-		erc20, err := types.NewInternalERC20Token(totalToBurn, contract.GetAddress().Hex())
+		erc20, err := types.NewInternalERC20Token(totalWithdrawn, contract.GetAddress().Hex())
 		if err != nil {
 			panic(sdkerrors.Wrapf(err, "invalid ERC20 address in executed batch"))
 		}
@@ -125,6 +110,7 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.Eth
 		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnVouchers); err != nil {
 			panic(err)
 		}
+		incrTokenCounter(ctx, MetricKeyTokensBurned, burnVouchers)
 	}
 
 	// Iterate through remaining batches
@@ -143,6 +129,7 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.Eth
 	k.DeleteBatch(ctx, *b)
 	// Delete it's confirmations as well
 	k.DeleteBatchConfirms(ctx, *b)
+	incrTokenContractCounter(ctx, MetricKeyBatchesExecuted, contract)
 }
 
 // StoreBatch stores a transaction batch, it will refuse to overwrite an existing
@@ -159,6 +146,17 @@ func (k Keeper) StoreBatch(ctx sdk.Context, batch types.InternalOutgoingTxBatch)
 		panic(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Should never overwrite batch!"))
 	}
 	store.Set(key, k.cdc.MustMarshal(&externalBatch))
+	store.Set(types.GetOutgoingTxBatchBlockKey(batch.Block, batch.TokenContract, batch.BatchNonce), key)
+
+	// Store the checkpoint as a legit past batch, signing it must never be slashable
+	k.SetPastEthSignatureCheckpoint(ctx, types.PastEthSignatureCheckpoint{
+		Checkpoint:     k.GetBatchCheckpoint(ctx, batch),
+		Height:         uint64(ctx.BlockHeight()),
+		Type:           types.CHECKPOINT_TYPE_BATCH,
+		Nonce:          batch.BatchNonce,
+		InvalidationId: nil,
+		TokenContract:  batch.TokenContract.GetAddress().Hex(),
+	})
 }
 
 // DeleteBatch deletes an outgoing transaction batch
@@ -168,6 +166,69 @@ func (k Keeper) DeleteBatch(ctx sdk.Context, batch types.InternalOutgoingTxBatch
 	}
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce))
+	store.Delete(types.GetOutgoingTxBatchBlockKey(batch.Block, batch.TokenContract, batch.BatchNonce))
+	store.Delete(types.GetBatchTxRemovalsKey(batch.TokenContract, batch.BatchNonce))
+}
+
+// GetBatchTxRemovals returns the transactions cancel batch proposals remove from the given batch once it is
+// cancelled, nil if there are none
+func (k Keeper) GetBatchTxRemovals(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) []uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetBatchTxRemovalsKey(tokenContract, nonce))
+	if bz == nil {
+		return nil
+	}
+	var removals types.BatchTxRemovals
+	k.cdc.MustUnmarshal(bz, &removals)
+	return removals.TxIds
+}
+
+// setBatchTxRemovals records the transactions to remove from a batch once it is cancelled, the record is deleted
+// with the batch
+func (k Keeper) setBatchTxRemovals(ctx sdk.Context, removals types.BatchTxRemovals) {
+	contract, err := types.NewEthAddress(removals.TokenContract)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid batch tx removals token contract"))
+	}
+	removals.TokenContract = contract.GetAddress().Hex()
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBatchTxRemovalsKey(*contract, removals.BatchNonce), k.cdc.MustMarshal(&removals))
+}
+
+// IterateBatchTxRemovals iterates through the recorded transaction removals of every batch
+func (k Keeper) IterateBatchTxRemovals(ctx sdk.Context, cb func(removals types.BatchTxRemovals) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BatchTxRemovalsKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var removals types.BatchTxRemovals
+		k.cdc.MustUnmarshal(iter.Value(), &removals)
+		// cb returns true to stop early
+		if cb(removals) {
+			break
+		}
+	}
+}
+
+// IterateOutgoingTxBatchesByBlock iterates through the batches created in fromBlock or later, in ascending block
+// order, using the block index so that the iteration can stop early
+func (k Keeper) IterateOutgoingTxBatchesByBlock(ctx sdk.Context, fromBlock uint64, cb func(batch types.InternalOutgoingTxBatch) bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.OutgoingTXBatchBlockKey)
+	iter := prefixStore.Iterator(types.UInt64Bytes(fromBlock), nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var batch types.OutgoingTxBatch
+		k.cdc.MustUnmarshal(store.Get(iter.Value()), &batch)
+		intBatch, err := batch.ToInternal()
+		if err != nil || intBatch == nil {
+			panic(sdkerrors.Wrap(err, "found invalid batch in store"))
+		}
+		// cb returns true to stop early
+		if cb(*intBatch) {
+			break
+		}
+	}
 }
 
 // pickUnbatchedTX find TX in pool and remove from "available" second index
@@ -238,6 +299,7 @@ func (k Keeper) CancelOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAd
 	if batch == nil {
 		return types.ErrUnknown
 	}
+	removals := k.GetBatchTxRemovals(ctx, tokenContract, nonce)
 	for _, tx := range batch.Transactions {
 		err := k.addUnbatchedTX(ctx, tx)
 		if err != nil {
@@ -250,6 +312,17 @@ func (k Keeper) CancelOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAd
 	// Delete it's confirmations as well
 	k.DeleteBatchConfirms(ctx, *batch)
 
+	// refund the transactions a cancel batch proposal removed instead of letting them be batched again
+	senders := make(map[uint64]sdk.AccAddress, len(batch.Transactions))
+	for _, tx := range batch.Transactions {
+		senders[tx.Id] = tx.Sender
+	}
+	for _, id := range removals {
+		if err := k.RemoveFromOutgoingPoolAndRefund(ctx, id, senders[id]); err != nil {
+			return sdkerrors.Wrapf(err, "remove tx %d", id)
+		}
+	}
+
 	ctx.EventManager().EmitTypedEvent(
 		&types.EventOutgoingBatchCanceled{
 			BridgeContract: k.GetBridgeContractAddress(ctx).GetAddress().Hex(),
@@ -333,14 +406,20 @@ func (k Keeper) GetLastSlashedBatchBlock(ctx sdk.Context) uint64 {
 	return types.UInt64FromBytes(bytes)
 }
 
-// GetUnSlashedBatches returns all the unslashed batches in state
-func (k Keeper) GetUnSlashedBatches(ctx sdk.Context, maxHeight uint64) (out []types.InternalOutgoingTxBatch) {
+// GetUnSlashedBatches returns the unslashed batches created before maxHeight in block order, at most limit of them
+// unless more batches share the block of the last one. The batches of a block can not be split between two calls
+// since the last slashed batch block is where the next call continues
+func (k Keeper) GetUnSlashedBatches(ctx sdk.Context, maxHeight uint64, limit uint64) (out []types.InternalOutgoingTxBatch) {
 	lastSlashedBatchBlock := k.GetLastSlashedBatchBlock(ctx)
-	batches := k.GetOutgoingTxBatches(ctx)
-	for _, batch := range batches {
-		if batch.Block > lastSlashedBatchBlock && batch.Block < maxHeight {
-			out = append(out, batch)
+	k.IterateOutgoingTxBatchesByBlock(ctx, lastSlashedBatchBlock+1, func(batch types.InternalOutgoingTxBatch) bool {
+		if batch.Block >= maxHeight {
+			return true
 		}
-	}
+		if uint64(len(out)) >= limit && batch.Block != out[len(out)-1].Block {
+			return true
+		}
+		out = append(out, batch)
+		return false
+	})
 	return
 }
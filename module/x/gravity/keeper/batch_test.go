@@ -30,7 +30,7 @@ func TestBatches(t *testing.T) {
 	require.NoError(t, err)
 
 	// mint some voucher first
-	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	require.NoError(t, MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers))
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
@@ -325,7 +325,7 @@ func TestBatchesFullCoins(t *testing.T) {
 	require.NoError(t, err)
 
 	// mint some voucher first
-	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	require.NoError(t, MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers))
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
@@ -549,7 +549,7 @@ func TestManyBatches(t *testing.T) {
 	require.NoError(t, err)
 
 	// mint vouchers first
-	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	require.NoError(t, MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers))
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
@@ -638,7 +638,7 @@ func TestPoolTxRefund(t *testing.T) {
 	require.NoError(t, err)
 
 	// mint some voucher first
-	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	require.NoError(t, MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers))
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
@@ -712,7 +712,7 @@ func TestBatchesNotCreatedWhenBridgePaused(t *testing.T) {
 	require.NoError(t, err)
 
 	// mint some voucher first
-	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	require.NoError(t, MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers))
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
@@ -790,7 +790,7 @@ func TestEthereumBlacklistBatches(t *testing.T) {
 	input.GravityKeeper.SetParams(ctx, params)
 
 	// mint some voucher first
-	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	require.NoError(t, MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers))
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
@@ -905,7 +905,7 @@ func TestBatchConfirms(t *testing.T) {
 	require.NoError(t, err)
 
 	// mint some voucher first
-	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	require.NoError(t, MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers))
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
@@ -999,3 +999,46 @@ func TestLastSlashedBatchBlock(t *testing.T) {
 	assert.NotPanics(t, func() { input.GravityKeeper.SetLastSlashedBatchBlock(ctx, 129) })
 	assert.Equal(t, uint64(129), input.GravityKeeper.GetLastSlashedBatchBlock(ctx))
 }
+
+// Tests that GetUnSlashedBatches returns the batches in block order and stops after the limit, unless more batches
+// share the block of the last one
+func TestGetUnSlashedBatchesLimit(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	tokenA, err := types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+	tokenB, err := types.NewEthAddress("0x17c1736CcF692F653c433d7aa2aB45148C016F68")
+	require.NoError(t, err)
+	// token B sorts before token A in the store, the order must come from the blocks
+	for _, b := range []struct {
+		nonce uint64
+		token *types.EthAddress
+		block uint64
+	}{{1, tokenA, 3}, {2, tokenB, 4}, {3, tokenA, 4}, {4, tokenB, 5}, {5, tokenA, 2}, {6, tokenB, 9}} {
+		batch, err := types.NewInternalOutgingTxBatch(b.nonce, 1000, nil, *b.token, b.block)
+		require.NoError(t, err)
+		k.StoreBatch(ctx, *batch)
+	}
+	k.SetLastSlashedBatchBlock(ctx, 2)
+
+	nonces := func(maxHeight uint64, limit uint64) (out []uint64) {
+		for _, batch := range k.GetUnSlashedBatches(ctx, maxHeight, limit) {
+			out = append(out, batch.BatchNonce)
+		}
+		return out
+	}
+	assert.Equal(t, []uint64{1, 2, 3, 4}, nonces(9, 100))
+	assert.Equal(t, []uint64{1}, nonces(9, 1))
+	// the second batch of block 4 is returned with the first
+	assert.Equal(t, []uint64{1, 2, 3}, nonces(9, 2))
+	assert.Equal(t, []uint64{1, 2, 3, 4, 6}, nonces(10, 100))
+
+	// deleted batches leave the index
+	batch := k.GetOutgoingTXBatch(ctx, *tokenA, 3)
+	require.NotNil(t, batch)
+	k.DeleteBatch(ctx, *batch)
+	assert.Equal(t, []uint64{1, 2, 4}, nonces(9, 100))
+}
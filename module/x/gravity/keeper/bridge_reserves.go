@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// GetBridgeTotals returns the lifetime bridge totals of a token, zero for a token which has not crossed the bridge
+// since they are counted
+func (k Keeper) GetBridgeTotals(ctx sdk.Context, tokenContract types.EthAddress) types.BridgeTotals {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetBridgeTotalsKey(tokenContract))
+	if bz == nil {
+		return types.BridgeTotals{
+			TokenContract:      tokenContract.GetAddress().Hex(),
+			Deposited:          sdk.ZeroInt(),
+			Withdrawn:          sdk.ZeroInt(),
+			CommunityPool:      sdk.ZeroInt(),
+			OpeningOutstanding: sdk.ZeroInt(),
+		}
+	}
+	var totals types.BridgeTotals
+	k.cdc.MustUnmarshal(bz, &totals)
+	return totals
+}
+
+// setBridgeTotals stores the lifetime bridge totals of a token
+func (k Keeper) setBridgeTotals(ctx sdk.Context, totals types.BridgeTotals) {
+	contract, err := types.NewEthAddress(totals.TokenContract)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid bridge totals token contract"))
+	}
+	totals.TokenContract = contract.GetAddress().Hex()
+	ctx.KVStore(k.storeKey).Set(types.GetBridgeTotalsKey(*contract), k.cdc.MustMarshal(&totals))
+}
+
+// updateBridgeTotals applies update to the lifetime bridge totals of a token
+func (k Keeper) updateBridgeTotals(ctx sdk.Context, tokenContract types.EthAddress, update func(totals *types.BridgeTotals)) {
+	totals := k.GetBridgeTotals(ctx, tokenContract)
+	update(&totals)
+	k.setBridgeTotals(ctx, totals)
+}
+
+// addToTotal adds amount to a lifetime total, the totals saturate at the largest Int instead of overflowing since a
+// token can cross the bridge back and forth without bound
+func addToTotal(total sdk.Int, amount sdk.Int) sdk.Int {
+	sum := new(big.Int).Add(total.BigInt(), amount.BigInt())
+	if max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)); sum.Cmp(max) > 0 {
+		return sdk.NewIntFromBigInt(max)
+	}
+	return sdk.NewIntFromBigInt(sum)
+}
+
+// isSaturatedTotal returns true if a lifetime total has saturated at the largest Int and is no longer exact
+func isSaturatedTotal(total sdk.Int) bool {
+	return total.BigInt().Cmp(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))) == 0
+}
+
+// outstandingBalance returns the amount the bridge owes of a reserve's token: the voucher supply of an Ethereum
+// originated token, which can be redeemed for the tokens locked in the Gravity contract, or the amount of a cosmos
+// originated token in circulation on Ethereum, which is locked in the module without being pending
+func outstandingBalance(r types.BridgeReserve) sdk.Int {
+	if !r.CosmosOriginated {
+		return r.VoucherSupply
+	}
+	return r.Locked.Sub(r.PendingPool).Sub(r.PendingBatches).Sub(r.PendingIbcAutoForwards)
+}
+
+// expectedOutstandingBalance returns the amount the bridge owes of a token according to its lifetime totals,
+// deposits redeem the cosmos originated tokens in circulation on Ethereum and withdrawals put them in circulation
+func expectedOutstandingBalance(totals types.BridgeTotals, cosmosOriginated bool) sdk.Int {
+	if cosmosOriginated {
+		return totals.OpeningOutstanding.Add(totals.Withdrawn).Sub(totals.Deposited)
+	}
+	return totals.OpeningOutstanding.Add(totals.Deposited).Sub(totals.Withdrawn)
+}
+
+// recordOpeningOutstandingBalances records the amount the bridge owes of every token as the opening balance of its
+// totals, this is done once when the totals start to be counted so that the bridge liabilities can be checked exactly
+func (k Keeper) recordOpeningOutstandingBalances(ctx sdk.Context) error {
+	for _, r := range k.GetBridgeReserves(ctx) {
+		outstanding := outstandingBalance(r)
+		if outstanding.IsNegative() {
+			return sdkerrors.Wrapf(types.ErrInvalid, "%s has less locked than pending, outstanding %s", r.TokenContract, outstanding)
+		}
+		totals := r.Totals
+		totals.OpeningOutstanding = outstanding
+		k.setBridgeTotals(ctx, totals)
+	}
+	return nil
+}
+
+// IterateBridgeTotals iterates through the lifetime bridge totals of every token in token contract order
+func (k Keeper) IterateBridgeTotals(ctx sdk.Context, cb func(totals types.BridgeTotals) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BridgeTotalsKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var totals types.BridgeTotals
+		k.cdc.MustUnmarshal(iter.Value(), &totals)
+		// cb returns true to stop early
+		if cb(totals) {
+			break
+		}
+	}
+}
+
+// GetBridgeReserves accounts for the amounts held by the bridge of every token which is mapped to a denom, has a
+// voucher supply, has crossed the bridge since the totals are counted or is pending in the pool, a batch or the IBC
+// auto-forward queue. This is intended for queries only as it iterates over the total supply and every pending
+// transfer
+func (k Keeper) GetBridgeReserves(ctx sdk.Context) []types.BridgeReserve {
+	reserves := make(map[string]*types.BridgeReserve)
+	reserve := func(tokenContract types.EthAddress) *types.BridgeReserve {
+		token := tokenContract.GetAddress().Hex()
+		if r, ok := reserves[token]; ok {
+			return r
+		}
+		cosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, tokenContract)
+		reserves[token] = &types.BridgeReserve{
+			TokenContract:          token,
+			Denom:                  denom,
+			CosmosOriginated:       cosmosOriginated,
+			Locked:                 sdk.ZeroInt(),
+			VoucherSupply:          sdk.ZeroInt(),
+			PendingPool:            sdk.ZeroInt(),
+			PendingBatches:         sdk.ZeroInt(),
+			PendingIbcAutoForwards: sdk.ZeroInt(),
+			Totals:                 k.GetBridgeTotals(ctx, tokenContract),
+		}
+		return reserves[token]
+	}
+
+	k.IterateERC20ToDenom(ctx, func(_ []byte, erc20ToDenom *types.ERC20ToDenom) bool {
+		contract, err := types.NewEthAddress(erc20ToDenom.Erc20)
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "invalid erc20 in erc20 to denom index"))
+		}
+		reserve(*contract)
+		return false
+	})
+	k.bankKeeper.IterateTotalSupply(ctx, func(supply sdk.Coin) bool {
+		if contract, err := types.GravityDenomToERC20(supply.Denom); err == nil {
+			if r := reserve(*contract); !r.CosmosOriginated {
+				r.VoucherSupply = supply.Amount
+			}
+		}
+		return false
+	})
+	k.IterateBridgeTotals(ctx, func(totals types.BridgeTotals) bool {
+		contract, err := types.NewEthAddress(totals.TokenContract)
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "invalid bridge totals token contract"))
+		}
+		reserve(*contract)
+		return false
+	})
+
+	k.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+		r := reserve(tx.Erc20Token.Contract)
+		r.PendingPool = r.PendingPool.Add(tx.Erc20Token.Amount).Add(tx.Erc20Fee.Amount)
+		return false
+	})
+	k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+		r := reserve(batch.TokenContract)
+		for _, tx := range batch.Transactions {
+			r.PendingBatches = r.PendingBatches.Add(tx.Erc20Token.Amount).Add(tx.Erc20Fee.Amount)
+		}
+		return false
+	})
+	for _, forward := range k.PendingIbcAutoForwards(ctx, 0) {
+		_, contract, err := k.DenomToERC20Lookup(ctx, forward.Token.Denom)
+		if err != nil {
+			// forwards are only queued for deposits of bridged tokens
+			panic(sdkerrors.Wrapf(err, "pending ibc auto forward %d of a token which is not bridged", forward.EventNonce))
+		}
+		r := reserve(*contract)
+		r.PendingIbcAutoForwards = r.PendingIbcAutoForwards.Add(forward.Token.Amount)
+	}
+
+	modAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	res := make([]types.BridgeReserve, 0, len(reserves))
+	for _, r := range reserves {
+		if r.CosmosOriginated {
+			r.Locked = k.bankKeeper.GetBalance(ctx, modAcc, r.Denom).Amount
+		}
+		res = append(res, *r)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].TokenContract < res[j].TokenContract
+	})
+	return res
+}
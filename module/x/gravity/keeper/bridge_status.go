@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// GetBridgeStatus collects a summary of the bridge health, this is intended for queries only as it
+// iterates over every valset, batch, logic call and unbatched transaction in the store
+func (k Keeper) GetBridgeStatus(ctx sdk.Context) types.BridgeStatus {
+	params := k.GetParams(ctx)
+	bonded := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+
+	// index the current orchestrators by validator, validators without an orchestrator
+	// can not sign anything and will always be listed as missing
+	orchestrators := make(map[string]sdk.AccAddress)
+	for _, key := range k.GetDelegateKeys(ctx) {
+		orch, err := sdk.AccAddressFromBech32(key.Orchestrator)
+		if err != nil {
+			panic("Invalid orchestrator addr in store!")
+		}
+		orchestrators[key.Validator] = orch
+	}
+	// the confirms made before a delegate key rotation are stored under the previous orchestrator
+	signers := make(map[string][]sdk.AccAddress)
+	for val, orch := range orchestrators {
+		signers[val] = []sdk.AccAddress{orch}
+	}
+	for _, rotation := range k.GetDelegateKeyRotations(ctx) {
+		orch, err := sdk.AccAddressFromBech32(rotation.PreviousOrchestrator)
+		if err != nil {
+			panic("Invalid orchestrator addr in store!")
+		}
+		signers[rotation.Validator] = append(signers[rotation.Validator], orch)
+	}
+
+	// valsets are sorted newest first
+	valsets := k.GetValsets(ctx)
+	lastObservedNonce := k.GetLastObservedEventNonce(ctx)
+
+	return types.BridgeStatus{
+		LastObservedEventNonce:     lastObservedNonce,
+		LastObservedEthereumHeight: k.GetLastObservedEthereumBlockHeight(ctx),
+		ValidatorEventNonceLags:    k.getValidatorEventNonceLags(ctx, bonded, orchestrators, lastObservedNonce),
+		OldestUnsignedValset:       k.getOldestUnsignedValset(ctx, valsets, signers),
+		OldestUnsignedBatch:        k.getOldestUnsignedBatch(ctx, valsets, signers),
+		OldestUnsignedLogicCall:    k.getOldestUnsignedLogicCall(ctx, valsets, signers),
+		Pools:                      k.getPoolStatuses(ctx),
+		PendingIbcAutoForwards:     uint64(len(k.PendingIbcAutoForwards(ctx, 0))),
+		BridgeActive:               params.BridgeActive,
+		BatchTimeoutHeight:         k.getBatchTimeoutHeight(ctx),
+	}
+}
+
+func (k Keeper) getValidatorEventNonceLags(
+	ctx sdk.Context,
+	bonded []stakingtypes.Validator,
+	orchestrators map[string]sdk.AccAddress,
+	lastObservedNonce uint64,
+) []types.ValidatorEventNonceLag {
+	lags := []types.ValidatorEventNonceLag{}
+	for _, val := range bonded {
+		lastNonce, lag := k.getEventNonceLag(ctx, val.GetOperator(), lastObservedNonce)
+		var orchestrator string
+		if orch, ok := orchestrators[val.GetOperator().String()]; ok {
+			orchestrator = orch.String()
+		}
+		lags = append(lags, types.ValidatorEventNonceLag{
+			ValidatorAddress:    val.GetOperator().String(),
+			OrchestratorAddress: orchestrator,
+			LastEventNonce:      lastNonce,
+			Lag:                 lag,
+		})
+	}
+	return lags
+}
+
+// getEventNonceLag returns the last event nonce submitted by the validator and how far it is behind the
+// last observed event nonce
+func (k Keeper) getEventNonceLag(ctx sdk.Context, val sdk.ValAddress, lastObservedNonce uint64) (lastNonce uint64, lag uint64) {
+	lastNonce = k.GetLastEventNonceByValidator(ctx, val)
+	if lastNonce < lastObservedNonce {
+		lag = lastObservedNonce - lastNonce
+	}
+	return lastNonce, lag
+}
+
+// missingSigners returns the operator addresses of the valset members none of whose orchestrators hasSigned returns
+// true for, members whose eth address was never registered by a validator are listed by their eth address
+func (k Keeper) missingSigners(
+	ctx sdk.Context,
+	members types.BridgeValidators,
+	signers map[string][]sdk.AccAddress,
+	hasSigned func(orch sdk.AccAddress) bool,
+) []string {
+	var missing []string
+	for _, member := range members {
+		ethAddr, err := types.NewEthAddress(member.EthereumAddress)
+		if err != nil {
+			missing = append(missing, member.EthereumAddress)
+			continue
+		}
+		validity, found := k.GetEthAddressValidity(ctx, *ethAddr)
+		if !found {
+			missing = append(missing, member.EthereumAddress)
+			continue
+		}
+		signed := false
+		for _, orch := range signers[validity.Validator] {
+			if hasSigned(orch) {
+				signed = true
+				break
+			}
+		}
+		if !signed {
+			missing = append(missing, validity.Validator)
+		}
+	}
+	return missing
+}
+
+// valsetAtHeight returns the members of the newest valset created at or before height, the oldest stored valset is
+// used if the one in force at height has been pruned. valsets must be sorted newest first
+func valsetAtHeight(valsets []types.Valset, height uint64) types.BridgeValidators {
+	for _, vs := range valsets {
+		if vs.Height <= height {
+			return vs.Members
+		}
+	}
+	if len(valsets) == 0 {
+		return nil
+	}
+	return valsets[len(valsets)-1].Members
+}
+
+func (k Keeper) getOldestUnsignedValset(
+	ctx sdk.Context,
+	valsets []types.Valset,
+	signers map[string][]sdk.AccAddress,
+) *types.UnsignedValset {
+	for i := len(valsets) - 1; i >= 0; i-- {
+		vs := valsets[i]
+		missing := k.missingSigners(ctx, vs.Members, signers, func(orch sdk.AccAddress) bool {
+			return k.GetValsetConfirm(ctx, vs.Nonce, orch) != nil
+		})
+		if len(missing) > 0 {
+			return &types.UnsignedValset{Nonce: vs.Nonce, Height: vs.Height, MissingValidators: missing}
+		}
+	}
+	return nil
+}
+
+func (k Keeper) getOldestUnsignedBatch(
+	ctx sdk.Context,
+	valsets []types.Valset,
+	signers map[string][]sdk.AccAddress,
+) *types.UnsignedBatch {
+	batches := k.GetOutgoingTxBatches(ctx)
+	sort.Slice(batches, func(i, j int) bool {
+		if batches[i].Block != batches[j].Block {
+			return batches[i].Block < batches[j].Block
+		}
+		return batches[i].BatchNonce < batches[j].BatchNonce
+	})
+	for _, batch := range batches {
+		missing := k.missingSigners(ctx, valsetAtHeight(valsets, batch.Block), signers, func(orch sdk.AccAddress) bool {
+			return k.GetBatchConfirm(ctx, batch.BatchNonce, batch.TokenContract, orch) != nil
+		})
+		if len(missing) > 0 {
+			return &types.UnsignedBatch{
+				TokenContract:     batch.TokenContract.GetAddress().Hex(),
+				BatchNonce:        batch.BatchNonce,
+				Block:             batch.Block,
+				MissingValidators: missing,
+			}
+		}
+	}
+	return nil
+}
+
+func (k Keeper) getOldestUnsignedLogicCall(
+	ctx sdk.Context,
+	valsets []types.Valset,
+	signers map[string][]sdk.AccAddress,
+) *types.UnsignedLogicCall {
+	calls := k.GetOutgoingLogicCalls(ctx)
+	sort.SliceStable(calls, func(i, j int) bool {
+		return calls[i].Block < calls[j].Block
+	})
+	for _, call := range calls {
+		missing := k.missingSigners(ctx, valsetAtHeight(valsets, call.Block), signers, func(orch sdk.AccAddress) bool {
+			return k.GetLogicCallConfirm(ctx, call.InvalidationId, call.InvalidationNonce, orch) != nil
+		})
+		if len(missing) > 0 {
+			return &types.UnsignedLogicCall{
+				InvalidationId:    call.InvalidationId,
+				InvalidationNonce: call.InvalidationNonce,
+				Block:             call.Block,
+				MissingValidators: missing,
+			}
+		}
+	}
+	return nil
+}
+
+// getPoolStatuses summarizes the unbatched transactions for each token, ordered by token contract
+func (k Keeper) getPoolStatuses(ctx sdk.Context) []types.PoolStatus {
+	pools := []types.PoolStatus{}
+	index := make(map[string]int)
+	k.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+		token := tx.Erc20Token.Contract.GetAddress().Hex()
+		i, ok := index[token]
+		if !ok {
+			i = len(pools)
+			index[token] = i
+			pools = append(pools, types.PoolStatus{
+				TokenContract: token,
+				TotalAmount:   sdk.ZeroInt(),
+				TotalFees:     sdk.ZeroInt(),
+				OldestTxId:    tx.Id,
+			})
+		}
+		pool := &pools[i]
+		pool.TxCount++
+		pool.TotalAmount = pool.TotalAmount.Add(tx.Erc20Token.Amount)
+		pool.TotalFees = pool.TotalFees.Add(tx.Erc20Fee.Amount)
+		if tx.Id < pool.OldestTxId {
+			pool.OldestTxId = tx.Id
+		}
+		return false
+	})
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].TokenContract < pools[j].TokenContract
+	})
+	return pools
+}
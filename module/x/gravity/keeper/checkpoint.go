@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Computing a checkpoint abi encodes and hashes the whole object, which is costly for large valsets and batches.
+// Every confirm of an object needs its checkpoint, and the confirms of a single object tend to arrive in the same
+// few blocks, so checkpoints are cached by object nonce in the transient store. The transient store is discarded
+// at the end of every block and its writes are reverted together with a failing tx, so the cache can never
+// outlive or disagree with the object it was computed for. Only objects read from the store may use the cache,
+// a checkpoint over an object supplied in a message must always be computed from that object
+
+// GetValsetCheckpoint returns the checkpoint of a stored valset, computing it only once per block
+func (k Keeper) GetValsetCheckpoint(ctx sdk.Context, valset types.Valset) []byte {
+	gravityID := k.GetGravityID(ctx)
+	return k.getCachedCheckpoint(ctx, types.GetValsetCheckpointCacheKey(gravityID, valset.Nonce), func() []byte {
+		return valset.GetCheckpoint(gravityID)
+	})
+}
+
+// GetBatchCheckpoint returns the checkpoint of a stored batch, computing it only once per block
+func (k Keeper) GetBatchCheckpoint(ctx sdk.Context, batch types.InternalOutgoingTxBatch) []byte {
+	gravityID := k.GetGravityID(ctx)
+	key := types.GetBatchCheckpointCacheKey(gravityID, batch.TokenContract, batch.BatchNonce)
+	return k.getCachedCheckpoint(ctx, key, func() []byte {
+		return batch.GetCheckpoint(gravityID)
+	})
+}
+
+// GetLogicCallCheckpoint returns the checkpoint of a stored logic call, computing it only once per block
+func (k Keeper) GetLogicCallCheckpoint(ctx sdk.Context, call types.OutgoingLogicCall) []byte {
+	gravityID := k.GetGravityID(ctx)
+	key := types.GetLogicCallCheckpointCacheKey(gravityID, call.InvalidationId, call.InvalidationNonce)
+	return k.getCachedCheckpoint(ctx, key, func() []byte {
+		return call.GetCheckpoint(gravityID)
+	})
+}
+
+// getCachedCheckpoint returns the checkpoint cached under key, or computes, caches and returns it.
+// The cache is accessed without charging gas, otherwise the gas used by a tx would depend on whether an earlier tx in
+// the same block happened to compute the checkpoint, and the gas estimated by a simulation would not hold
+func (k Keeper) getCachedCheckpoint(ctx sdk.Context, key []byte, compute func() []byte) []byte {
+	store := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).TransientStore(k.tStoreKey)
+	if checkpoint := store.Get(key); checkpoint != nil {
+		return checkpoint
+	}
+	checkpoint := compute()
+	store.Set(key, checkpoint)
+	return checkpoint
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Tests that cached checkpoints match freshly computed ones and follow a change of the gravity id
+func TestCheckpointCache(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	ctx := input.Context
+	k := input.GravityKeeper
+	gravityID := k.GetGravityID(ctx)
+
+	valset := checkpointTestValset(150)
+	batch := checkpointTestBatch(t, 100)
+	call := types.OutgoingLogicCall{
+		Transfers:            []types.ERC20Token{{Contract: batch.TokenContract.GetAddress().Hex(), Amount: sdk.NewInt(1)}},
+		Fees:                 []types.ERC20Token{{Contract: batch.TokenContract.GetAddress().Hex(), Amount: sdk.NewInt(1)}},
+		LogicContractAddress: "0x17c1736CcF692F653c433d7aa2aB45148C016F68",
+		Payload:              []byte("payload"),
+		Timeout:              10000,
+		InvalidationId:       []byte("invalidation id"),
+		InvalidationNonce:    1,
+		Block:                1,
+	}
+
+	tstore := ctx.TransientStore(k.tStoreKey)
+	valsetKey := types.GetValsetCheckpointCacheKey(gravityID, valset.Nonce)
+	require.False(t, tstore.Has(valsetKey))
+	assert.Equal(t, valset.GetCheckpoint(gravityID), k.GetValsetCheckpoint(ctx, valset))
+	assert.True(t, tstore.Has(valsetKey))
+	assert.Equal(t, valset.GetCheckpoint(gravityID), k.GetValsetCheckpoint(ctx, valset))
+	assert.Equal(t, batch.GetCheckpoint(gravityID), k.GetBatchCheckpoint(ctx, batch))
+	assert.Equal(t, batch.GetCheckpoint(gravityID), k.GetBatchCheckpoint(ctx, batch))
+	assert.Equal(t, call.GetCheckpoint(gravityID), k.GetLogicCallCheckpoint(ctx, call))
+	assert.Equal(t, call.GetCheckpoint(gravityID), k.GetLogicCallCheckpoint(ctx, call))
+
+	// a new gravity id must never be answered with a checkpoint cached for the old one
+	k.SetGravityID(ctx, "new-gravity-id")
+	assert.Equal(t, valset.GetCheckpoint("new-gravity-id"), k.GetValsetCheckpoint(ctx, valset))
+	assert.Equal(t, batch.GetCheckpoint("new-gravity-id"), k.GetBatchCheckpoint(ctx, batch))
+	assert.Equal(t, call.GetCheckpoint("new-gravity-id"), k.GetLogicCallCheckpoint(ctx, call))
+	assert.NotEqual(t, valset.GetCheckpoint(gravityID), k.GetValsetCheckpoint(ctx, valset))
+}
+
+// Tests that reading a checkpoint costs the same gas whether or not it is already cached
+func TestCheckpointCacheGas(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	valset := checkpointTestValset(150)
+
+	gasUsed := func() sdk.Gas {
+		ctx := input.Context.WithGasMeter(sdk.NewGasMeter(1000000))
+		k.GetValsetCheckpoint(ctx, valset)
+		return ctx.GasMeter().GasConsumed()
+	}
+	tstore := input.Context.TransientStore(k.tStoreKey)
+	valsetKey := types.GetValsetCheckpointCacheKey(k.GetGravityID(input.Context), valset.Nonce)
+	require.False(t, tstore.Has(valsetKey))
+	miss := gasUsed()
+	require.True(t, tstore.Has(valsetKey))
+	hit := gasUsed()
+	assert.Equal(t, miss, hit)
+}
+
+// checkpointTestValset builds a valset of n members, about the size of the active set on mainnet
+func checkpointTestValset(n int) types.Valset {
+	members := make([]types.BridgeValidator, n)
+	for i := range members {
+		members[i] = types.BridgeValidator{
+			Power:           uint64(math.MaxUint32 / n),
+			EthereumAddress: gethcommon.BigToAddress(big.NewInt(int64(i + 1))).Hex(),
+		}
+	}
+	return types.Valset{
+		Nonce:        1,
+		Members:      members,
+		Height:       1,
+		RewardAmount: sdk.ZeroInt(),
+		RewardToken:  "0x0000000000000000000000000000000000000000",
+	}
+}
+
+// checkpointTestBatch builds a batch of n transfers to distinct destinations
+func checkpointTestBatch(t testing.TB, n int) types.InternalOutgoingTxBatch {
+	batch := types.OutgoingTxBatch{
+		BatchNonce:    1,
+		BatchTimeout:  2111,
+		Transactions:  make([]types.OutgoingTransferTx, n),
+		TokenContract: "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4",
+		Block:         1,
+	}
+	for i := range batch.Transactions {
+		batch.Transactions[i] = types.OutgoingTransferTx{
+			Id:          uint64(i + 1),
+			Sender:      AccAddrs[0].String(),
+			DestAddress: gethcommon.BigToAddress(big.NewInt(int64(i + 1))).Hex(),
+			Erc20Token:  types.ERC20Token{Contract: batch.TokenContract, Amount: sdk.NewInt(int64(1000 + i))},
+			Erc20Fee:    types.ERC20Token{Contract: batch.TokenContract, Amount: sdk.NewInt(int64(i + 1))},
+		}
+	}
+	internal, err := batch.ToInternal()
+	require.NoError(t, err)
+	return *internal
+}
+
+func BenchmarkGetValsetCheckpointCached150Validators(b *testing.B) {
+	input := CreateTestEnv(b)
+	valset := checkpointTestValset(150)
+	input.GravityKeeper.GetValsetCheckpoint(input.Context, valset)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		input.GravityKeeper.GetValsetCheckpoint(input.Context, valset)
+	}
+}
+
+func BenchmarkGetBatchCheckpointCached100Txs(b *testing.B) {
+	input := CreateTestEnv(b)
+	batch := checkpointTestBatch(b, 100)
+	input.GravityKeeper.GetBatchCheckpoint(input.Context, batch)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		input.GravityKeeper.GetBatchCheckpoint(input.Context, batch)
+	}
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// IterateOutgoingTXBatchesWithCursor calls cb on at most limit batches in ascending key order, continuing after the
+// batch the previous call with the same cursorKey stopped at. Once every batch has been visited the cursor starts
+// over from the first batch. cb may modify the store, including deleting the batch it is called on
+func (k Keeper) IterateOutgoingTXBatchesWithCursor(ctx sdk.Context, cursorKey []byte, limit uint64, cb func(batch types.InternalOutgoingTxBatch)) {
+	k.iterateWithCursor(ctx, types.OutgoingTXBatchKey, cursorKey, limit, func(value []byte) {
+		var batch types.OutgoingTxBatch
+		k.cdc.MustUnmarshal(value, &batch)
+		intBatch, err := batch.ToInternal()
+		if err != nil || intBatch == nil {
+			panic(sdkerrors.Wrap(err, "found invalid batch in store"))
+		}
+		cb(*intBatch)
+	})
+}
+
+// IterateOutgoingLogicCallsWithCursor calls cb on at most limit logic calls in ascending key order, continuing after
+// the logic call the previous call with the same cursorKey stopped at. Once every logic call has been visited the
+// cursor starts over from the first logic call. cb may modify the store, including deleting the logic call it is
+// called on
+func (k Keeper) IterateOutgoingLogicCallsWithCursor(ctx sdk.Context, cursorKey []byte, limit uint64, cb func(call types.OutgoingLogicCall)) {
+	k.iterateWithCursor(ctx, types.KeyOutgoingLogicCall, cursorKey, limit, func(value []byte) {
+		var call types.OutgoingLogicCall
+		k.cdc.MustUnmarshal(value, &call)
+		cb(call)
+	})
+}
+
+// getCursor returns the key stored under cursorKey, nil if the next iteration starts over from the first key
+func (k Keeper) getCursor(ctx sdk.Context, cursorKey []byte) []byte {
+	return ctx.KVStore(k.storeKey).Get(cursorKey)
+}
+
+// setCursor stores the key the next iteration with cursorKey continues after, an empty key deletes the cursor
+func (k Keeper) setCursor(ctx sdk.Context, cursorKey []byte, key []byte) {
+	if len(key) == 0 {
+		ctx.KVStore(k.storeKey).Delete(cursorKey)
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(cursorKey, key)
+}
+
+// iterateWithCursor reads at most limit values under storePrefix starting after the key stored under cursorKey and
+// stores the last key read as the new cursor, or deletes the cursor once the end of the prefix is reached. The
+// values are read before cb is called on them, so cb is free to write to the store
+func (k Keeper) iterateWithCursor(ctx sdk.Context, storePrefix []byte, cursorKey []byte, limit uint64, cb func(value []byte)) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, storePrefix)
+
+	var start []byte
+	if cursor := store.Get(cursorKey); cursor != nil {
+		// the smallest key after the cursor, copied so that the stored value is never appended to
+		start = append(append([]byte{}, cursor...), 0x00)
+	}
+	iter := prefixStore.Iterator(start, nil)
+	var (
+		lastKey []byte
+		values  [][]byte
+	)
+	for ; iter.Valid() && uint64(len(values)) < limit; iter.Next() {
+		lastKey = append([]byte{}, iter.Key()...)
+		values = append(values, iter.Value())
+	}
+	finished := !iter.Valid()
+	iter.Close()
+
+	if finished {
+		store.Delete(cursorKey)
+	} else {
+		store.Set(cursorKey, lastKey)
+	}
+	for _, value := range values {
+		cb(value)
+	}
+}
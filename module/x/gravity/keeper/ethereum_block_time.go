@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+const (
+	// ethereumBlockTimeSmoothing is the inverse of the weight of a new sample in the Ethereum block time estimate,
+	// every sample moves the estimate a tenth of the way towards it
+	ethereumBlockTimeSmoothing = 10
+	// ethereumBlockTimeBound is the factor by which the Ethereum block time estimate may deviate from the
+	// AverageEthereumBlockTime param, bounding the damage done by an oracle which is stalled or lagging
+	ethereumBlockTimeBound = 2
+)
+
+// GetEthereumBlockTimeEstimate returns the moving average of the Ethereum block time, zero before the first
+// Ethereum block height is observed
+func (k Keeper) GetEthereumBlockTimeEstimate(ctx sdk.Context) types.EthereumBlockTimeEstimate {
+	var estimate types.EthereumBlockTimeEstimate
+	bz := ctx.KVStore(k.storeKey).Get(types.EthereumBlockTimeEstimateKey)
+	if bz == nil {
+		return estimate
+	}
+	k.cdc.MustUnmarshal(bz, &estimate)
+	return estimate
+}
+
+// setEthereumBlockTimeEstimate stores the moving average of the Ethereum block time
+func (k Keeper) setEthereumBlockTimeEstimate(ctx sdk.Context, estimate types.EthereumBlockTimeEstimate) {
+	ctx.KVStore(k.storeKey).Set(types.EthereumBlockTimeEstimateKey, k.cdc.MustMarshal(&estimate))
+}
+
+// sampleEthereumBlockTime updates the Ethereum block time estimate with the time per Ethereum block elapsed since
+// the last sample. Several heights observed in the same Cosmos block can not be told apart in time, only the first
+// of them is kept as the start of the next sample.
+func (k Keeper) sampleEthereumBlockTime(ctx sdk.Context, ethereumHeight uint64) {
+	blockTime := ctx.BlockTime()
+	now := blockTime.Unix()*1000 + int64(blockTime.Nanosecond())/int64(time.Millisecond)
+	if now <= 0 {
+		// block times before the epoch only occur in tests which do not set one
+		return
+	}
+	estimate := k.GetEthereumBlockTimeEstimate(ctx)
+	if estimate.CosmosBlockTime != 0 {
+		if ethereumHeight <= estimate.EthereumBlockHeight || uint64(now) <= estimate.CosmosBlockTime {
+			return
+		}
+		sample := k.boundEthereumBlockTime(ctx,
+			(uint64(now)-estimate.CosmosBlockTime)/(ethereumHeight-estimate.EthereumBlockHeight))
+		switch {
+		case estimate.Samples == 0:
+			estimate.AverageEthereumBlockTime = sample
+		case sample >= estimate.AverageEthereumBlockTime:
+			estimate.AverageEthereumBlockTime += (sample - estimate.AverageEthereumBlockTime) / ethereumBlockTimeSmoothing
+		default:
+			estimate.AverageEthereumBlockTime -= (estimate.AverageEthereumBlockTime - sample) / ethereumBlockTimeSmoothing
+		}
+		estimate.Samples++
+	}
+	estimate.EthereumBlockHeight = ethereumHeight
+	estimate.CosmosBlockTime = uint64(now)
+	k.setEthereumBlockTimeEstimate(ctx, estimate)
+}
+
+// boundEthereumBlockTime limits blockTime to within a factor of ethereumBlockTimeBound of the
+// AverageEthereumBlockTime param
+func (k Keeper) boundEthereumBlockTime(ctx sdk.Context, blockTime uint64) uint64 {
+	param := k.GetParams(ctx).AverageEthereumBlockTime
+	upper := uint64(math.MaxUint64)
+	if param <= math.MaxUint64/ethereumBlockTimeBound {
+		upper = param * ethereumBlockTimeBound
+	}
+	switch {
+	case blockTime < param/ethereumBlockTimeBound:
+		return param / ethereumBlockTimeBound
+	case blockTime > upper:
+		return upper
+	default:
+		return blockTime
+	}
+}
+
+// GetEthereumBlockTime returns the Ethereum block time in milliseconds used to project Ethereum heights, the
+// bounded estimate once a block time has been sampled and the AverageEthereumBlockTime param before that
+func (k Keeper) GetEthereumBlockTime(ctx sdk.Context) uint64 {
+	estimate := k.GetEthereumBlockTimeEstimate(ctx)
+	if estimate.Samples == 0 {
+		return k.GetParams(ctx).AverageEthereumBlockTime
+	}
+	// the param may have changed since the last sample
+	return k.boundEthereumBlockTime(ctx, estimate.AverageEthereumBlockTime)
+}
+
+// GetEthereumTimeoutHeight projects the Ethereum height timeout milliseconds from now, it is used for the timeout of
+// batches and should be used by modules creating logic calls. Zero is returned if no Ethereum height has been observed
+func (k Keeper) GetEthereumTimeoutHeight(ctx sdk.Context, timeout uint64) uint64 {
+	params := k.GetParams(ctx)
+	currentCosmosHeight := ctx.BlockHeight()
+	// we store the last observed Cosmos and Ethereum heights, we do not concern ourselves if these values are zero because
+	// no batch can be produced if the last Ethereum block height is not first populated by a deposit event.
+	heights := k.GetLastObservedEthereumBlockHeight(ctx)
+	if heights.CosmosBlockHeight == 0 || heights.EthereumBlockHeight == 0 {
+		return 0
+	}
+	ethereumBlockTime := k.GetEthereumBlockTime(ctx)
+	// we project how long it has been in milliseconds since the last Ethereum block height was observed
+	projectedMillis := (uint64(currentCosmosHeight) - heights.CosmosBlockHeight) * params.AverageBlockTime
+	// we convert that projection into the current Ethereum height using the estimated Ethereum block time in millis
+	projectedCurrentEthereumHeight := (projectedMillis / ethereumBlockTime) + heights.EthereumBlockHeight
+	// we convert the timeout (lets say 12 hours) into a number of blocks to place on top of our projection of the
+	// current Ethereum block height.
+	blocksToAdd := timeout / ethereumBlockTime
+	return projectedCurrentEthereumHeight + blocksToAdd
+}
@@ -5,8 +5,10 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
@@ -46,6 +48,11 @@ func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types
 		return sdkerrors.Wrap(types.ErrInvalid, "Checkpoint exists, cannot slash")
 	}
 
+	// The archive only covers the checkpoint retention window, older subjects can not be judged
+	if k.isPrunedCheckpointSubject(ctx, subject) {
+		return sdkerrors.Wrap(types.ErrInvalid, "Checkpoint of this nonce may have been pruned from the archive, cannot slash")
+	}
+
 	// Decode Eth signature to bytes
 
 	// strip 0x prefix if needed
@@ -63,40 +70,225 @@ func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types
 		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature to eth address failed with checkpoint %s and signature %s", hex.EncodeToString(checkpoint), signature))
 	}
 
-	// Find the offending validator by eth address
-	val, found := k.GetValidatorByEthAddress(ctx, *ethAddress)
+	// Find the offending validator by eth address, including addresses it has since replaced
+	validity, found := k.GetEthAddressValidity(ctx, *ethAddress)
 	if !found {
 		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Did not find validator for eth address %s from signature %s with checkpoint %s and GravityID %s", ethAddress.GetAddress().Hex(), signature, hex.EncodeToString(checkpoint), gravityID))
 	}
 
+	// The signature can not tell when it was made, so evidence expires relative to the end of the key's validity
+	params := k.GetParams(ctx)
+	currentHeight := uint64(ctx.BlockHeight())
+	if params.MaxBadSignatureEvidenceAge != 0 && validity.EndHeight != 0 &&
+		currentHeight > validity.EndHeight && currentHeight-validity.EndHeight > params.MaxBadSignatureEvidenceAge {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Eth address %s stopped being the key of validator %s at height %d, evidence older than %d blocks is rejected", ethAddress.GetAddress().Hex(), validity.Validator, validity.EndHeight, params.MaxBadSignatureEvidenceAge))
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validity.Validator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid validator in eth address validity")
+	}
+	val, found := k.StakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Validator %s of eth address %s no longer exists", validity.Validator, ethAddress.GetAddress().Hex()))
+	}
+
 	// Slash the offending validator
 	cons, err := val.GetConsAddr()
 	if err != nil {
 		return sdkerrors.Wrap(err, "Could not get consensus key address for validator")
 	}
 
-	params := k.GetParams(ctx)
 	if !val.IsJailed() {
 		k.StakingKeeper.Jail(ctx, cons)
 		k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionBadEthSignature)
+		IncrSlashCounter(ctx, SlashReasonBadEthSignature)
 	}
 
 	return nil
 }
 
-// SetPastEthSignatureCheckpoint puts the checkpoint of a valset, batch, or logic call into a set
-// in order to prove later that it existed at one point.
-func (k Keeper) SetPastEthSignatureCheckpoint(ctx sdk.Context, checkpoint []byte) {
+// SetPastEthSignatureCheckpoint puts the checkpoint of a valset, batch, or logic call into the archive
+// in order to prove later that it existed at one point. Records of a known type are indexed by their height
+// so that they can be pruned once they fall out of the checkpoint retention window.
+func (k Keeper) SetPastEthSignatureCheckpoint(ctx sdk.Context, record types.PastEthSignatureCheckpoint) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetPastEthSignatureCheckpointKey(checkpoint), []byte{0x1})
+	// a checkpoint stored again, for example by the genesis import, must not stay indexed at its old height
+	if old, found := k.GetPastEthSignatureCheckpointRecord(ctx, record.Checkpoint); found && old.Type != types.CHECKPOINT_TYPE_UNSPECIFIED {
+		store.Delete(types.GetPastEthSignatureCheckpointHeightKey(old.Height, old.Checkpoint))
+	}
+	// checkpoints of an unspecified type keep the format used before the height and type were recorded
+	if record.Type == types.CHECKPOINT_TYPE_UNSPECIFIED {
+		store.Set(types.GetPastEthSignatureCheckpointKey(record.Checkpoint), []byte{0x1})
+		return
+	}
+	store.Set(types.GetPastEthSignatureCheckpointKey(record.Checkpoint), k.cdc.MustMarshal(&record))
+	store.Set(types.GetPastEthSignatureCheckpointHeightKey(record.Height, record.Checkpoint), []byte{0x1})
 }
 
-// GetPastEthSignatureCheckpoint tells you whether a given checkpoint has ever existed
+// GetPastEthSignatureCheckpoint tells you whether a given checkpoint is in the archive
 func (k Keeper) GetPastEthSignatureCheckpoint(ctx sdk.Context, checkpoint []byte) (found bool) {
 	store := ctx.KVStore(k.storeKey)
-	if bytes.Equal(store.Get(types.GetPastEthSignatureCheckpointKey(checkpoint)), []byte{0x1}) {
-		return true
-	} else {
+	return store.Has(types.GetPastEthSignatureCheckpointKey(checkpoint))
+}
+
+// GetPastEthSignatureCheckpointRecord returns the archived record of the given checkpoint
+func (k Keeper) GetPastEthSignatureCheckpointRecord(ctx sdk.Context, checkpoint []byte) (record types.PastEthSignatureCheckpoint, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPastEthSignatureCheckpointKey(checkpoint))
+	if bz == nil {
+		return record, false
+	}
+	return k.unmarshalPastEthSignatureCheckpoint(checkpoint, bz), true
+}
+
+// unmarshalPastEthSignatureCheckpoint decodes an archive value, checkpoints archived before their height and type
+// were recorded are stored as a single 0x1 byte
+func (k Keeper) unmarshalPastEthSignatureCheckpoint(checkpoint []byte, bz []byte) types.PastEthSignatureCheckpoint {
+	if bytes.Equal(bz, []byte{0x1}) {
+		return types.PastEthSignatureCheckpoint{
+			Checkpoint:     checkpoint,
+			Height:         0,
+			Type:           types.CHECKPOINT_TYPE_UNSPECIFIED,
+			Nonce:          0,
+			InvalidationId: nil,
+			TokenContract:  "",
+		}
+	}
+	var record types.PastEthSignatureCheckpoint
+	k.cdc.MustUnmarshal(bz, &record)
+	return record
+}
+
+// IteratePastEthSignatureCheckpoints iterates through every checkpoint stored by SetPastEthSignatureCheckpoint
+func (k Keeper) IteratePastEthSignatureCheckpoints(ctx sdk.Context, cb func(record types.PastEthSignatureCheckpoint) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PastEthSignatureCheckpointKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		checkpoint := types.GetCheckpointFromPastEthSignatureCheckpointKey(iter.Key())
+		// cb returns true to stop early
+		if cb(k.unmarshalPastEthSignatureCheckpoint(checkpoint, iter.Value())) {
+			break
+		}
+	}
+}
+
+// PrunePastEthSignatureCheckpoints removes every checkpoint created more than CheckpointRetentionWindow blocks ago
+// from the archive and raises the pruned nonce of its type, checkpoints of an unspecified type are never pruned.
+// A window of zero keeps the full archive. At most MaxEndBlockerItems checkpoints are pruned per call.
+func (k Keeper) PrunePastEthSignatureCheckpoints(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	window := params.CheckpointRetentionWindow
+	if window == 0 {
+		return
+	}
+	// param change proposals validate every param on its own, so the window may have become shorter than the
+	// windows it depends on since it was set
+	if min := params.MinCheckpointRetentionWindow(); window < min {
+		window = min
+	}
+	currentHeight := uint64(ctx.BlockHeight())
+	if currentHeight <= window {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.PastEthSignatureCheckpointHeightKey)
+	iter := prefixStore.Iterator(nil, types.UInt64Bytes(currentHeight-window))
+	// collect the checkpoints first, the store must not be written to while it is iterated
+	var checkpoints [][]byte
+	for ; iter.Valid() && uint64(len(checkpoints)) < params.MaxEndBlockerItems; iter.Next() {
+		checkpoints = append(checkpoints, iter.Key()[8:])
+	}
+	iter.Close()
+
+	for _, checkpoint := range checkpoints {
+		record, found := k.GetPastEthSignatureCheckpointRecord(ctx, checkpoint)
+		if !found {
+			panic(fmt.Sprintf("indexed checkpoint %X is missing from the archive", checkpoint))
+		}
+		store.Delete(types.GetPastEthSignatureCheckpointHeightKey(record.Height, checkpoint))
+		store.Delete(types.GetPastEthSignatureCheckpointKey(checkpoint))
+		scope := types.GetCheckpointNonceScope(record.Type, record.TokenContract, record.InvalidationId)
+		if record.Nonce > k.GetPrunedCheckpointNonce(ctx, record.Type, scope) {
+			k.setPrunedCheckpointNonce(ctx, types.PrunedCheckpointNonce{
+				Type:           record.Type,
+				InvalidationId: record.InvalidationId,
+				Nonce:          record.Nonce,
+				TokenContract:  record.TokenContract,
+			})
+		}
+	}
+}
+
+// GetPrunedCheckpointNonce returns the highest nonce of the given checkpoint type pruned from the archive within the
+// given scope, which is the token contract for batches and the invalidation id for logic calls, see
+// types.GetCheckpointNonceScope. Zero if none has been pruned yet.
+func (k Keeper) GetPrunedCheckpointNonce(ctx sdk.Context, checkpointType types.CheckpointType, scope []byte) uint64 {
+	if checkpointType == types.CHECKPOINT_TYPE_VALSET {
+		scope = nil
+	}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPrunedCheckpointNonceKey(checkpointType, scope))
+	if bz == nil {
+		return 0
+	}
+	return types.UInt64FromBytes(bz)
+}
+
+// setPrunedCheckpointNonce records the highest nonce pruned from the archive for a checkpoint type, per token for
+// batches and per invalidation id for logic calls
+func (k Keeper) setPrunedCheckpointNonce(ctx sdk.Context, pruned types.PrunedCheckpointNonce) {
+	scope := types.GetCheckpointNonceScope(pruned.Type, pruned.TokenContract, pruned.InvalidationId)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPrunedCheckpointNonceKey(pruned.Type, scope), types.UInt64Bytes(pruned.Nonce))
+}
+
+// IteratePrunedCheckpointNonces iterates through the highest pruned nonce of every checkpoint type and scope
+func (k Keeper) IteratePrunedCheckpointNonces(ctx sdk.Context, cb func(pruned types.PrunedCheckpointNonce) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrunedCheckpointNonceKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		pruned := types.PrunedCheckpointNonce{
+			Type:           types.CheckpointType(iter.Key()[0]),
+			InvalidationId: nil,
+			Nonce:          types.UInt64FromBytes(iter.Value()),
+			TokenContract:  "",
+		}
+		switch scope := iter.Key()[1:]; pruned.Type {
+		case types.CHECKPOINT_TYPE_BATCH:
+			pruned.TokenContract = gethcommon.BytesToAddress(scope).Hex()
+		case types.CHECKPOINT_TYPE_LOGIC_CALL:
+			pruned.InvalidationId = scope
+		}
+		// cb returns true to stop early
+		if cb(pruned) {
+			break
+		}
+	}
+}
+
+// isPrunedCheckpointSubject tells you whether the checkpoint of a subject with the nonce of the given subject could
+// have been pruned from the archive, in that case a genuine signature over it can not be told apart from a bad one
+func (k Keeper) isPrunedCheckpointSubject(ctx sdk.Context, subject types.EthereumSigned) bool {
+	var (
+		nonce  uint64
+		pruned uint64
+	)
+	switch subject := subject.(type) {
+	case *types.Valset:
+		nonce, pruned = subject.Nonce, k.GetPrunedCheckpointNonce(ctx, types.CHECKPOINT_TYPE_VALSET, nil)
+	case *types.OutgoingTxBatch:
+		nonce, pruned = subject.BatchNonce, k.GetPrunedCheckpointNonce(ctx, types.CHECKPOINT_TYPE_BATCH,
+			types.GetCheckpointNonceScope(types.CHECKPOINT_TYPE_BATCH, subject.TokenContract, nil))
+	case *types.OutgoingLogicCall:
+		nonce, pruned = subject.InvalidationNonce, k.GetPrunedCheckpointNonce(ctx, types.CHECKPOINT_TYPE_LOGIC_CALL, subject.InvalidationId)
+	default:
 		return false
 	}
+	// a pruned nonce of zero means nothing has been pruned yet
+	return pruned != 0 && nonce <= pruned
 }
@@ -34,7 +34,7 @@ func TestSubmitBadSignatureEvidenceBatchExists(t *testing.T) {
 	require.NoError(t, err)
 
 	// mint some voucher first
-	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	require.NoError(t, MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers))
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
@@ -154,3 +154,205 @@ func TestSubmitBadSignatureEvidenceSlash(t *testing.T) {
 	val := input.StakingKeeper.Validator(ctx, ValAddrs[0])
 	require.True(t, val.IsJailed())
 }
+
+//nolint: exhaustivestruct
+func TestSubmitBadSignatureEvidenceReplacedKey(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+
+	batch := types.OutgoingTxBatch{
+		TokenContract: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+		BatchTimeout:  420,
+	}
+	any, err := codectypes.NewAnyWithValue(&batch)
+	require.NoError(t, err)
+
+	oldKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	oldAddress, err := types.NewEthAddress(crypto.PubkeyToAddress(oldKey.PublicKey).String())
+	require.NoError(t, err)
+	newKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	newAddress, err := types.NewEthAddress(crypto.PubkeyToAddress(newKey.PublicKey).String())
+	require.NoError(t, err)
+
+	// the validator signs a forged batch and then replaces its eth key
+	k.SetEthAddressForValidator(ctx.WithBlockHeight(10), ValAddrs[0], *oldAddress)
+	ethSignature, err := types.NewEthereumSignature(batch.GetCheckpoint(k.GetGravityID(ctx)), oldKey)
+	require.NoError(t, err)
+	k.SetEthAddressForValidator(ctx.WithBlockHeight(20), ValAddrs[0], *newAddress)
+
+	validity, found := k.GetEthAddressValidity(ctx, *oldAddress)
+	require.True(t, found)
+	require.Equal(t, types.EthAddressValidity{
+		EthAddress:  oldAddress.GetAddress().Hex(),
+		Validator:   ValAddrs[0].String(),
+		StartHeight: 10,
+		EndHeight:   20,
+	}, *validity)
+	validity, found = k.GetEthAddressValidity(ctx, *newAddress)
+	require.True(t, found)
+	require.Equal(t, uint64(20), validity.StartHeight)
+	require.Equal(t, uint64(0), validity.EndHeight)
+
+	params := k.GetParams(ctx)
+	params.MaxBadSignatureEvidenceAge = 100
+	k.SetParams(ctx, params)
+	msg := types.MsgSubmitBadSignatureEvidence{
+		Subject:   any,
+		Signature: hex.EncodeToString(ethSignature),
+	}
+
+	// evidence made with the replaced key expires
+	err = k.CheckBadSignatureEvidence(ctx.WithBlockHeight(121), &msg)
+	require.Error(t, err)
+	require.False(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+
+	// but is still attributed to the validator until then
+	err = k.CheckBadSignatureEvidence(ctx.WithBlockHeight(120), &msg)
+	require.NoError(t, err)
+	require.True(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+
+	// the age of the evidence does not underflow below the end height, as after a chain restart from an export
+	err = k.CheckBadSignatureEvidence(ctx.WithBlockHeight(15), &msg)
+	require.NoError(t, err)
+}
+
+//nolint: exhaustivestruct
+func TestPrunePastEthSignatureCheckpoints(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	gravityID := k.GetGravityID(ctx)
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddress, err := types.NewEthAddress(crypto.PubkeyToAddress(privKey.PublicKey).String())
+	require.NoError(t, err)
+	k.SetEthAddressForValidator(ctx, ValAddrs[0], *ethAddress)
+
+	oldValset := k.SetValsetRequest(ctx.WithBlockHeight(5))
+	newValset := k.SetValsetRequest(ctx.WithBlockHeight(20))
+	oldCheckpoint := oldValset.GetCheckpoint(gravityID)
+	newCheckpoint := newValset.GetCheckpoint(gravityID)
+	record, found := k.GetPastEthSignatureCheckpointRecord(ctx, oldCheckpoint)
+	require.True(t, found)
+	require.Equal(t, types.PastEthSignatureCheckpoint{
+		Checkpoint:     oldCheckpoint,
+		Height:         5,
+		Type:           types.CHECKPOINT_TYPE_VALSET,
+		Nonce:          oldValset.Nonce,
+		InvalidationId: nil,
+		TokenContract:  "",
+	}, record)
+
+	// the archive mode keeps every checkpoint
+	k.PrunePastEthSignatureCheckpoints(ctx.WithBlockHeight(1000))
+	require.True(t, k.GetPastEthSignatureCheckpoint(ctx, oldCheckpoint))
+
+	// a window shorter than the signed windows of 10 blocks, as set by a param change proposal, is raised to them
+	params := k.GetParams(ctx)
+	params.CheckpointRetentionWindow = 5
+	k.SetParams(ctx, params)
+
+	// a checkpoint is kept for the whole window
+	k.PrunePastEthSignatureCheckpoints(ctx.WithBlockHeight(15))
+	require.True(t, k.GetPastEthSignatureCheckpoint(ctx, oldCheckpoint))
+	require.Equal(t, uint64(0), k.GetPrunedCheckpointNonce(ctx, types.CHECKPOINT_TYPE_VALSET, nil))
+
+	k.PrunePastEthSignatureCheckpoints(ctx.WithBlockHeight(16))
+	require.False(t, k.GetPastEthSignatureCheckpoint(ctx, oldCheckpoint))
+	require.True(t, k.GetPastEthSignatureCheckpoint(ctx, newCheckpoint))
+	require.Equal(t, oldValset.Nonce, k.GetPrunedCheckpointNonce(ctx, types.CHECKPOINT_TYPE_VALSET, nil))
+	require.Equal(t, uint64(0), k.GetPrunedCheckpointNonce(ctx, types.CHECKPOINT_TYPE_BATCH, nil))
+
+	submit := func(valset types.Valset) error {
+		signature, err := types.NewEthereumSignature(valset.GetCheckpoint(gravityID), privKey)
+		require.NoError(t, err)
+		any, err := codectypes.NewAnyWithValue(&valset)
+		require.NoError(t, err)
+		return k.CheckBadSignatureEvidence(ctx, &types.MsgSubmitBadSignatureEvidence{
+			Subject:   any,
+			Signature: hex.EncodeToString(signature),
+		})
+	}
+
+	// a genuine signature over the pruned valset must not be slashed
+	require.EqualError(t, submit(oldValset), "Checkpoint of this nonce may have been pruned from the archive, cannot slash: invalid")
+	require.False(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+
+	// while a forged valset with a nonce in the window still is
+	forged := newValset
+	forged.Members = forged.Members[1:]
+	require.NoError(t, submit(forged))
+	require.True(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+}
+
+// Batch nonces are shared by all tokens but only ordered within one, pruning the batch of one token must not make
+// a forged batch of another token with a lower nonce unslashable
+//nolint: exhaustivestruct
+func TestPruneBatchCheckpointsPerToken(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	gravityID := k.GetGravityID(ctx)
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddress, err := types.NewEthAddress(crypto.PubkeyToAddress(privKey.PublicKey).String())
+	require.NoError(t, err)
+	k.SetEthAddressForValidator(ctx, ValAddrs[0], *ethAddress)
+
+	tokenA, err := types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+	tokenB, err := types.NewEthAddress("0x2a24af0501A534fcA004eE1bD667b783F205A546")
+	require.NoError(t, err)
+	oldBatch, err := types.NewInternalOutgingTxBatch(1, 1000, nil, *tokenA, 5)
+	require.NoError(t, err)
+	k.StoreBatch(ctx.WithBlockHeight(5), *oldBatch)
+	newBatch, err := types.NewInternalOutgingTxBatch(2, 1000, nil, *tokenB, 20)
+	require.NoError(t, err)
+	k.StoreBatch(ctx.WithBlockHeight(20), *newBatch)
+
+	params := k.GetParams(ctx)
+	params.CheckpointRetentionWindow = 10
+	k.SetParams(ctx, params)
+	k.PrunePastEthSignatureCheckpoints(ctx.WithBlockHeight(16))
+
+	// only the nonce of the pruned token is raised
+	require.Equal(t, uint64(1), k.GetPrunedCheckpointNonce(ctx, types.CHECKPOINT_TYPE_BATCH, tokenA.GetAddress().Bytes()))
+	require.Equal(t, uint64(0), k.GetPrunedCheckpointNonce(ctx, types.CHECKPOINT_TYPE_BATCH, tokenB.GetAddress().Bytes()))
+	var pruned []types.PrunedCheckpointNonce
+	k.IteratePrunedCheckpointNonces(ctx, func(p types.PrunedCheckpointNonce) bool {
+		pruned = append(pruned, p)
+		return false
+	})
+	require.Equal(t, []types.PrunedCheckpointNonce{{
+		Type:          types.CHECKPOINT_TYPE_BATCH,
+		Nonce:         1,
+		TokenContract: tokenA.GetAddress().Hex(),
+	}}, pruned)
+
+	submit := func(batch types.InternalOutgoingTxBatch) error {
+		external := batch.ToExternal()
+		signature, err := types.NewEthereumSignature(external.GetCheckpoint(gravityID), privKey)
+		require.NoError(t, err)
+		any, err := codectypes.NewAnyWithValue(&external)
+		require.NoError(t, err)
+		return k.CheckBadSignatureEvidence(ctx, &types.MsgSubmitBadSignatureEvidence{
+			Subject:   any,
+			Signature: hex.EncodeToString(signature),
+		})
+	}
+
+	// a genuine signature over the pruned batch must not be slashed
+	require.EqualError(t, submit(*oldBatch), "Checkpoint of this nonce may have been pruned from the archive, cannot slash: invalid")
+	require.False(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+
+	// while a forged batch of the other token with the same nonce still is
+	forged, err := types.NewInternalOutgingTxBatch(1, 1000, nil, *tokenB, 5)
+	require.NoError(t, err)
+	require.NoError(t, submit(*forged))
+	require.True(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+}
@@ -34,6 +34,9 @@ func initBridgeDataFromGenesis(ctx sdk.Context, k Keeper, data types.GenesisStat
 		}
 		k.StoreBatch(ctx, *intBatch)
 	}
+	for _, removals := range data.BatchTxRemovals {
+		k.setBatchTxRemovals(ctx, removals)
+	}
 
 	// reset batch confirmations in state
 	for _, conf := range data.BatchConfirms {
@@ -65,6 +68,26 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.SetLastSlashedLogicCallBlock(ctx, data.GravityNonces.LastSlashedLogicCallBlock)
 	k.setID(ctx, data.GravityNonces.LastTxPoolId, []byte(types.KeyLastTXPoolID))
 	k.setID(ctx, data.GravityNonces.LastBatchId, []byte(types.KeyLastOutgoingBatchID))
+	if data.GravityNonces.LastUnbondingBlockHeight != 0 {
+		k.SetLastUnBondingBlockHeight(ctx, data.GravityNonces.LastUnbondingBlockHeight)
+	}
+
+	// the optional values below are only written when present so that an export of a chain which has
+	// never set them imports to an identical store
+	if data.LastObservedEthereumHeight != (types.LastObservedEthereumBlockHeight{}) {
+		k.setLastObservedEthereumBlockHeightWithCosmos(ctx, data.LastObservedEthereumHeight)
+	}
+	if data.LastObservedValset != nil {
+		k.SetLastObservedValset(ctx, *data.LastObservedValset)
+	}
+	if !data.LatestValsetTime.IsZero() {
+		k.SetLatestValsetTime(ctx, data.LatestValsetTime)
+	}
+	if data.EthereumBlockTimeEstimate != (types.EthereumBlockTimeEstimate{}) {
+		k.setEthereumBlockTimeEstimate(ctx, data.EthereumBlockTimeEstimate)
+	}
+	k.setCursor(ctx, types.BatchTimeoutCursorKey, data.BatchTimeoutCursor)
+	k.setCursor(ctx, types.LogicCallTimeoutCursorKey, data.LogicCallTimeoutCursor)
 
 	initBridgeDataFromGenesis(ctx, k, data)
 
@@ -95,6 +118,15 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.SetAttestation(ctx, claim.GetEventNonce(), hash, &att)
 	}
 
+	// restore the last event nonce of every validator which has submitted a claim
+	for _, n := range data.LastEventNonces {
+		val, err := sdk.ValAddressFromBech32(n.Validator)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid validator in LastEventNonces: %s", n.Validator))
+		}
+		k.SetLastEventNonceByValidator(ctx, val, n.EventNonce)
+	}
+
 	// reset attestation state of specific validators
 	// this must be done after the above to be correct
 	for _, att := range data.Attestations {
@@ -103,15 +135,9 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		if err != nil {
 			panic("couldn't cast to claim")
 		}
-		// reconstruct the latest event nonce for every validator
-		// if somehow this genesis state is saved when all attestations
-		// have been cleaned up GetLastEventNonceByValidator handles that case
-		//
-		// if we were to save and load the last event nonce for every validator
-		// then we would need to carry that state forever across all chain restarts
-		// but since we've already had to handle the edge case of new validators joining
-		// while all attestations have already been cleaned up we can do this instead and
-		// not carry around every validators event nonce counter forever.
+		// reconstruct the latest event nonce for every validator, this is a
+		// no-op when LastEventNonces was exported alongside the attestations
+		// but keeps older genesis files, which did not include it, working
 		for _, vote := range att.Votes {
 			val, err := sdk.ValAddressFromBech32(vote)
 			if err != nil {
@@ -129,7 +155,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		panic("Duplicate delegate key found in Genesis!")
 	}
 	for _, keys := range data.DelegateKeys {
-		err := keys.ValidateBasic()
+		err := keys.ValidateAddresses()
 		if err != nil {
 			panic("Invalid delegate key in Genesis!")
 		}
@@ -152,6 +178,21 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		// set the ethereum address
 		k.SetEthAddressForValidator(ctx, val, *ethAddr)
 	}
+	// restore the delegate keys retired by rotations, this must be done after setting the current keys
+	for _, rotation := range data.DelegateKeyRotations {
+		k.SetDelegateKeyRotation(ctx, rotation)
+	}
+	// setting the eth addresses above started their validity at the genesis height, restore the real ranges
+	for _, validity := range data.EthAddressValidities {
+		k.SetEthAddressValidity(ctx, validity)
+	}
+	for _, n := range data.DelegateKeyNonces {
+		val, err := sdk.ValAddressFromBech32(n.Validator)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid validator in DelegateKeyNonces: %s", n.Validator))
+		}
+		k.SetDelegateKeyNonce(ctx, val, n.Nonce)
+	}
 
 	// populate state with cosmos originated denom-erc20 mapping
 	for i, item := range data.Erc20ToDenoms {
@@ -162,6 +203,37 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.setCosmosOriginatedDenomToERC20(ctx, item.Denom, *ethAddr)
 	}
 
+	// restore the pending IBC auto forward queue, these were validated when they were queued
+	for _, forward := range data.PendingIbcAutoForwards {
+		k.setPendingIbcAutoForward(ctx, forward)
+	}
+
+	// restore the checkpoint archive after the valsets, batches and logic calls
+	// above, which re-add their own checkpoints, so that older checkpoints are kept
+	// with their original creation height
+	for _, record := range data.PastEthSignatureCheckpointRecords {
+		k.SetPastEthSignatureCheckpoint(ctx, record)
+	}
+	// checkpoints exported before their height and type were recorded are never pruned
+	for _, checkpoint := range data.PastEthSignatureCheckpoints {
+		if !k.GetPastEthSignatureCheckpoint(ctx, checkpoint) {
+			k.SetPastEthSignatureCheckpoint(ctx, types.PastEthSignatureCheckpoint{
+				Checkpoint:     checkpoint,
+				Height:         0,
+				Type:           types.CHECKPOINT_TYPE_UNSPECIFIED,
+				Nonce:          0,
+				InvalidationId: nil,
+				TokenContract:  "",
+			})
+		}
+	}
+	for _, pruned := range data.PrunedCheckpointNonces {
+		k.setPrunedCheckpointNonce(ctx, pruned)
+	}
+	for _, totals := range data.BridgeTotals {
+		k.setBridgeTotals(ctx, totals)
+	}
+
 	// now that we have the denom-erc20 mapping we need to validate
 	// that the valset reward is possible and cosmos originated remove
 	// this if you want a non-cosmos originated reward
@@ -203,6 +275,15 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		delegates          = k.GetDelegateKeys(ctx)
 		erc20ToDenoms      = []types.ERC20ToDenom{}
 		unbatchedTransfers = k.GetUnbatchedTransactions(ctx)
+		forwards           = []types.PendingIbcAutoForward{}
+		checkpoints        = []types.PastEthSignatureCheckpoint{}
+		prunedNonces       = []types.PrunedCheckpointNonce{}
+		bridgeTotals       = []types.BridgeTotals{}
+		batchTxRemovals    = []types.BatchTxRemovals{}
+		lastEventNonces    = []types.LastEventNonceByValidator{}
+		delegateKeyNonces  = []types.DelegateKeyNonce{}
+		rotations          = k.GetDelegateKeyRotations(ctx)
+		validities         = k.GetEthAddressValidities(ctx)
 	)
 
 	// export valset confirmations from state
@@ -239,12 +320,53 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		return false
 	})
 
+	// export the pending IBC auto forward queue
+	for _, forward := range k.PendingIbcAutoForwards(ctx, 0) {
+		forwards = append(forwards, *forward)
+	}
+
+	// export the checkpoint archive and how far it has been pruned
+	k.IteratePastEthSignatureCheckpoints(ctx, func(record types.PastEthSignatureCheckpoint) bool {
+		checkpoints = append(checkpoints, record)
+		return false
+	})
+	k.IteratePrunedCheckpointNonces(ctx, func(pruned types.PrunedCheckpointNonce) bool {
+		prunedNonces = append(prunedNonces, pruned)
+		return false
+	})
+	k.IterateBridgeTotals(ctx, func(totals types.BridgeTotals) bool {
+		bridgeTotals = append(bridgeTotals, totals)
+		return false
+	})
+	k.IterateBatchTxRemovals(ctx, func(removals types.BatchTxRemovals) bool {
+		batchTxRemovals = append(batchTxRemovals, removals)
+		return false
+	})
+
+	// export the last event nonce of every validator
+	k.IterateLastEventNonceByValidator(ctx, func(val sdk.ValAddress, nonce uint64) bool {
+		lastEventNonces = append(lastEventNonces, types.LastEventNonceByValidator{
+			Validator:  val.String(),
+			EventNonce: nonce,
+		})
+		return false
+	})
+
+	// export the delegate key nonce of every validator which has set its keys with a message
+	k.IterateDelegateKeyNonces(ctx, func(val sdk.ValAddress, nonce uint64) bool {
+		delegateKeyNonces = append(delegateKeyNonces, types.DelegateKeyNonce{
+			Validator: val.String(),
+			Nonce:     nonce,
+		})
+		return false
+	})
+
 	unbatchedTxs := make([]types.OutgoingTransferTx, len(unbatchedTransfers))
 	for i, v := range unbatchedTransfers {
 		unbatchedTxs[i] = v.ToExternal()
 	}
 
-	return types.GenesisState{
+	genesis := types.GenesisState{
 		Params: &p,
 		GravityNonces: types.GravityNonces{
 			LatestValsetNonce:         k.GetLatestValsetNonce(ctx),
@@ -254,6 +376,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 			LastSlashedLogicCallBlock: k.GetLastSlashedLogicCallBlock(ctx),
 			LastTxPoolId:              k.getID(ctx, types.KeyLastTXPoolID),
 			LastBatchId:               k.getID(ctx, types.KeyLastOutgoingBatchID),
+			LastUnbondingBlockHeight:  k.GetLastUnBondingBlockHeight(ctx),
 		},
 		Valsets:            valsets,
 		ValsetConfirms:     vsconfs,
@@ -265,5 +388,28 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		DelegateKeys:       delegates,
 		Erc20ToDenoms:      erc20ToDenoms,
 		UnbatchedTransfers: unbatchedTxs,
+
+		PendingIbcAutoForwards:      forwards,
+		PastEthSignatureCheckpoints: [][]byte{},
+		LastObservedEthereumHeight:  k.GetLastObservedEthereumBlockHeight(ctx),
+		LastObservedValset:          k.GetLastObservedValset(ctx),
+		LastEventNonces:             lastEventNonces,
+		LatestValsetTime:            k.GetLatestValsetTime(ctx),
+		DelegateKeyNonces:           delegateKeyNonces,
+		DelegateKeyRotations:        rotations,
+		EthAddressValidities:        validities,
+
+		PastEthSignatureCheckpointRecords: checkpoints,
+		PrunedCheckpointNonces:            prunedNonces,
+		BridgeTotals:                      bridgeTotals,
+		EthereumBlockTimeEstimate:         k.GetEthereumBlockTimeEstimate(ctx),
+		BatchTimeoutCursor:                k.getCursor(ctx, types.BatchTimeoutCursorKey),
+		LogicCallTimeoutCursor:            k.getCursor(ctx, types.LogicCallTimeoutCursorKey),
+		BatchTxRemovals:                   batchTxRemovals,
+	}
+	// cache the attestation claims so that the exported state can be validated without a codec
+	if err := genesis.UnpackInterfaces(k.cdc); err != nil {
+		panic(sdkerrors.Wrap(err, "unable to unpack exported attestations"))
 	}
+	return genesis
 }
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 	"testing"
@@ -8,6 +9,9 @@ import (
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -75,7 +79,7 @@ func TestBatchAndTxImportExport(t *testing.T) {
 		require.NoError(t, err)
 
 		// Mint the vouchers
-		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+		require.NoError(t, MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers))
 	}
 
 	// give sender i a balance of token i
@@ -176,3 +180,173 @@ func exportImport(t *testing.T, input *TestInput) {
 	require.Empty(t, batches)
 	InitGenesis(input.Context, input.GravityKeeper, genesisState)
 }
+
+// Tests that every gravity store is preserved byte for byte by an export and import of the genesis state
+//nolint: exhaustivestruct
+func TestGenesisRoundTripIsLossless(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+
+	// valsets, their confirms and the observed valset
+	valset := k.SetValsetRequest(ctx)
+	for i, orch := range OrchAddrs {
+		k.SetValsetConfirm(ctx, types.MsgValsetConfirm{
+			Nonce:        valset.Nonce,
+			Orchestrator: orch.String(),
+			EthAddress:   EthAddrs[i].String(),
+			Signature:    "dummysig",
+		})
+	}
+	k.SetLastObservedValset(ctx, valset)
+
+	// pool transactions, a batch and its confirms
+	var (
+		sender, _      = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		receiver, _    = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		contract, _    = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		token, err     = types.NewInternalERC20Token(sdk.NewInt(99999), contract.GetAddress().Hex())
+		allVouchers    = sdk.NewCoins(token.GravityCoin())
+		amount, feeAmt = sdk.NewCoin(token.GravityCoin().Denom, sdk.NewInt(100)), sdk.NewCoin(token.GravityCoin().Denom, sdk.NewInt(2))
+	)
+	require.NoError(t, err)
+	require.NoError(t, MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, sender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, allVouchers))
+	for i := 0; i < 4; i++ {
+		_, err := k.AddToOutgoingPool(ctx, sender, *receiver, amount, feeAmt)
+		require.NoError(t, err)
+	}
+	batch, err := k.BuildOutgoingTXBatch(ctx, *contract, 2)
+	require.NoError(t, err)
+	for i, orch := range OrchAddrs {
+		k.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+			Nonce:         batch.BatchNonce,
+			TokenContract: contract.GetAddress().Hex(),
+			EthSigner:     EthAddrs[i].String(),
+			Orchestrator:  orch.String(),
+			Signature:     "dummysig",
+		})
+	}
+	// a cancel batch proposal removing one of its transactions at the timeout
+	k.addBatchTxRemovals(ctx, *contract, batch.BatchNonce, []uint64{batch.Transactions[0].Id})
+
+	// a logic call and its confirms
+	call := types.OutgoingLogicCall{
+		Timeout:           420,
+		InvalidationId:    []byte("invalidation"),
+		InvalidationNonce: 1,
+	}
+	k.SetOutgoingLogicCall(ctx, call)
+	for i, orch := range OrchAddrs {
+		k.SetLogicCallConfirm(ctx, &types.MsgConfirmLogicCall{
+			InvalidationId:    fmt.Sprintf("%x", call.InvalidationId),
+			InvalidationNonce: call.InvalidationNonce,
+			EthSigner:         EthAddrs[i].String(),
+			Orchestrator:      orch.String(),
+			Signature:         "dummysig",
+		})
+	}
+
+	// attestations and the per validator event nonces, the last validator has had its
+	// attestations pruned so its nonce can only be recovered from the explicit export
+	_, _, hashes := createAttestations(t, 3, k, ctx)
+	for i, hash := range hashes {
+		nonce := uint64(i + 1)
+		att := k.GetAttestation(ctx, nonce, hash)
+		att.Votes = []string{ValAddrs[0].String(), ValAddrs[1].String()}
+		att.Observed = true
+		k.SetAttestation(ctx, nonce, hash, att)
+	}
+	k.SetLastEventNonceByValidator(ctx, ValAddrs[0], 3)
+	k.SetLastEventNonceByValidator(ctx, ValAddrs[1], 3)
+	k.SetLastEventNonceByValidator(ctx, ValAddrs[4], 7)
+	k.setLastObservedEventNonce(ctx, 3)
+	k.SetLastObservedEthereumBlockHeight(ctx, 1234)
+
+	// the remaining queues, archives and counters, a pending forward is backed by module funds
+	require.NoError(t, MintDepositedVouchers(ctx, input.GravityKeeper, sdk.NewCoins(amount)))
+	foreignReceiver, err := bech32.ConvertAndEncode("cosmos", AccAddrs[0])
+	require.NoError(t, err)
+	k.setPendingIbcAutoForward(ctx, types.PendingIbcAutoForward{
+		ForeignReceiver: foreignReceiver,
+		Token:           &amount,
+		IbcChannel:      "channel-0",
+		EventNonce:      2,
+	})
+	// a checkpoint with bytes above 0x7f exercises the multi byte key encoding
+	k.SetPastEthSignatureCheckpoint(ctx, types.PastEthSignatureCheckpoint{
+		Checkpoint:     []byte{0x00, 0x7f, 0x80, 0xff, 0xc3, 0xa9},
+		Height:         3,
+		Type:           types.CHECKPOINT_TYPE_LOGIC_CALL,
+		Nonce:          4,
+		InvalidationId: []byte{0x1},
+		TokenContract:  "",
+	})
+	// a checkpoint archived before heights were recorded and an earlier logic call pruned from the archive
+	ctx.KVStore(k.storeKey).Set(types.GetPastEthSignatureCheckpointKey([]byte{0xab, 0xcd}), []byte{0x1})
+	k.setPrunedCheckpointNonce(ctx, types.PrunedCheckpointNonce{
+		Type:           types.CHECKPOINT_TYPE_LOGIC_CALL,
+		InvalidationId: []byte{0x1},
+		Nonce:          2,
+	})
+	k.setPrunedCheckpointNonce(ctx, types.PrunedCheckpointNonce{
+		Type:          types.CHECKPOINT_TYPE_BATCH,
+		Nonce:         1,
+		TokenContract: contract.GetAddress().Hex(),
+	})
+	k.SetLastUnBondingBlockHeight(ctx, 42)
+	k.SetDelegateKeyNonce(ctx, ValAddrs[2], 2)
+
+	// a delegate key rotation retires keys which must keep resolving to their validator
+	ethKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	newEthAddr, err := types.NewEthAddress(crypto.PubkeyToAddress(ethKey.PublicKey).Hex())
+	require.NoError(t, err)
+	newOrch := sdk.AccAddress(bytes.Repeat([]byte{0x9}, 20))
+	rotateSig, err := types.NewEthereumSignature(types.GetDelegateKeysSignHash(k.GetGravityID(ctx), ValAddrs[2], newOrch, 2), ethKey)
+	require.NoError(t, err)
+	_, err = NewMsgServerImpl(k).RotateDelegateKeys(sdk.WrapSDKContext(ctx), types.NewMsgRotateDelegateKeys(ValAddrs[2], newOrch, *newEthAddr, rotateSig))
+	require.NoError(t, err)
+	k.SetLastSlashedValsetNonce(ctx, 1)
+	k.SetLastSlashedBatchBlock(ctx, 5)
+	k.SetLastSlashedLogicCallBlock(ctx, 6)
+	// the timeout cursors of an EndBlocker which stopped after the batch and logic call
+	k.setCursor(ctx, types.BatchTimeoutCursorKey,
+		types.GetOutgoingTxBatchKey(*contract, batch.BatchNonce)[len(types.OutgoingTXBatchKey):])
+	k.setCursor(ctx, types.LogicCallTimeoutCursorKey,
+		types.GetOutgoingLogicCallKey(call.InvalidationId, call.InvalidationNonce)[len(types.KeyOutgoingLogicCall):])
+
+	exported := ExportGenesis(ctx, k)
+	require.NoError(t, exported.ValidateBasic())
+	require.Len(t, exported.DelegateKeyRotations, 1)
+	require.NotEmpty(t, exported.BatchTimeoutCursor)
+	require.NotEmpty(t, exported.LogicCallTimeoutCursor)
+
+	// validate-genesis reads the state back from JSON, which must unpack the attestation claims
+	var fromJSON types.GenesisState
+	marshaler := MakeTestMarshaler()
+	toJSON := ExportGenesis(ctx, k)
+	require.NoError(t, marshaler.UnmarshalJSON(marshaler.MustMarshalJSON(&toJSON), &fromJSON))
+	require.NoError(t, fromJSON.ValidateBasic())
+
+	newInput := CreateTestEnv(t)
+	InitGenesis(newInput.Context, newInput.GravityKeeper, exported)
+
+	require.Equal(t, gravityStoreContents(ctx, k), gravityStoreContents(newInput.Context, newInput.GravityKeeper))
+	require.Equal(t, exported, ExportGenesis(newInput.Context, newInput.GravityKeeper))
+}
+
+// gravityStoreContents returns every key value pair in the gravity store
+func gravityStoreContents(ctx sdk.Context, k Keeper) []kv.Pair {
+	iter := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	var pairs []kv.Pair
+	for ; iter.Valid(); iter.Next() {
+		pairs = append(pairs, kv.Pair{Key: iter.Key(), Value: iter.Value()})
+	}
+	return pairs
+}
@@ -36,6 +36,16 @@ func RegisterProposalTypes() {
 		govtypes.RegisterProposalType(types.ProposalTypeAirdrop)
 		govtypes.RegisterProposalTypeCodec(&types.AirdropProposal{}, airdrop)
 	}
+	cancelBatch := "gravity/CancelBatch"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(cancelBatch, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeCancelBatch)
+		govtypes.RegisterProposalTypeCodec(&types.CancelBatchProposal{}, cancelBatch)
+	}
+	removePendingTransfers := "gravity/RemovePendingTransfers"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(removePendingTransfers, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeRemovePendingTransfers)
+		govtypes.RegisterProposalTypeCodec(&types.RemovePendingTransfersProposal{}, removePendingTransfers)
+	}
 }
 
 func NewGravityProposalHandler(k Keeper) govtypes.Handler {
@@ -47,6 +57,10 @@ func NewGravityProposalHandler(k Keeper) govtypes.Handler {
 			return k.HandleAirdropProposal(ctx, c)
 		case *types.IBCMetadataProposal:
 			return k.HandleIBCMetadataProposal(ctx, c)
+		case *types.CancelBatchProposal:
+			return k.HandleCancelBatchProposal(ctx, c)
+		case *types.RemovePendingTransfersProposal:
+			return k.HandleRemovePendingTransfersProposal(ctx, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized Gravity proposal content type: %T", c)
@@ -250,3 +264,165 @@ func (k Keeper) HandleIBCMetadataProposal(ctx sdk.Context, p *types.IBCMetadataP
 
 	return nil
 }
+
+// handles a governance proposal cancelling a batch which can never execute on Ethereum, the transactions of the
+// batch return to the pool and those listed for removal are refunded to their senders so they are not batched again.
+// A batch which could still execute must never be cancelled, its execution would pay out the transactions a second
+// time and halt the chain once observed. So a batch which has not timed out yet is left to the EndBlocker, which
+// refunds the recorded removals when it cancels the batch at its timeout. The EndBlocker may also have cancelled the
+// batch while the proposal was voted on, then the removed transactions are refunded from the pool or recorded for
+// the batch they were added to since. Batches superseded by the execution of a later batch are cancelled as part of
+// that execution and need no proposal.
+func (k Keeper) HandleCancelBatchProposal(ctx sdk.Context, p *types.CancelBatchProposal) error {
+	ctx.Logger().Info("Gov vote passed: Cancelling batch", "token", p.TokenContract, "nonce", p.BatchNonce)
+
+	tokenContract, err := types.NewEthAddress(p.TokenContract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid token contract")
+	}
+	batch := k.GetOutgoingTXBatch(ctx, *tokenContract, p.BatchNonce)
+	if batch == nil {
+		if p.BatchNonce > k.getID(ctx, types.KeyLastOutgoingBatchID) {
+			return sdkerrors.Wrapf(types.ErrUnknown, "batch %d of token %s", p.BatchNonce, p.TokenContract)
+		}
+		return k.removeTxsOfCancelledBatch(ctx, *tokenContract, p.RemoveTxIds)
+	}
+	// check every removal before recording them so that a mistaken proposal changes nothing
+	inBatch := make(map[uint64]struct{}, len(batch.Transactions))
+	for _, tx := range batch.Transactions {
+		inBatch[tx.Id] = struct{}{}
+	}
+	for _, id := range p.RemoveTxIds {
+		if _, ok := inBatch[id]; !ok {
+			return sdkerrors.Wrapf(types.ErrInvalid, "tx %d is not in batch %d", id, p.BatchNonce)
+		}
+	}
+	k.addBatchTxRemovals(ctx, *tokenContract, p.BatchNonce, p.RemoveTxIds)
+
+	// the same condition as the EndBlocker timeout, see cleanupTimedOutBatches
+	if ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight; batch.BatchTimeout >= ethereumHeight {
+		ctx.Logger().Info("Batch is cancelled once it times out", "token", p.TokenContract, "nonce", p.BatchNonce,
+			"timeout", batch.BatchTimeout, "ethereum height", ethereumHeight)
+		return nil
+	}
+	return sdkerrors.Wrap(k.CancelOutgoingTXBatch(ctx, *tokenContract, p.BatchNonce), "cancel batch")
+}
+
+// removeTxsOfCancelledBatch removes the transactions of a batch which has already been cancelled, those back in the
+// pool are refunded right away and those which have been batched again are recorded for removal from their new batch
+func (k Keeper) removeTxsOfCancelledBatch(ctx sdk.Context, tokenContract types.EthAddress, ids []uint64) error {
+	// the nonce of the batch holding each transaction, zero for the pool
+	batchNonces := make([]uint64, len(ids))
+	for i, id := range ids {
+		if tx, err := k.GetUnbatchedTxById(ctx, id); err == nil {
+			if tx.Erc20Token.Contract.GetAddress() != tokenContract.GetAddress() {
+				return sdkerrors.Wrapf(types.ErrInvalid, "tx %d is not of token %s", id, tokenContract.GetAddress().Hex())
+			}
+			continue
+		}
+		k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+			if batch.TokenContract.GetAddress() != tokenContract.GetAddress() {
+				return false
+			}
+			for _, tx := range batch.Transactions {
+				if tx.Id == id {
+					batchNonces[i] = batch.BatchNonce
+					return true
+				}
+			}
+			return false
+		})
+		if batchNonces[i] == 0 {
+			return sdkerrors.Wrapf(types.ErrUnknown, "tx %d is neither in the pool nor in a batch", id)
+		}
+	}
+
+	for i, id := range ids {
+		if batchNonces[i] != 0 {
+			k.addBatchTxRemovals(ctx, tokenContract, batchNonces[i], []uint64{id})
+			continue
+		}
+		tx, err := k.GetUnbatchedTxById(ctx, id)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "remove tx %d", id)
+		}
+		if err := k.RemoveFromOutgoingPoolAndRefund(ctx, id, tx.Sender); err != nil {
+			return sdkerrors.Wrapf(err, "remove tx %d", id)
+		}
+	}
+	return nil
+}
+
+// addBatchTxRemovals records transactions to remove from a batch once it is cancelled, in addition to those
+// recorded before
+func (k Keeper) addBatchTxRemovals(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64, ids []uint64) {
+	if len(ids) == 0 {
+		return
+	}
+	removals := types.BatchTxRemovals{
+		TokenContract: tokenContract.GetAddress().Hex(),
+		BatchNonce:    nonce,
+		TxIds:         k.GetBatchTxRemovals(ctx, tokenContract, nonce),
+	}
+	recorded := make(map[uint64]struct{}, len(removals.TxIds))
+	for _, id := range removals.TxIds {
+		recorded[id] = struct{}{}
+	}
+	for _, id := range ids {
+		if _, ok := recorded[id]; !ok {
+			removals.TxIds = append(removals.TxIds, id)
+			recorded[id] = struct{}{}
+		}
+	}
+	k.setBatchTxRemovals(ctx, removals)
+}
+
+// handles a governance proposal removing the transactions waiting in the pool which were sent by or to the given
+// accounts, their funds are sent to the community pool or refunded to their senders. Nothing is changed if no
+// transaction matches so that the proposal fails visibly
+func (k Keeper) HandleRemovePendingTransfersProposal(ctx sdk.Context, p *types.RemovePendingTransfersProposal) error {
+	ctx.Logger().Info("Gov vote passed: Removing pending transfers", "senders", p.Senders, "destinations", p.Destinations, "refund", p.RefundSenders)
+
+	senders := make(map[string]struct{}, len(p.Senders))
+	for _, s := range p.Senders {
+		sender, err := sdk.AccAddressFromBech32(s)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "invalid sender %s", s)
+		}
+		senders[sender.String()] = struct{}{}
+	}
+	destinations := make(map[string]struct{}, len(p.Destinations))
+	for _, d := range p.Destinations {
+		destination, err := types.NewEthAddress(d)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "invalid destination %s", d)
+		}
+		destinations[destination.GetAddress().Hex()] = struct{}{}
+	}
+
+	var removed []*types.InternalOutgoingTransferTx
+	k.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+		_, fromSender := senders[tx.Sender.String()]
+		_, toDestination := destinations[tx.DestAddress.GetAddress().Hex()]
+		if fromSender || toDestination {
+			removed = append(removed, tx)
+		}
+		return false
+	})
+	if len(removed) == 0 {
+		return sdkerrors.Wrap(types.ErrUnknown, "no pending transfers of the senders or destinations")
+	}
+
+	for _, tx := range removed {
+		var err error
+		if p.RefundSenders {
+			err = k.RemoveFromOutgoingPoolAndRefund(ctx, tx.Id, tx.Sender)
+		} else {
+			err = k.RemoveFromOutgoingPoolToCommunityPool(ctx, tx.Id)
+		}
+		if err != nil {
+			return sdkerrors.Wrapf(err, "remove tx %d", tx.Id)
+		}
+	}
+	return nil
+}
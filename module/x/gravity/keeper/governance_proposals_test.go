@@ -191,3 +191,155 @@ func TestIBCMetadataProposal(t *testing.T) {
 	require.Error(t, err)
 
 }
+
+// fillPool funds sender and adds transfers of the given amounts with a fee of 1 to the pool, the transfers get the ids
+// 1 to len(amounts)
+func fillPool(t *testing.T, input TestInput, sender sdk.AccAddress, receiver types.EthAddress, tokenContract types.EthAddress, amounts ...int64) {
+	ctx := input.Context
+	vouchers, err := types.NewInternalERC20Token(sdk.NewInt(100000), tokenContract.GetAddress().Hex())
+	require.NoError(t, err)
+	require.NoError(t, MintDepositedVouchers(ctx, input.GravityKeeper, sdk.NewCoins(vouchers.GravityCoin())))
+	input.AccountKeeper.NewAccountWithAddress(ctx, sender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(vouchers.GravityCoin())))
+
+	for _, amount := range amounts {
+		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(amount), tokenContract.GetAddress().Hex())
+		require.NoError(t, err)
+		feeToken, err := types.NewInternalERC20Token(sdk.NewInt(1), tokenContract.GetAddress().Hex())
+		require.NoError(t, err)
+		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, sender, receiver, amountToken.GravityCoin(), feeToken.GravityCoin())
+		require.NoError(t, err)
+	}
+}
+
+//nolint: exhaustivestruct
+func TestCancelBatchProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	k := input.GravityKeeper
+	handler := NewGravityProposalHandler(k)
+	var (
+		sender, _        = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		receiver, _      = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+	fillPool(t, input, sender, *receiver, *tokenContract, 100, 200, 300)
+	denom := types.GravityDenom(*tokenContract)
+	balance := input.BankKeeper.GetBalance(ctx, sender, denom)
+
+	k.SetLastObservedEthereumBlockHeight(ctx, 100)
+	batch, err := k.BuildOutgoingTXBatch(ctx, *tokenContract, 3)
+	require.NoError(t, err)
+	require.Greater(t, batch.BatchTimeout, uint64(100))
+	for i, orch := range OrchAddrs {
+		k.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+			Nonce:         batch.BatchNonce,
+			TokenContract: tokenContract.GetAddress().Hex(),
+			EthSigner:     EthAddrs[i].String(),
+			Orchestrator:  orch.String(),
+			Signature:     "dummysig",
+		})
+	}
+	proposal := &types.CancelBatchProposal{
+		Title:         "cancel batch",
+		Description:   "the batch always reverts",
+		TokenContract: tokenContract.GetAddress().Hex(),
+		BatchNonce:    batch.BatchNonce,
+		RemoveTxIds:   []uint64{2},
+	}
+
+	// a tx which is not in the batch changes nothing
+	proposal.RemoveTxIds = []uint64{2, 4}
+	require.Error(t, handler(ctx, proposal))
+	require.Nil(t, k.GetBatchTxRemovals(ctx, *tokenContract, batch.BatchNonce))
+
+	// a signed batch which may still execute on Ethereum is not cancelled, not even at its timeout height, the
+	// removal is recorded for the EndBlocker to carry out at the timeout instead
+	proposal.RemoveTxIds = []uint64{2}
+	require.NoError(t, handler(ctx, proposal))
+	k.SetLastObservedEthereumBlockHeight(ctx, batch.BatchTimeout)
+	require.NoError(t, handler(ctx, proposal))
+	require.NotNil(t, k.GetOutgoingTXBatch(ctx, *tokenContract, batch.BatchNonce))
+	require.Len(t, k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, *tokenContract), len(OrchAddrs))
+	require.Equal(t, []uint64{2}, k.GetBatchTxRemovals(ctx, *tokenContract, batch.BatchNonce))
+	require.Equal(t, balance, input.BankKeeper.GetBalance(ctx, sender, denom))
+
+	// once timed out the batch is cancelled right away
+	k.SetLastObservedEthereumBlockHeight(ctx, batch.BatchTimeout+1)
+	require.NoError(t, handler(ctx, proposal))
+	require.Nil(t, k.GetOutgoingTXBatch(ctx, *tokenContract, batch.BatchNonce))
+	require.Nil(t, k.GetBatchTxRemovals(ctx, *tokenContract, batch.BatchNonce))
+	require.Empty(t, k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, *tokenContract))
+	var pooled []uint64
+	for _, tx := range k.GetUnbatchedTransactions(ctx) {
+		pooled = append(pooled, tx.Id)
+	}
+	require.ElementsMatch(t, []uint64{1, 3}, pooled)
+	// the removed transaction is refunded with its fee
+	require.Equal(t, balance.Amount.AddRaw(201), input.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+
+	// once the batch is gone, the removed transaction is no longer anywhere
+	require.Error(t, handler(ctx, proposal))
+	// while one of its transactions back in the pool is refunded from there
+	proposal.RemoveTxIds = []uint64{1}
+	require.NoError(t, handler(ctx, proposal))
+	require.Equal(t, balance.Amount.AddRaw(302), input.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+
+	// a batch which never existed
+	proposal.BatchNonce = batch.BatchNonce + 1
+	proposal.RemoveTxIds = nil
+	require.Error(t, handler(ctx, proposal))
+}
+
+//nolint: exhaustivestruct
+func TestRemovePendingTransfersProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	k := input.GravityKeeper
+	handler := NewGravityProposalHandler(k)
+	var (
+		sender, _        = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		other, _         = sdk.AccAddressFromBech32("gravity1n38caqg63jf9hefycw3yp95fpkpk669nvekqy2")
+		receiver, _      = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		otherReceiver, _ = types.NewEthAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+		tokenContract, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+	fillPool(t, input, sender, *receiver, *tokenContract, 100, 200)
+	fillPool(t, input, other, *otherReceiver, *tokenContract, 300)
+	fillPool(t, input, other, *receiver, *tokenContract, 400)
+	denom := types.GravityDenom(*tokenContract)
+	otherBalance := input.BankKeeper.GetBalance(ctx, other, denom)
+
+	proposal := &types.RemovePendingTransfersProposal{
+		Title:        "remove pending transfers",
+		Description:  "sanctioned",
+		Senders:      []string{sender.String()},
+		Destinations: []string{otherReceiver.GetAddress().Hex()},
+	}
+	require.NoError(t, handler(ctx, proposal))
+	pool := k.GetUnbatchedTransactions(ctx)
+	require.Len(t, pool, 1)
+	require.Equal(t, uint64(4), pool[0].Id)
+	// the funds are seized
+	communityPool := input.DistKeeper.GetFeePool(ctx).CommunityPool
+	require.Equal(t, sdk.NewDec(603), communityPool.AmountOf(denom))
+	require.Equal(t, sdk.NewInt(603), k.GetBridgeTotals(ctx, *tokenContract).CommunityPool)
+	require.Equal(t, otherBalance, input.BankKeeper.GetBalance(ctx, other, denom))
+
+	// nothing is left to remove
+	require.Error(t, handler(ctx, proposal))
+
+	refund := &types.RemovePendingTransfersProposal{
+		Title:         "remove pending transfers",
+		Description:   "refund",
+		Senders:       []string{other.String()},
+		RefundSenders: true,
+	}
+	require.NoError(t, handler(ctx, refund))
+	require.Empty(t, k.GetUnbatchedTransactions(ctx))
+	require.Equal(t, otherBalance.Amount.AddRaw(401), input.BankKeeper.GetBalance(ctx, other, denom).Amount)
+}
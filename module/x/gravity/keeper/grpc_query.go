@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
@@ -69,21 +72,46 @@ func (k Keeper) ValsetConfirmsByNonce(
 	return &types.QueryValsetConfirmsByNonceResponse{Confirms: confirms}, nil
 }
 
-const maxValsetRequestsReturned = 5
+// defaultValsetRequestsReturned is the number of valsets returned by LastValsetRequests
+// when the request does not provide pagination
+const defaultValsetRequestsReturned = 5
+
+// newestFirst returns a copy of pageReq with the iteration order inverted, this is used by
+// queries which list the newest (or highest fee) entries first unless pagination.reverse is set
+func newestFirst(pageReq *query.PageRequest) *query.PageRequest {
+	if pageReq == nil {
+		return &query.PageRequest{Reverse: true}
+	}
+	ret := *pageReq
+	ret.Reverse = !pageReq.Reverse
+	return &ret
+}
 
 // LastValsetRequests queries the LastValsetRequests of the gravity module
 func (k Keeper) LastValsetRequests(
 	c context.Context,
 	req *types.QueryLastValsetRequestsRequest) (*types.QueryLastValsetRequestsResponse, error) {
-	valReq := k.GetValsets(sdk.UnwrapSDKContext(c))
-	valReqLen := len(valReq)
-	retLen := 0
-	if valReqLen < maxValsetRequestsReturned {
-		retLen = valReqLen
-	} else {
-		retLen = maxValsetRequestsReturned
+	ctx := sdk.UnwrapSDKContext(c)
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{Limit: defaultValsetRequestsReturned}
+	}
+
+	valsets := []types.Valset{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValsetRequestKey)
+	pageRes, err := query.Paginate(store, newestFirst(pageReq), func(_ []byte, value []byte) error {
+		var valset types.Valset
+		if err := k.cdc.Unmarshal(value, &valset); err != nil {
+			return err
+		}
+		valsets = append(valsets, valset)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
-	return &types.QueryLastValsetRequestsResponse{Valsets: valReq[0:retLen]}, nil
+
+	return &types.QueryLastValsetRequestsResponse{Valsets: valsets, Pagination: pageRes}, nil
 }
 
 // LastPendingValsetRequestByAddr queries the LastPendingValsetRequestByAddr of the gravity module
@@ -184,30 +212,57 @@ func (k Keeper) LastPendingLogicCallByAddr(
 	}
 }
 
-const MaxResults = 100 // todo: impl pagination
-
 // OutgoingTxBatches queries the OutgoingTxBatches of the gravity module
 func (k Keeper) OutgoingTxBatches(
 	c context.Context,
 	req *types.QueryOutgoingTxBatchesRequest) (*types.QueryOutgoingTxBatchesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixKey := types.OutgoingTXBatchKey
+	if req.TokenContract != "" {
+		contract, err := types.NewEthAddress(req.TokenContract)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid token contract in request")
+		}
+		prefixKey = types.GetOutgoingTxBatchContractPrefix(*contract)
+	}
+
 	var batches []types.OutgoingTxBatch
-	k.IterateOutgoingTXBatches(sdk.UnwrapSDKContext(c), func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
-		batches = append(batches, batch.ToExternal())
-		return len(batches) == MaxResults
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+	pageRes, err := query.Paginate(store, newestFirst(req.Pagination), func(_ []byte, value []byte) error {
+		var batch types.OutgoingTxBatch
+		if err := k.cdc.Unmarshal(value, &batch); err != nil {
+			return err
+		}
+		batches = append(batches, batch)
+		return nil
 	})
-	return &types.QueryOutgoingTxBatchesResponse{Batches: batches}, nil
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryOutgoingTxBatchesResponse{Batches: batches, Pagination: pageRes}, nil
 }
 
 // OutgoingLogicCalls queries the OutgoingLogicCalls of the gravity module
 func (k Keeper) OutgoingLogicCalls(
 	c context.Context,
 	req *types.QueryOutgoingLogicCallsRequest) (*types.QueryOutgoingLogicCallsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
 	var calls []types.OutgoingLogicCall
-	k.IterateOutgoingLogicCalls(sdk.UnwrapSDKContext(c), func(_ []byte, call types.OutgoingLogicCall) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyOutgoingLogicCall)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var call types.OutgoingLogicCall
+		if err := k.cdc.Unmarshal(value, &call); err != nil {
+			return err
+		}
 		calls = append(calls, call)
-		return len(calls) == MaxResults
+		return nil
 	})
-	return &types.QueryOutgoingLogicCallsResponse{Calls: calls}, nil
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryOutgoingLogicCallsResponse{Calls: calls, Pagination: pageRes}, nil
 }
 
 // BatchRequestByNonce queries the BatchRequestByNonce of the gravity module.
@@ -314,62 +369,92 @@ func (k Keeper) GetAttestations(
 ) (*types.QueryAttestationsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	limit := req.Limit
-	if limit == 0 || limit > QUERY_ATTESTATIONS_LIMIT {
-		limit = QUERY_ATTESTATIONS_LIMIT
+	var pageReq query.PageRequest
+	if req.Pagination != nil {
+		pageReq = *req.Pagination
+	} else {
+		pageReq.Limit = req.Limit
+	}
+	if pageReq.Limit == 0 || pageReq.Limit > QUERY_ATTESTATIONS_LIMIT {
+		pageReq.Limit = QUERY_ATTESTATIONS_LIMIT
 	}
+	pageReq.Reverse = pageReq.Reverse || strings.EqualFold(req.OrderBy, "desc")
 
-	var (
-		attestations []types.Attestation
-		count        uint64
-		iterErr      error
-	)
+	var observed *bool
+	if req.Observed != "" {
+		o, err := strconv.ParseBool(req.Observed)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid observed filter %s", req.Observed)
+		}
+		observed = &o
+	}
+	var voter string
+	if req.Voter != "" {
+		val, err := sdk.ValAddressFromBech32(req.Voter)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid voter")
+		}
+		voter = val.String()
+	}
+	if req.MaxHeight != 0 && req.MinHeight > req.MaxHeight {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "min height is greater than max height")
+	}
 
-	reverse := strings.EqualFold(req.OrderBy, "desc")
 	filter := req.Height > 0 || req.Nonce > 0 || req.ClaimType != ""
 
-	k.IterateAttestations(ctx, reverse, func(_ []byte, att types.Attestation) (abort bool) {
+	var attestations []types.Attestation
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OracleAttestationKey)
+	pageRes, err := query.FilteredPaginate(store, &pageReq, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var att types.Attestation
+		if err := k.cdc.Unmarshal(value, &att); err != nil {
+			return false, err
+		}
 		claim, err := k.UnpackAttestationClaim(&att)
 		if err != nil {
-			iterErr = sdkerrors.Wrap(sdkerrors.ErrUnpackAny, "failed to unmarshal Ethereum claim")
-			return true
+			return false, sdkerrors.Wrap(sdkerrors.ErrUnpackAny, "failed to unmarshal Ethereum claim")
 		}
 
-		var match bool
-		switch {
-		case filter && claim.GetBlockHeight() == req.Height:
-			attestations = append(attestations, att)
-			match = true
-
-		case filter && claim.GetEventNonce() == req.Nonce:
-			attestations = append(attestations, att)
-			match = true
+		// An attestation must match ANY of the nonce, height and claim type filters. No filter
+		// provided is equivalent to providing no query params or just limit and/or order_by.
+		match := !filter ||
+			claim.GetBlockHeight() == req.Height ||
+			claim.GetEventNonce() == req.Nonce ||
+			claim.GetType().String() == req.ClaimType
 
-		case filter && claim.GetType().String() == req.ClaimType:
-			attestations = append(attestations, att)
-			match = true
-
-		case !filter:
-			// No filter provided, so we include the attestation. This is equivalent
-			// to providing no query params or just limit and/or order_by.
-			attestations = append(attestations, att)
-			match = true
+		// and ALL of the remaining filters
+		if observed != nil && att.Observed != *observed {
+			match = false
 		}
-
-		if match {
-			count++
-			if count >= limit {
-				return true
-			}
+		if req.MinHeight != 0 && claim.GetBlockHeight() < req.MinHeight {
+			match = false
+		}
+		if req.MaxHeight != 0 && claim.GetBlockHeight() > req.MaxHeight {
+			match = false
+		}
+		if voter != "" && !hasVote(att, voter) {
+			match = false
 		}
 
-		return false
+		if match && accumulate {
+			attestations = append(attestations, att)
+		}
+		return match, nil
 	})
-	if iterErr != nil {
-		return nil, iterErr
+	if err != nil {
+		return nil, err
 	}
 
-	return &types.QueryAttestationsResponse{Attestations: attestations}, nil
+	return &types.QueryAttestationsResponse{Attestations: attestations, Pagination: pageRes}, nil
+}
+
+// hasVote returns true if the given validator has voted on the attestation
+func hasVote(att types.Attestation, validator string) bool {
+	for _, vote := range att.Votes {
+		if vote == validator {
+			return true
+		}
+	}
+	return false
 }
 
 func (k Keeper) GetDelegateKeyByValidator(
@@ -395,6 +480,36 @@ func (k Keeper) GetDelegateKeyByValidator(
 	return nil, sdkerrors.Wrap(types.ErrInvalid, "No validator")
 }
 
+// DelegateKeys queries all the delegate keys set by validators, ordered by orchestrator address
+func (k Keeper) DelegateKeys(
+	c context.Context,
+	req *types.QueryDelegateKeysRequest) (*types.QueryDelegateKeysResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var keys []types.MsgSetOrchestratorAddress
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyOrchestratorAddress)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		orch := sdk.AccAddress(key)
+		val := sdk.ValAddress(value)
+		ethAddr, found := k.GetEthAddressByValidator(ctx, val)
+		if !found {
+			// this should never happen unless the store
+			// is somehow inconsistent
+			return sdkerrors.Wrapf(types.ErrInvalid, "no eth address for validator %s", val.String())
+		}
+		keys = append(keys, types.MsgSetOrchestratorAddress{
+			Validator:    val.String(),
+			Orchestrator: orch.String(),
+			EthAddress:   ethAddr.GetAddress().Hex(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegateKeysResponse{DelegateKeys: keys, Pagination: pageRes}, nil
+}
+
 func (k Keeper) GetDelegateKeyByOrchestrator(
 	c context.Context,
 	req *types.QueryDelegateKeysByOrchestratorAddress) (*types.QueryDelegateKeysByOrchestratorAddressResponse, error) {
@@ -443,7 +558,6 @@ func (k Keeper) GetPendingSendToEth(
 	req *types.QueryPendingSendToEth) (*types.QueryPendingSendToEthResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	batches := k.GetOutgoingTxBatches(ctx)
-	unbatched_tx := k.GetUnbatchedTransactions(ctx)
 	sender_address := req.GetSenderAddress()
 	res := types.QueryPendingSendToEthResponse{
 		TransfersInBatches: []types.OutgoingTransferTx{},
@@ -456,11 +570,26 @@ func (k Keeper) GetPendingSendToEth(
 			}
 		}
 	}
-	for _, tx := range unbatched_tx {
-		if tx.Sender.String() == sender_address {
-			res.UnbatchedTransfers = append(res.UnbatchedTransfers, tx.ToExternal())
+
+	// unbatched transactions are stored in ascending fee order, list them highest fee first
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingTXPoolKey)
+	pageRes, err := query.FilteredPaginate(store, newestFirst(req.Pagination), func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var tx types.OutgoingTransferTx
+		if err := k.cdc.Unmarshal(value, &tx); err != nil {
+			return false, err
+		}
+		if tx.Sender != sender_address {
+			return false, nil
 		}
+		if accumulate {
+			res.UnbatchedTransfers = append(res.UnbatchedTransfers, tx)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
+	res.Pagination = pageRes
 
 	return &res, nil
 }
@@ -473,3 +602,61 @@ func (k Keeper) GetPendingIbcAutoForwards(
 	pendingForwards := k.PendingIbcAutoForwards(ctx, req.Limit)
 	return &types.QueryPendingIbcAutoForwardsResponse{PendingIbcAutoForwards: pendingForwards}, nil
 }
+
+// ValsetPowerDiff queries the power drift between the current validator set and the latest valset request
+func (k Keeper) ValsetPowerDiff(
+	c context.Context,
+	req *types.QueryValsetPowerDiffRequest,
+) (*types.QueryValsetPowerDiffResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	latestValset := k.GetLatestValset(ctx)
+	if latestValset == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "no valset requests have been made")
+	}
+	powerDiff, err := k.GetValsetPowerDiff(ctx, *latestValset)
+	if err != nil {
+		return nil, err
+	}
+	ageBlocks, ageTime := k.GetValsetAge(ctx, *latestValset)
+
+	return &types.QueryValsetPowerDiffResponse{
+		LatestValsetNonce:     latestValset.Nonce,
+		LatestValsetHeight:    latestValset.Height,
+		PowerDiff:             powerDiff,
+		PowerDiffThreshold:    params.ValsetPowerDiffThreshold,
+		LatestValsetAgeBlocks: ageBlocks,
+		LatestValsetAgeTime:   ageTime,
+		Expired:               k.IsValsetExpired(ctx, *latestValset, params),
+	}, nil
+}
+
+// BridgeStatus queries a summary of the bridge health, see GetBridgeStatus
+func (k Keeper) BridgeStatus(
+	c context.Context,
+	req *types.QueryBridgeStatusRequest,
+) (*types.QueryBridgeStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBridgeStatusResponse{Status: k.GetBridgeStatus(ctx)}, nil
+}
+
+// BridgeReserves queries the amounts held by the bridge of every bridged token, see GetBridgeReserves
+func (k Keeper) BridgeReserves(
+	c context.Context,
+	req *types.QueryBridgeReservesRequest,
+) (*types.QueryBridgeReservesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBridgeReservesResponse{Reserves: k.GetBridgeReserves(ctx)}, nil
+}
+
+// EthereumBlockTime queries the Ethereum block time estimate and the block time used to project timeout heights
+func (k Keeper) EthereumBlockTime(
+	c context.Context,
+	req *types.QueryEthereumBlockTimeRequest,
+) (*types.QueryEthereumBlockTimeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryEthereumBlockTimeResponse{
+		Estimate:          k.GetEthereumBlockTimeEstimate(ctx),
+		EthereumBlockTime: k.GetEthereumBlockTime(ctx),
+	}, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 )
 
@@ -98,6 +99,94 @@ func TestQueryGetAttestations(t *testing.T) {
 			nonces:    []uint64{},
 			expectErr: false,
 		},
+		{
+			name: "filter by observed",
+			req: &types.QueryAttestationsRequest{
+				Observed: "true",
+			},
+			numResult: 5,
+			nonces:    []uint64{2, 4, 6, 8, 10},
+			expectErr: false,
+		},
+		{
+			name: "filter by not observed and min height",
+			req: &types.QueryAttestationsRequest{
+				Observed:  "false",
+				MinHeight: 2,
+			},
+			numResult: 2,
+			nonces:    []uint64{7, 9},
+			expectErr: false,
+		},
+		{
+			name: "filter by height range",
+			req: &types.QueryAttestationsRequest{
+				MinHeight: 1,
+				MaxHeight: 1,
+			},
+			numResult: 5,
+			nonces:    []uint64{1, 2, 3, 4, 5},
+			expectErr: false,
+		},
+		{
+			name: "filter by voter",
+			req: &types.QueryAttestationsRequest{
+				Voter: keeper.ValAddrs[0].String(),
+			},
+			numResult: 3,
+			nonces:    []uint64{1, 2, 3},
+			expectErr: false,
+		},
+		{
+			name: "filter by nonce and voter",
+			req: &types.QueryAttestationsRequest{
+				Nonce: 7,
+				Voter: keeper.ValAddrs[0].String(),
+			},
+			numResult: 0,
+			nonces:    []uint64{},
+			expectErr: false,
+		},
+		{
+			name: "pagination",
+			req: &types.QueryAttestationsRequest{
+				Pagination: &query.PageRequest{Offset: 2, Limit: 3},
+			},
+			numResult: 3,
+			nonces:    []uint64{3, 4, 5},
+			expectErr: false,
+		},
+		{
+			name: "pagination descending",
+			req: &types.QueryAttestationsRequest{
+				Pagination: &query.PageRequest{Limit: 2, Reverse: true},
+			},
+			numResult: 2,
+			nonces:    []uint64{10, 9},
+			expectErr: false,
+		},
+		{
+			name: "invalid observed filter",
+			req: &types.QueryAttestationsRequest{
+				Observed: "foo",
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid voter",
+			req: &types.QueryAttestationsRequest{
+				Voter: "foo",
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid height range",
+			req: &types.QueryAttestationsRequest{
+				MinHeight: 2,
+				MaxHeight: 1,
+			},
+			expectErr: true,
+		},
 	}
 
 	for i, tc := range testCases {
@@ -130,7 +219,7 @@ func createAttestations(t *testing.T, k keeper.Keeper, ctx sdk.Context, length i
 		nonce := uint64(1 + i)
 		msg := types.MsgSendToCosmosClaim{
 			EventNonce:     nonce,
-			BlockHeight:    1,
+			BlockHeight:    uint64(1 + i/5),
 			TokenContract:  "0x00000000000000000001",
 			Amount:         sdk.NewInt(10000000000 + int64(i)),
 			EthereumSender: "0x00000000000000000002",
@@ -141,8 +230,13 @@ func createAttestations(t *testing.T, k keeper.Keeper, ctx sdk.Context, length i
 		any, err := codectypes.NewAnyWithValue(&msg)
 		require.NoError(t, err)
 
+		votes := []string{}
+		if nonce <= 3 {
+			votes = append(votes, keeper.ValAddrs[0].String())
+		}
 		att := &types.Attestation{
-			Observed: false,
+			Observed: nonce%2 == 0,
+			Votes:    votes,
 			Height:   uint64(ctx.BlockHeight()),
 			Claim:    any,
 		}
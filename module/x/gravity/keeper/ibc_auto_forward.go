@@ -112,6 +112,7 @@ func (k Keeper) addPendingIbcAutoForward(ctx sdk.Context, forward types.PendingI
 		)
 	}
 	store.Set(key, k.cdc.MustMarshal(&forward))
+	incrIbcAutoForwardsQueuedCounter(ctx)
 
 	k.logger(ctx).Info("SendToCosmos Pending IBC Auto-Forward", "ibcReceiver", forward.ForeignReceiver,
 		"token", token, "denom", forward.Token.Denom, "amount", forward.Token.Amount.String(),
@@ -128,6 +129,13 @@ func (k Keeper) addPendingIbcAutoForward(ctx sdk.Context, forward types.PendingI
 	})
 }
 
+// setPendingIbcAutoForward stores a pending IBC Auto-Forward without validating or logging it, only to be used when
+// restoring the queue from genesis
+func (k Keeper) setPendingIbcAutoForward(ctx sdk.Context, forward types.PendingIbcAutoForward) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingIbcAutoForwardKey(forward.EventNonce), k.cdc.MustMarshal(&forward))
+}
+
 // deletePendingIbcAutoForward removes a single pending IBC Auto-Forward send to an IBC-enabled chain from the store
 // WARNING: this should only be called while clearing the queue in ClearNextPendingIbcAutoForward
 func (k Keeper) deletePendingIbcAutoForward(ctx sdk.Context, eventNonce uint64) error {
@@ -206,6 +214,7 @@ func (k Keeper) ProcessNextPendingIbcAutoForward(ctx sdk.Context) (stop bool, er
 	ctx = sdk.UnwrapSDKContext(wCtx)
 
 	// Log + emit event
+	incrIbcAutoForwardsExecutedCounter(ctx, recoverableErr == nil)
 	if recoverableErr == nil {
 		k.logEmitIbcForwardSuccessEvent(ctx, *forward, msgTransfer)
 	} else { // Funds have already been sent to the fallback user, emit a failure log
@@ -1,25 +1,46 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
+	"math/big"
 
-	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
-// TODO: Add any future invariants here
-// TODO: (see the sdk docs for more info https://docs.cosmos.network/master/building-modules/invariants.html)
+// RegisterInvariants registers all of the gravity module's invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "outgoing-tx-index", OutgoingTxIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "event-nonces", EventNonceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "batch-checkpoints", BatchCheckpointInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "erc20-denom-mapping", ERC20DenomMappingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "bridge-liabilities", BridgeLiabilitiesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "observed-attestations", ObservedAttestationsInvariant(k))
+}
+
+// AllInvariants runs all of the gravity module's invariants, stopping at the first broken one
+// (see the sdk docs for more info https://docs.cosmos.network/master/building-modules/invariants.html)
 func AllInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return ModuleBalanceInvariant(k)(ctx)
-
-		// Example additional invariants
-		//  res, stop := FutureInvariant(k)(ctx)
-		//	if stop {
-		//		return res, stop
-		//	}
-		//
-		//	return AnotherFutureInvariant(k)(ctx)
+		invariants := []sdk.Invariant{
+			ModuleBalanceInvariant(k),
+			OutgoingTxIndexInvariant(k),
+			EventNonceInvariant(k),
+			BatchCheckpointInvariant(k),
+			ERC20DenomMappingInvariant(k),
+			BridgeLiabilitiesInvariant(k),
+			ObservedAttestationsInvariant(k),
+		}
+		for _, invariant := range invariants {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
 	}
 }
 
@@ -123,3 +144,210 @@ func sumPendingIbcAutoForwards(ctx sdk.Context, k Keeper, expectedBals map[strin
 
 	return expectedBals
 }
+
+// OutgoingTxIndexInvariant checks that every unbatched tx is stored under the fee index key it would be looked up by,
+// and that no tx id is in use more than once across the pool and all batches
+func OutgoingTxIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			res    string
+			broken bool
+		)
+		seen := make(map[uint64]string)
+
+		k.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
+			if tx.Erc20Token.Contract != tx.Erc20Fee.Contract {
+				res, broken = fmt.Sprintf("unbatched tx %d pays fees in a different token than it sends", tx.Id), true
+				return true
+			}
+			if !bytes.Equal(key, types.GetOutgoingTxPoolKey(*tx.Erc20Fee, tx.Id)) {
+				res, broken = fmt.Sprintf("unbatched tx %d is not stored under its fee index", tx.Id), true
+				return true
+			}
+			if _, found := seen[tx.Id]; found {
+				res, broken = fmt.Sprintf("unbatched tx %d is in the pool more than once", tx.Id), true
+				return true
+			}
+			seen[tx.Id] = "the pool"
+			return false
+		})
+		if broken {
+			return res, broken
+		}
+
+		k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+			location := fmt.Sprintf("batch %s %d", batch.TokenContract.GetAddress().Hex(), batch.BatchNonce)
+			for _, tx := range batch.Transactions {
+				if other, found := seen[tx.Id]; found {
+					res, broken = fmt.Sprintf("tx %d is in both %s and %s", tx.Id, other, location), true
+					return true
+				}
+				seen[tx.Id] = location
+			}
+			return false
+		})
+		return res, broken
+	}
+}
+
+// EventNonceInvariant checks that the last observed event nonce was claimed by at least one validator, an event
+// can only be observed once enough validators have claimed it
+func EventNonceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		lastObserved := k.GetLastObservedEventNonce(ctx)
+		if lastObserved == 0 {
+			return "", false
+		}
+
+		var maxValidatorNonce uint64
+		k.IterateLastEventNonceByValidator(ctx, func(_ sdk.ValAddress, nonce uint64) bool {
+			if nonce > maxValidatorNonce {
+				maxValidatorNonce = nonce
+			}
+			return false
+		})
+		if lastObserved > maxValidatorNonce {
+			return fmt.Sprintf(
+				"last observed event nonce %d exceeds the highest event nonce claimed by any validator %d",
+				lastObserved, maxValidatorNonce,
+			), true
+		}
+		return "", false
+	}
+}
+
+// BatchCheckpointInvariant checks that the checkpoint of every stored batch is in the past checkpoint archive,
+// otherwise the validators signing it could be slashed for signing it. Batches whose checkpoint may have been
+// pruned from the archive are skipped, evidence against them is rejected
+func BatchCheckpointInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			res    string
+			broken bool
+		)
+		k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+			prunedNonce := k.GetPrunedCheckpointNonce(ctx, types.CHECKPOINT_TYPE_BATCH, batch.TokenContract.GetAddress().Bytes())
+			if batch.BatchNonce > prunedNonce && !k.GetPastEthSignatureCheckpoint(ctx, k.GetBatchCheckpoint(ctx, batch)) {
+				res, broken = fmt.Sprintf(
+					"checkpoint of batch %s %d is missing from the past checkpoints",
+					batch.TokenContract.GetAddress().Hex(), batch.BatchNonce,
+				), true
+				return true
+			}
+			return false
+		})
+		return res, broken
+	}
+}
+
+// ERC20DenomMappingInvariant checks that the cosmos originated denom to ERC20 and ERC20 to denom indexes are
+// inverses of one another
+func ERC20DenomMappingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			res    string
+			broken bool
+		)
+		numErc20s := 0
+		k.IterateERC20ToDenom(ctx, func(_ []byte, erc20ToDenom *types.ERC20ToDenom) bool {
+			numErc20s++
+			erc20, found := k.GetCosmosOriginatedERC20(ctx, erc20ToDenom.Denom)
+			if !found || erc20.GetAddress().Hex() != erc20ToDenom.Erc20 {
+				res, broken = fmt.Sprintf(
+					"ERC20 %s maps to denom %s which does not map back to it", erc20ToDenom.Erc20, erc20ToDenom.Denom,
+				), true
+				return true
+			}
+			return false
+		})
+		if broken {
+			return res, broken
+		}
+
+		// Every denom mapping back to an ERC20 above, having the same number of entries makes them a bijection
+		numDenoms := 0
+		iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomToERC20Key).Iterator(nil, nil)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			numDenoms++
+		}
+		if numDenoms != numErc20s {
+			return fmt.Sprintf("%d cosmos originated denoms are mapped to ERC20s but %d ERC20s to denoms", numDenoms, numErc20s), true
+		}
+		return "", false
+	}
+}
+
+// BridgeLiabilitiesInvariant checks that the bridge owes exactly what its lifetime totals account for of every token:
+// the voucher supply of an Ethereum originated token must equal its opening balance plus the deposits minus the
+// withdrawals, the amount of a cosmos originated token in circulation on Ethereum (locked in the module without
+// being pending) must equal its opening balance plus the withdrawals minus the deposits. The voucher supply must also
+// be representable on Ethereum. Tokens whose totals have saturated can no longer be checked exactly and are skipped
+func BridgeLiabilitiesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		maxSupply := sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)))
+		for _, r := range k.GetBridgeReserves(ctx) {
+			if r.VoucherSupply.GT(maxSupply) {
+				return fmt.Sprintf("voucher %s supply %s exceeds the max supply of an ERC20", r.Denom, r.VoucherSupply), true
+			}
+			if isSaturatedTotal(r.Totals.Deposited) || isSaturatedTotal(r.Totals.Withdrawn) {
+				continue
+			}
+			outstanding := outstandingBalance(r)
+			expected := expectedOutstandingBalance(r.Totals, r.CosmosOriginated)
+			if !outstanding.Equal(expected) {
+				return fmt.Sprintf(
+					"%s outstanding %s does not match the bridge totals %s (opening %s, deposited %s, withdrawn %s)",
+					r.Denom, outstanding, expected, r.Totals.OpeningOutstanding, r.Totals.Deposited, r.Totals.Withdrawn,
+				), true
+			}
+		}
+		return "", false
+	}
+}
+
+// ObservedAttestationsInvariant checks that every event nonce up to the last observed one still in the store has an
+// observed attestation, other attestations at these nonces lost the vote and are only waiting to be pruned. Nonces
+// more than AttestationEventsToKeep below the last observed one may be partly pruned already and are skipped
+func ObservedAttestationsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			res      string
+			broken   bool
+			nonce    uint64
+			observed bool
+		)
+		lastObserved := k.GetLastObservedEventNonce(ctx)
+		var fromNonce uint64
+		if lastObserved > AttestationEventsToKeep {
+			fromNonce = lastObserved - AttestationEventsToKeep
+		}
+		checkNonce := func() {
+			if nonce != 0 && !observed {
+				res, broken = fmt.Sprintf(
+					"no attestation at event nonce %d is observed but the last observed event nonce is %d", nonce, lastObserved,
+				), true
+			}
+		}
+
+		// All attestations at a nonce are iterated consecutively
+		k.IterateAttestationsFromNonce(ctx, fromNonce, func(attNonce uint64, att types.Attestation) bool {
+			if attNonce > lastObserved {
+				return true
+			}
+			if attNonce != nonce {
+				checkNonce()
+				if broken {
+					return true
+				}
+				nonce, observed = attNonce, false
+			}
+			observed = observed || att.Observed
+			return false
+		})
+		if !broken {
+			checkNonce()
+		}
+		return res, broken
+	}
+}
@@ -29,7 +29,7 @@ func TestModuleBalanceUnbatchedTxs(t *testing.T) {
 	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
 	require.NoError(t, err)
 	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
-	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
+	err = MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers)
 	require.NoError(t, err)
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
@@ -100,7 +100,7 @@ func TestModuleBalanceBatchedTxs(t *testing.T) {
 
 	// mint some voucher first
 	for _, v := range allVouchers {
-		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, v))
+		require.NoError(t, MintDepositedVouchers(ctx, input.GravityKeeper, v))
 		// set senders balance
 		input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, v))
@@ -186,3 +186,128 @@ func checkImbalancedModule(t *testing.T, ctx sdk.Context, gravityKeeper Keeper,
 	// Rebalance the module
 	bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins)
 }
+
+// Tests that each of the store consistency invariants holds on a consistent store and detects a corruption of it
+func TestStoreConsistencyInvariants(t *testing.T) {
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, _       = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		cosmosTokenAddr, _  = types.NewEthAddress("0xF815240800ddf3E0be80e0d848B13ecaa504BF37")
+		cosmosDenom         = "ufoo"
+		voucherAmount       = sdk.NewInt(1000)
+		invariantViolated   = func(t *testing.T, invariant sdk.Invariant, ctx sdk.Context) {
+			res, broken := invariant(ctx)
+			require.True(t, broken)
+			require.NotEmpty(t, res)
+		}
+		invariantHolds = func(t *testing.T, invariant sdk.Invariant, ctx sdk.Context) {
+			res, broken := invariant(ctx)
+			require.False(t, broken, res)
+		}
+	)
+
+	// setup creates pending transfers of both an ethereum originated and a cosmos originated token and
+	// batches some of them
+	setup := func(t *testing.T) (TestInput, sdk.Context, *types.InternalOutgoingTxBatch) {
+		input := CreateTestEnv(t)
+		ctx := input.Context
+		k := input.GravityKeeper
+		k.setCosmosOriginatedDenomToERC20(ctx, cosmosDenom, *cosmosTokenAddr)
+
+		voucher, err := types.NewInternalERC20Token(voucherAmount, myTokenContractAddr)
+		require.NoError(t, err)
+		coins := sdk.NewCoins(voucher.GravityCoin(), sdk.NewCoin(cosmosDenom, voucherAmount))
+		require.NoError(t, MintDepositedVouchers(ctx, input.GravityKeeper, sdk.NewCoins(voucher.GravityCoin())))
+		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(cosmosDenom, voucherAmount))))
+		input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, coins))
+
+		for i := 0; i < 4; i++ {
+			amount := sdk.NewCoin(voucher.GravityCoin().Denom, sdk.NewInt(int64(100+i)))
+			_, err := k.AddToOutgoingPool(ctx, mySender, *myReceiver, amount, sdk.NewCoin(amount.Denom, sdk.NewInt(int64(i+1))))
+			require.NoError(t, err)
+		}
+		_, err = k.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewCoin(cosmosDenom, sdk.NewInt(100)), sdk.NewCoin(cosmosDenom, sdk.NewInt(1)))
+		require.NoError(t, err)
+
+		batch, err := k.BuildOutgoingTXBatch(ctx, voucher.Contract, 2)
+		require.NoError(t, err)
+		input.AssertInvariants()
+		return input, ctx, batch
+	}
+
+	t.Run("outgoing tx index", func(t *testing.T) {
+		input, ctx, batch := setup(t)
+		invariant := OutgoingTxIndexInvariant(input.GravityKeeper)
+		invariantHolds(t, invariant, ctx)
+		// put a batched tx back into the pool without removing it from the batch
+		require.NoError(t, input.GravityKeeper.addUnbatchedTX(ctx, batch.Transactions[0]))
+		invariantViolated(t, invariant, ctx)
+	})
+
+	t.Run("event nonces", func(t *testing.T) {
+		input, ctx, _ := setup(t)
+		invariant := EventNonceInvariant(input.GravityKeeper)
+		input.GravityKeeper.SetLastEventNonceByValidator(ctx, ValAddrs[0], 5)
+		input.GravityKeeper.setLastObservedEventNonce(ctx, 5)
+		invariantHolds(t, invariant, ctx)
+		input.GravityKeeper.setLastObservedEventNonce(ctx, 6)
+		invariantViolated(t, invariant, ctx)
+	})
+
+	t.Run("batch checkpoints", func(t *testing.T) {
+		input, ctx, batch := setup(t)
+		invariant := BatchCheckpointInvariant(input.GravityKeeper)
+		invariantHolds(t, invariant, ctx)
+		checkpoint := batch.GetCheckpoint(input.GravityKeeper.GetGravityID(ctx))
+		ctx.KVStore(input.GravityStoreKey).Delete(types.GetPastEthSignatureCheckpointKey(checkpoint))
+		invariantViolated(t, invariant, ctx)
+	})
+
+	t.Run("erc20 denom mapping", func(t *testing.T) {
+		input, ctx, _ := setup(t)
+		invariant := ERC20DenomMappingInvariant(input.GravityKeeper)
+		invariantHolds(t, invariant, ctx)
+		ctx.KVStore(input.GravityStoreKey).Delete(types.GetERC20ToDenomKey(*cosmosTokenAddr))
+		invariantViolated(t, invariant, ctx)
+	})
+
+	t.Run("bridge liabilities", func(t *testing.T) {
+		input, ctx, _ := setup(t)
+		invariant := BridgeLiabilitiesInvariant(input.GravityKeeper)
+		invariantHolds(t, invariant, ctx)
+		// release the locked cosmos originated tokens while the transfer is still pending
+		locked := sdk.NewCoins(sdk.NewCoin(cosmosDenom, sdk.NewInt(101)))
+		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, locked))
+		invariantViolated(t, invariant, ctx)
+
+		// vouchers minted without a deposit are not backed by the Gravity contract
+		input, ctx, _ = setup(t)
+		invariant = BridgeLiabilitiesInvariant(input.GravityKeeper)
+		voucher, err := types.NewInternalERC20Token(sdk.NewInt(1), myTokenContractAddr)
+		require.NoError(t, err)
+		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(voucher.GravityCoin())))
+		invariantViolated(t, invariant, ctx)
+	})
+
+	t.Run("observed attestations", func(t *testing.T) {
+		input, ctx, _ := setup(t)
+		k := input.GravityKeeper
+		invariant := ObservedAttestationsInvariant(k)
+		_, _, hashes := createAttestations(t, 2, k, ctx)
+		for i, hash := range hashes {
+			nonce := uint64(i + 1)
+			att := k.GetAttestation(ctx, nonce, hash)
+			att.Observed = nonce == 1
+			k.SetAttestation(ctx, nonce, hash, att)
+		}
+		k.setLastObservedEventNonce(ctx, 1)
+		invariantHolds(t, invariant, ctx)
+		k.setLastObservedEventNonce(ctx, 2)
+		invariantViolated(t, invariant, ctx)
+		// nonce 2 is below the pruning cutoff now, its attestations may be partly pruned
+		k.setLastObservedEventNonce(ctx, 3+AttestationEventsToKeep)
+		invariantHolds(t, invariant, ctx)
+	})
+}
@@ -34,6 +34,7 @@ var _ types.DistributionKeeper = (*distrkeeper.Keeper)(nil)
 type Keeper struct {
 	// NOTE: If you add anything to this struct, add a nil check to ValidateMembers below!
 	storeKey   sdk.StoreKey // Unexposed key to access store from sdk.Context
+	tStoreKey  sdk.StoreKey // Unexposed key to access the transient store, which caches checkpoints per block
 	paramSpace paramtypes.Subspace
 
 	// NOTE: If you add anything to this struct, add a nil check to ValidateMembers below!
@@ -79,6 +80,7 @@ func (k Keeper) ValidateMembers() {
 // NewKeeper returns a new instance of the gravity keeper
 func NewKeeper(
 	storeKey sdk.StoreKey,
+	tStoreKey sdk.StoreKey,
 	paramSpace paramtypes.Subspace,
 	cdc codec.BinaryCodec,
 	bankKeeper *bankkeeper.BaseKeeper,
@@ -96,6 +98,7 @@ func NewKeeper(
 
 	k := Keeper{
 		storeKey:   storeKey,
+		tStoreKey:  tStoreKey,
 		paramSpace: paramSpace,
 
 		cdc:                cdc,
@@ -123,11 +126,21 @@ func NewKeeper(
 
 // SendToCommunityPool handles incorrect SendToCosmos calls to the community pool, since the calls
 // have already been made on Ethereum there's nothing we can do to reverse them, and we should at least
-// make use of the tokens which would otherwise be lost
+// make use of the tokens which would otherwise be lost. The coins are counted in the community pool total of their
+// token, so they must all be bridged tokens
 func (k Keeper) SendToCommunityPool(ctx sdk.Context, coins sdk.Coins) error {
 	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distrtypes.ModuleName, coins); err != nil {
 		return sdkerrors.Wrap(err, "transfer to community pool failed")
 	}
+	for _, coin := range coins {
+		_, tokenContract, err := k.DenomToERC20Lookup(ctx, coin.Denom)
+		if err != nil {
+			return sdkerrors.Wrap(err, "community pool transfer of a token which is not bridged")
+		}
+		k.updateBridgeTotals(ctx, *tokenContract, func(totals *types.BridgeTotals) {
+			totals.CommunityPool = addToTotal(totals.CommunityPool, coin.Amount)
+		})
+	}
 	feePool := k.DistKeeper.GetFeePool(ctx)
 	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(coins...)...)
 	k.DistKeeper.SetFeePool(ctx, feePool)
@@ -330,15 +343,18 @@ func (k Keeper) GetLastSlashedLogicCallBlock(ctx sdk.Context) uint64 {
 	return types.UInt64FromBytes(bytes)
 }
 
-// GetUnSlashedLogicCalls returns all the unslashed logic calls in state
-func (k Keeper) GetUnSlashedLogicCalls(ctx sdk.Context, maxHeight uint64) (out []types.OutgoingLogicCall) {
+// GetUnSlashedLogicCalls returns the unslashed logic calls in block order, at most limit of them unless more logic
+// calls share the block of the last one. The logic calls of a block can not be split between two calls since the
+// last slashed logic call block is where the next call continues
+func (k Keeper) GetUnSlashedLogicCalls(ctx sdk.Context, maxHeight uint64, limit uint64) (out []types.OutgoingLogicCall) {
 	lastSlashedLogicCallBlock := k.GetLastSlashedLogicCallBlock(ctx)
-	calls := k.GetOutgoingLogicCalls(ctx)
-	for _, call := range calls {
-		if call.Block > lastSlashedLogicCallBlock {
-			out = append(out, call)
+	k.IterateOutgoingLogicCallsByBlock(ctx, lastSlashedLogicCallBlock+1, func(call types.OutgoingLogicCall) bool {
+		if uint64(len(out)) >= limit && call.Block != out[len(out)-1].Block {
+			return true
 		}
-	}
+		out = append(out, call)
+		return false
+	})
 	return
 }
 
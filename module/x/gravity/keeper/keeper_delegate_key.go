@@ -6,6 +6,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -28,13 +29,16 @@ func (k Keeper) SetOrchestratorValidator(ctx sdk.Context, val sdk.ValAddress, or
 	store.Set(types.GetOrchestratorAddressKey(orch), val.Bytes())
 }
 
-// GetOrchestratorValidator returns the validator key associated with an orchestrator key
+// GetOrchestratorValidator returns the validator key associated with an orchestrator key, orchestrator keys
+// retired by a delegate key rotation are not associated with their validator any more
 func (k Keeper) GetOrchestratorValidator(ctx sdk.Context, orch sdk.AccAddress) (validator stakingtypes.Validator, found bool) {
-	valAddr, foundValAddr := k.GetOrchestratorValidatorAddr(ctx, orch)
 	if err := sdk.VerifyAddressFormat(orch); err != nil {
 		ctx.Logger().Error("invalid orch address")
 		return validator, false
 	}
+	store := ctx.KVStore(k.storeKey)
+	valAddr := sdk.ValAddress(store.Get(types.GetOrchestratorAddressKey(orch)))
+	foundValAddr := valAddr != nil
 
 	if !foundValAddr && valAddr == nil {
 		return stakingtypes.Validator{
@@ -112,7 +116,8 @@ func (k Keeper) GetOrchestratorValidator(ctx sdk.Context, orch sdk.AccAddress) (
 // GetOrchestratorValidatorAddr returns the validator address associated with an orchestrator key.
 // Getting a result from this function means that the validator existed at some point and sent a SetOrchestratorAddress
 // message. It does not mean that the validator is in the current validator set, for that use GetOrchestratorValidator.
-// This will hold true as long as we never delete any delegate keys.
+// Orchestrator keys retired by a delegate key rotation are still found, so that the confirms they submitted can be
+// attributed to their validator. This will hold true as long as we never delete any delegate keys.
 func (k Keeper) GetOrchestratorValidatorAddr(ctx sdk.Context, orch sdk.AccAddress) (validator sdk.ValAddress, found bool) {
 	if err := sdk.VerifyAddressFormat(orch); err != nil {
 		ctx.Logger().Error("invalid orch address")
@@ -120,26 +125,106 @@ func (k Keeper) GetOrchestratorValidatorAddr(ctx sdk.Context, orch sdk.AccAddres
 	}
 	store := ctx.KVStore(k.storeKey)
 	valAddr := store.Get([]byte(types.GetOrchestratorAddressKey(orch)))
+	if valAddr == nil {
+		valAddr = store.Get(types.GetRetiredOrchestratorAddressKey(orch))
+	}
 	if valAddr == nil {
 		return sdk.ValAddress{}, false
 	}
 	return valAddr, true
 }
 
+// GetOrchestratorByValidator returns the current orchestrator key of a validator
+func (k Keeper) GetOrchestratorByValidator(ctx sdk.Context, validator sdk.ValAddress) (orch sdk.AccAddress, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyOrchestratorAddress)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if validator.Equals(sdk.ValAddress(iter.Value())) {
+			return sdk.AccAddress(iter.Key()), true
+		}
+	}
+	return nil, false
+}
+
+// IsOrchestratorRegistered returns true if the orchestrator key is or has ever been the orchestrator of a validator,
+// such a key can not be registered for a validator again
+func (k Keeper) IsOrchestratorRegistered(ctx sdk.Context, orch sdk.AccAddress) bool {
+	_, found := k.GetOrchestratorValidatorAddr(ctx, orch)
+	return found
+}
+
 /////////////////////////////
 //       ETH ADDRESS       //
 /////////////////////////////
 
-// SetEthAddress sets the ethereum address for a given validator
+// SetEthAddress sets the ethereum address for a given validator, ending the validity of the address it replaces
 func (k Keeper) SetEthAddressForValidator(ctx sdk.Context, validator sdk.ValAddress, ethAddr types.EthAddress) {
 	if err := sdk.VerifyAddressFormat(validator); err != nil {
 		panic(sdkerrors.Wrap(err, "invalid validator address"))
 	}
+	height := uint64(ctx.BlockHeight())
+	if previous, found := k.GetEthAddressByValidator(ctx, validator); found && *previous != ethAddr {
+		if validity, found := k.GetEthAddressValidity(ctx, *previous); found && validity.EndHeight == 0 {
+			validity.EndHeight = height
+			k.SetEthAddressValidity(ctx, *validity)
+		}
+	}
+	if _, found := k.GetEthAddressValidity(ctx, ethAddr); !found {
+		k.SetEthAddressValidity(ctx, types.EthAddressValidity{
+			EthAddress:  ethAddr.GetAddress().Hex(),
+			Validator:   validator.String(),
+			StartHeight: height,
+			EndHeight:   0,
+		})
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetEthAddressByValidatorKey(validator), ethAddr.GetAddress().Bytes())
 	store.Set(types.GetValidatorByEthAddressKey(ethAddr), []byte(validator))
 }
 
+// SetEthAddressValidity stores the validator and validity height range of an eth address
+func (k Keeper) SetEthAddressValidity(ctx sdk.Context, validity types.EthAddressValidity) {
+	ethAddr, err := types.NewEthAddress(validity.EthAddress)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid eth address"))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEthAddressValidityKey(*ethAddr), k.cdc.MustMarshal(&validity))
+}
+
+// GetEthAddressValidity returns the validator and validity height range of an eth address, which is found for
+// every eth address that has ever been registered by a validator
+func (k Keeper) GetEthAddressValidity(ctx sdk.Context, ethAddr types.EthAddress) (*types.EthAddressValidity, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEthAddressValidityKey(ethAddr))
+	if bz == nil {
+		return nil, false
+	}
+	var validity types.EthAddressValidity
+	k.cdc.MustUnmarshal(bz, &validity)
+	return &validity, true
+}
+
+// GetEthAddressValidities returns the validity of every eth address ever registered, ordered by eth address
+func (k Keeper) GetEthAddressValidities(ctx sdk.Context) []types.EthAddressValidity {
+	validities := []types.EthAddressValidity{}
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.EthAddressValidityKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var validity types.EthAddressValidity
+		k.cdc.MustUnmarshal(iter.Value(), &validity)
+		validities = append(validities, validity)
+	}
+	return validities
+}
+
 // GetEthAddressByValidator returns the eth address for a given gravity validator
 func (k Keeper) GetEthAddressByValidator(ctx sdk.Context, validator sdk.ValAddress) (ethAddress *types.EthAddress, found bool) {
 	if err := sdk.VerifyAddressFormat(validator); err != nil {
@@ -158,6 +243,29 @@ func (k Keeper) GetEthAddressByValidator(ctx sdk.Context, validator sdk.ValAddre
 	return addr, true
 }
 
+// IsEthAddressRegistered returns true if the eth address is or has ever been the eth address of a validator,
+// such an address can not be registered for a validator again
+func (k Keeper) IsEthAddressRegistered(ctx sdk.Context, ethAddr types.EthAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorByEthAddressKey(ethAddr))
+}
+
+// GetEthAddressByValidatorAtHeight returns the eth address a validator used at the given block height, which is its
+// current eth address unless it has rotated its delegate keys since
+func (k Keeper) GetEthAddressByValidatorAtHeight(ctx sdk.Context, validator sdk.ValAddress, height uint64) (ethAddress *types.EthAddress, found bool) {
+	k.IterateDelegateKeyRotationsByValidator(ctx, validator, func(rotation types.DelegateKeyRotation) (stop bool) {
+		if rotation.Height > height {
+			ethAddress, _ = types.NewEthAddress(rotation.PreviousEthAddress)
+			return true
+		}
+		return false
+	})
+	if ethAddress != nil {
+		return ethAddress, true
+	}
+	return k.GetEthAddressByValidator(ctx, validator)
+}
+
 // GetValidatorByEthAddress returns the validator for a given eth address
 func (k Keeper) GetValidatorByEthAddress(ctx sdk.Context, ethAddr types.EthAddress) (validator stakingtypes.Validator, found bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -234,3 +342,104 @@ func (k Keeper) GetValidatorByEthAddress(ctx sdk.Context, ethAddr types.EthAddre
 
 	return validator, true
 }
+
+/////////////////////////////
+//   DELEGATE KEY NONCE    //
+/////////////////////////////
+
+// GetDelegateKeyNonce returns the number of times a validator has set its delegate keys, this is the
+// nonce its eth key has to sign over to prove possession of the key the next time they are set
+func (k Keeper) GetDelegateKeyNonce(ctx sdk.Context, validator sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.GetDelegateKeyNonceKey(validator))
+	if len(bytes) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bytes)
+}
+
+// SetDelegateKeyNonce sets the delegate key nonce of a validator
+func (k Keeper) SetDelegateKeyNonce(ctx sdk.Context, validator sdk.ValAddress, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDelegateKeyNonceKey(validator), types.UInt64Bytes(nonce))
+}
+
+// IterateDelegateKeyNonces iterates through the delegate key nonce of every validator which has one
+func (k Keeper) IterateDelegateKeyNonces(ctx sdk.Context, cb func(validator sdk.ValAddress, nonce uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.DelegateKeyNonceKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(sdk.ValAddress(iter.Key()), types.UInt64FromBytes(iter.Value())) {
+			break
+		}
+	}
+}
+
+/////////////////////////////
+//  DELEGATE KEY ROTATION  //
+/////////////////////////////
+
+// SetDelegateKeyRotation stores the record of a delegate key rotation, the previous orchestrator of the validator is
+// retired unless it is still its current orchestrator and the previous eth address stays associated with the validator
+func (k Keeper) SetDelegateKeyRotation(ctx sdk.Context, rotation types.DelegateKeyRotation) {
+	val, err := sdk.ValAddressFromBech32(rotation.Validator)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	orch, err := sdk.AccAddressFromBech32(rotation.PreviousOrchestrator)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid orch address"))
+	}
+	ethAddr, err := types.NewEthAddress(rotation.PreviousEthAddress)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid eth address"))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDelegateKeyRotationKey(val, rotation.Height), k.cdc.MustMarshal(&rotation))
+	if !store.Has(types.GetOrchestratorAddressKey(orch)) {
+		store.Set(types.GetRetiredOrchestratorAddressKey(orch), val.Bytes())
+	}
+	store.Set(types.GetValidatorByEthAddressKey(*ethAddr), val.Bytes())
+}
+
+// HasDelegateKeyRotation returns true if the validator rotated its delegate keys at height, a validator may only rotate
+// its keys once per block since the rotations are keyed by height
+func (k Keeper) HasDelegateKeyRotation(ctx sdk.Context, validator sdk.ValAddress, height uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetDelegateKeyRotationKey(validator, height))
+}
+
+// IterateDelegateKeyRotationsByValidator iterates through the delegate key rotations of a validator in ascending
+// order of height
+func (k Keeper) IterateDelegateKeyRotationsByValidator(ctx sdk.Context, validator sdk.ValAddress, cb func(rotation types.DelegateKeyRotation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.GetDelegateKeyRotationValidatorPrefix(validator))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var rotation types.DelegateKeyRotation
+		k.cdc.MustUnmarshal(iter.Value(), &rotation)
+		if cb(rotation) {
+			break
+		}
+	}
+}
+
+// GetDelegateKeyRotations returns every delegate key rotation ordered by validator and height
+func (k Keeper) GetDelegateKeyRotations(ctx sdk.Context) []types.DelegateKeyRotation {
+	rotations := []types.DelegateKeyRotation{}
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.DelegateKeyRotationKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var rotation types.DelegateKeyRotation
+		k.cdc.MustUnmarshal(iter.Value(), &rotation)
+		rotations = append(rotations, rotation)
+	}
+	return rotations
+}
@@ -37,19 +37,52 @@ func (k Keeper) SetOutgoingLogicCall(ctx sdk.Context, call types.OutgoingLogicCa
 	store := ctx.KVStore(k.storeKey)
 
 	// Store checkpoint to prove that this logic call actually happened
-	checkpoint := call.GetCheckpoint(k.GetGravityID(ctx))
-	k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
+	k.SetPastEthSignatureCheckpoint(ctx, types.PastEthSignatureCheckpoint{
+		Checkpoint:     k.GetLogicCallCheckpoint(ctx, call),
+		Height:         uint64(ctx.BlockHeight()),
+		Type:           types.CHECKPOINT_TYPE_LOGIC_CALL,
+		Nonce:          call.InvalidationNonce,
+		InvalidationId: call.InvalidationId,
+		TokenContract:  "",
+	})
 	key := types.GetOutgoingLogicCallKey(call.InvalidationId, call.InvalidationNonce)
 	if store.Has(key) {
 		panic("Can not overwrite logic call")
 	}
 	store.Set(key,
 		k.cdc.MustMarshal(&call))
+	store.Set(types.GetOutgoingLogicCallBlockKey(call.Block, call.InvalidationId, call.InvalidationNonce), key)
 }
 
 // DeleteOutgoingLogicCall deletes outgoing logic calls
 func (k Keeper) DeleteOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) {
-	ctx.KVStore(k.storeKey).Delete(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce))
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce)
+	bz := store.Get(key)
+	if bz == nil {
+		return
+	}
+	var call types.OutgoingLogicCall
+	k.cdc.MustUnmarshal(bz, &call)
+	store.Delete(key)
+	store.Delete(types.GetOutgoingLogicCallBlockKey(call.Block, invalidationID, invalidationNonce))
+}
+
+// IterateOutgoingLogicCallsByBlock iterates through the logic calls created in fromBlock or later, in ascending
+// block order, using the block index so that the iteration can stop early
+func (k Keeper) IterateOutgoingLogicCallsByBlock(ctx sdk.Context, fromBlock uint64, cb func(call types.OutgoingLogicCall) bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.OutgoingLogicCallBlockKey)
+	iter := prefixStore.Iterator(types.UInt64Bytes(fromBlock), nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var call types.OutgoingLogicCall
+		k.cdc.MustUnmarshal(store.Get(iter.Value()), &call)
+		// cb returns true to stop early
+		if cb(call) {
+			break
+		}
+	}
 }
 
 // IterateOutgoingLogicCalls iterates over outgoing logic calls
@@ -3,9 +3,11 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -192,6 +194,19 @@ func TestDelegateKeys(t *testing.T) {
 		assert.Equal(t, ethAddrs[i], res.EthAddress)
 	}
 
+	// the paginated query returns the same keys, ordered by orchestrator address
+	var paginated []types.MsgSetOrchestratorAddress
+	pageReq := &query.PageRequest{Limit: 3}
+	for {
+		res, err := k.DelegateKeys(sdk.WrapSDKContext(ctx), &types.QueryDelegateKeysRequest{Pagination: pageReq})
+		require.NoError(t, err)
+		paginated = append(paginated, res.DelegateKeys...)
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3}
+	}
+	assert.ElementsMatch(t, addresses, paginated)
 }
 
 //nolint: exhaustivestruct
@@ -221,7 +236,7 @@ func TestLastSlashedValsetNonce(t *testing.T) {
 	//  lastSlashedValsetNonce should be zero initially.
 	lastSlashedValsetNonce := k.GetLastSlashedValsetNonce(ctx)
 	assert.Equal(t, lastSlashedValsetNonce, uint64(0))
-	unslashedValsets := k.GetUnSlashedValsets(ctx, uint64(12))
+	unslashedValsets := k.GetUnSlashedValsets(ctx, uint64(12), math.MaxUint64)
 	assert.Equal(t, len(unslashedValsets), 9)
 
 	// check if last Slashed Valset nonce is set properly or not
@@ -232,20 +247,20 @@ func TestLastSlashedValsetNonce(t *testing.T) {
 	lastSlashedValset := k.GetValset(ctx, lastSlashedValsetNonce)
 
 	// when valset height + signedValsetsWindow > current block height, len(unslashedValsets) should be zero
-	unslashedValsets = k.GetUnSlashedValsets(ctx, uint64(ctx.BlockHeight()))
+	unslashedValsets = k.GetUnSlashedValsets(ctx, uint64(ctx.BlockHeight()), math.MaxUint64)
 	assert.Equal(t, len(unslashedValsets), 0)
 
 	// when lastSlashedValset height + signedValsetsWindow == BlockHeight, len(unslashedValsets) should be zero
 	heightDiff := uint64(ctx.BlockHeight()) - lastSlashedValset.Height
-	unslashedValsets = k.GetUnSlashedValsets(ctx, heightDiff)
+	unslashedValsets = k.GetUnSlashedValsets(ctx, heightDiff, math.MaxUint64)
 	assert.Equal(t, len(unslashedValsets), 0)
 
 	// when signedValsetsWindow is between lastSlashedValset height and latest valset's height
-	unslashedValsets = k.GetUnSlashedValsets(ctx, heightDiff-2)
+	unslashedValsets = k.GetUnSlashedValsets(ctx, heightDiff-2, math.MaxUint64)
 	assert.Equal(t, len(unslashedValsets), 2)
 
 	// when signedValsetsWindow > latest valset's height
-	unslashedValsets = k.GetUnSlashedValsets(ctx, heightDiff-6)
+	unslashedValsets = k.GetUnSlashedValsets(ctx, heightDiff-6, math.MaxUint64)
 	assert.Equal(t, len(unslashedValsets), 6)
 	fmt.Println("unslashedValsetsRange", unslashedValsets)
 }
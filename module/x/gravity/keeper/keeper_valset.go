@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,13 +32,20 @@ func (k Keeper) SetValsetRequest(ctx sdk.Context) types.Valset {
 	}
 	k.StoreValset(ctx, valset)
 	k.SetLatestValsetNonce(ctx, valset.Nonce)
+	k.SetLatestValsetTime(ctx, ctx.BlockTime())
 
 	// Store the checkpoint as a legit past valset, this is only for evidence
 	// based slashing. We are storing the checkpoint that will be signed with
 	// the validators Ethereum keys so that we know not to slash them if someone
 	// attempts to submit the signature of this validator set as evidence of bad behavior
-	checkpoint := valset.GetCheckpoint(k.GetGravityID(ctx))
-	k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
+	k.SetPastEthSignatureCheckpoint(ctx, types.PastEthSignatureCheckpoint{
+		Checkpoint:     k.GetValsetCheckpoint(ctx, valset),
+		Height:         uint64(ctx.BlockHeight()),
+		Type:           types.CHECKPOINT_TYPE_VALSET,
+		Nonce:          valset.Nonce,
+		InvalidationId: nil,
+		TokenContract:  "",
+	})
 
 	ctx.EventManager().EmitTypedEvent(
 		&types.EventMultisigUpdateRequest{
@@ -157,6 +165,74 @@ func (k Keeper) GetLatestValset(ctx sdk.Context) (out *types.Valset) {
 	return
 }
 
+// SetLatestValsetTime sets the block time at which the latest valset request was created
+func (k Keeper) SetLatestValsetTime(ctx sdk.Context, blockTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LatestValsetTime, sdk.FormatTimeBytes(blockTime))
+}
+
+// GetLatestValsetTime returns the block time at which the latest valset request was created,
+// returns the zero time if it has not been set
+func (k Keeper) GetLatestValsetTime(ctx sdk.Context) time.Time {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.LatestValsetTime)
+
+	if len(bytes) == 0 {
+		return time.Time{}
+	}
+	blockTime, err := sdk.ParseTimeBytes(bytes)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid latest valset time"))
+	}
+	return blockTime
+}
+
+// GetValsetPowerDiff returns the fraction of the total bridge power which has changed between the
+// current validator set and the provided valset, see InternalBridgeValidators.PowerDiff
+func (k Keeper) GetValsetPowerDiff(ctx sdk.Context, valset types.Valset) (float64, error) {
+	vs, err := k.GetCurrentValset(ctx)
+	if err != nil {
+		return 0, err
+	}
+	intCurrMembers, err := types.BridgeValidators(vs.Members).ToInternal()
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "invalid current valset members")
+	}
+	intValsetMembers, err := types.BridgeValidators(valset.Members).ToInternal()
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "invalid valset members")
+	}
+	return intCurrMembers.PowerDiff(*intValsetMembers), nil
+}
+
+// GetValsetAge returns the age of the given valset in blocks, and the age of the latest valset request
+// in milliseconds. The time based age is zero when the latest valset request time is unknown, which is
+// the case for valsets created before the time was recorded
+func (k Keeper) GetValsetAge(ctx sdk.Context, valset types.Valset) (blocks uint64, millis uint64) {
+	currentHeight := uint64(ctx.BlockHeight())
+	if currentHeight > valset.Height {
+		blocks = currentHeight - valset.Height
+	}
+	latestTime := k.GetLatestValsetTime(ctx)
+	if valset.Nonce == k.GetLatestValsetNonce(ctx) && !latestTime.IsZero() && ctx.BlockTime().After(latestTime) {
+		millis = uint64(ctx.BlockTime().Sub(latestTime).Milliseconds())
+	}
+	return blocks, millis
+}
+
+// IsValsetExpired returns true if the given valset is older than either the MaxValsetAgeBlocks or
+// MaxValsetAgeTime params, a param value of zero disables that limit
+func (k Keeper) IsValsetExpired(ctx sdk.Context, valset types.Valset, params types.Params) bool {
+	blocks, millis := k.GetValsetAge(ctx, valset)
+	if params.MaxValsetAgeBlocks != 0 && blocks >= params.MaxValsetAgeBlocks {
+		return true
+	}
+	if params.MaxValsetAgeTime != 0 && millis >= params.MaxValsetAgeTime {
+		return true
+	}
+	return false
+}
+
 // setLastSlashedValsetNonce sets the latest slashed valset nonce
 func (k Keeper) SetLastSlashedValsetNonce(ctx sdk.Context, nonce uint64) {
 	store := ctx.KVStore(k.storeKey)
@@ -174,15 +250,13 @@ func (k Keeper) GetLastSlashedValsetNonce(ctx sdk.Context) uint64 {
 	return types.UInt64FromBytes(bytes)
 }
 
-// SetLastUnBondingBlockHeight sets the last unbonding block height. Note this value is not saved and loaded in genesis
-// and is reset to zero on chain upgrade.
+// SetLastUnBondingBlockHeight sets the last unbonding block height
 func (k Keeper) SetLastUnBondingBlockHeight(ctx sdk.Context, unbondingBlockHeight uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.LastUnBondingBlockHeight, types.UInt64Bytes(unbondingBlockHeight))
 }
 
-// GetLastUnBondingBlockHeight returns the last unbonding block height, returns zero if not set, this is not
-// saved or loaded ing enesis and is reset to zero on chain upgrade
+// GetLastUnBondingBlockHeight returns the last unbonding block height, returns zero if not set
 func (k Keeper) GetLastUnBondingBlockHeight(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
 	bytes := store.Get(types.LastUnBondingBlockHeight)
@@ -193,14 +267,22 @@ func (k Keeper) GetLastUnBondingBlockHeight(ctx sdk.Context) uint64 {
 	return types.UInt64FromBytes(bytes)
 }
 
-// GetUnSlashedValsets returns all the "ready-to-slash" unslashed validator sets in state (valsets at least signedValsetsWindow blocks old)
-func (k Keeper) GetUnSlashedValsets(ctx sdk.Context, signedValsetsWindow uint64) (out []*types.Valset) {
+// GetUnSlashedValsets returns at most limit of the "ready-to-slash" unslashed validator sets in state (valsets at least
+// signedValsetsWindow blocks old) in nonce order
+func (k Keeper) GetUnSlashedValsets(ctx sdk.Context, signedValsetsWindow uint64, limit uint64) (out []*types.Valset) {
 	lastSlashedValsetNonce := k.GetLastSlashedValsetNonce(ctx)
 	blockHeight := uint64(ctx.BlockHeight())
 	k.IterateValsetBySlashedValsetNonce(ctx, lastSlashedValsetNonce, func(_ []byte, valset *types.Valset) bool {
+		if uint64(len(out)) >= limit {
+			return true
+		}
 		// Implicitly the unslashed valsets appear after the last slashed valset,
 		// however not all valsets are ready-to-slash since validators have a window
-		if valset.Nonce > lastSlashedValsetNonce && !(blockHeight < valset.Height+signedValsetsWindow) {
+		if blockHeight < valset.Height+signedValsetsWindow {
+			// valsets are created in nonce order, no later valset is ready either
+			return true
+		}
+		if valset.Nonce > lastSlashedValsetNonce {
 			out = append(out, valset)
 		}
 		return false
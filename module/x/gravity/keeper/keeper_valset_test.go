@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	_ "github.com/Gravity-Bridge/Gravity-Bridge/module/config"
@@ -62,7 +63,7 @@ func TestValsets(t *testing.T) {
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 100)
 
 	// verify that only valsets with higher nonce than LastSlashedValsetNonce are returned by GetUnSlashedValsets()
-	unslashedValsets := k.GetUnSlashedValsets(ctx, 10)
+	unslashedValsets := k.GetUnSlashedValsets(ctx, 10, math.MaxUint64)
 	for _, vs := range unslashedValsets {
 		require.Greater(t, vs.Nonce, uint64(LastSlashedNonce),
 			fmt.Sprintf("got valset with nonce: %d, but expected only valsets with nonce higher than %d", vs.Nonce, LastSlashedNonce))
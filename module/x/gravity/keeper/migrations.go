@@ -2,6 +2,7 @@ package keeper
 
 import (
 	v2 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v2"
+	v3 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v3"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -20,3 +21,16 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	ctx.Logger().Info("Mercury Upgrade: Enter Migrate1to2()")
 	return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from consensus version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	ctx.Logger().Info("v3 Upgrade: Enter Migrate2to3()")
+	if err := v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.paramSpace, m.keeper.cdc); err != nil {
+		return err
+	}
+	// the pool stats and pending attestation counts are kept from v3 on, they start from what is in the store
+	m.keeper.recountPoolStats(ctx)
+	m.keeper.recountPendingAttestations(ctx)
+	// the bridge totals are counted from v3 on, what the bridge owed before is their opening balance
+	return m.keeper.recordOpeningOutstandingBalances(ctx)
+}
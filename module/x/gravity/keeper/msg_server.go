@@ -51,21 +51,25 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 		return nil, sdkerrors.Wrap(types.ErrResetDelegateKeys, val.String())
 	}
 
-	// check that neither key is a duplicate
-	delegateKeys := k.GetDelegateKeys(ctx)
-	for i := range delegateKeys {
-		if delegateKeys[i].EthAddress == addr.GetAddress().Hex() {
-			return nil, sdkerrors.Wrap(err, "Duplicate Ethereum Key")
-		}
-		if delegateKeys[i].Orchestrator == orch.String() {
-			return nil, sdkerrors.Wrap(err, "Duplicate Orchestrator Key")
-		}
+	// check that neither key is a duplicate, including keys retired by a rotation
+	if k.IsEthAddressRegistered(ctx, *addr) {
+		return nil, sdkerrors.Wrap(types.ErrDuplicate, "Ethereum Key")
+	}
+	if k.IsOrchestratorRegistered(ctx, orch) {
+		return nil, sdkerrors.Wrap(types.ErrDuplicate, "Orchestrator Key")
+	}
+
+	// check that the validator controls the ethereum key
+	nonce := k.GetDelegateKeyNonce(ctx, val)
+	if err := k.verifyDelegateKeysSignature(ctx, val, orch, *addr, nonce, msg.EthSignature); err != nil {
+		return nil, err
 	}
 
 	// set the orchestrator address
 	k.SetOrchestratorValidator(ctx, val, orch)
 	// set the ethereum address
 	k.SetEthAddressForValidator(ctx, val, *addr)
+	k.SetDelegateKeyNonce(ctx, val, nonce+1)
 
 	ctx.EventManager().EmitTypedEvent(
 		&types.EventSetOperatorAddress{
@@ -78,6 +82,110 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 
 }
 
+// RotateDelegateKeys handles MsgRotateDelegateKeys, replacing the orchestrator and/or eth address of a validator
+// which has already set its delegate keys. The previous keys are kept so that the confirms they made can still be
+// attributed and checked, and a new valset is requested to send the new eth address to Ethereum
+func (k msgServer) RotateDelegateKeys(c context.Context, msg *types.MsgRotateDelegateKeys) (*types.MsgRotateDelegateKeysResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Key not valid")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check the following, all should be validated in validate basic
+	val, e1 := sdk.ValAddressFromBech32(msg.Validator)
+	orch, e2 := sdk.AccAddressFromBech32(msg.Orchestrator)
+	addr, e3 := types.NewEthAddress(msg.EthAddress)
+	if e1 != nil || e2 != nil || e3 != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "Key not valid")
+	}
+
+	validator := k.Keeper.StakingKeeper.Validator(ctx, val)
+	if validator == nil {
+		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, val.String())
+	}
+
+	// the validator must have keys to rotate
+	previousOrch, foundOrch := k.GetOrchestratorByValidator(ctx, val)
+	previousAddr, foundAddr := k.GetEthAddressByValidator(ctx, val)
+	if !foundOrch || !foundAddr {
+		return nil, sdkerrors.Wrapf(types.ErrEmpty, "no delegate keys set for validator %s", val.String())
+	}
+
+	orchChanged := !orch.Equals(previousOrch)
+	addrChanged := *addr != *previousAddr
+	if !orchChanged && !addrChanged {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "delegate keys are unchanged")
+	}
+	// a second rotation in the same block would overwrite the record of the first one
+	if k.HasDelegateKeyRotation(ctx, val, uint64(ctx.BlockHeight())) {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicate, "delegate keys already rotated at height %d", ctx.BlockHeight())
+	}
+	// a key can not be taken over from another validator or be reused once retired
+	if orchChanged && k.IsOrchestratorRegistered(ctx, orch) {
+		return nil, sdkerrors.Wrap(types.ErrDuplicate, "Orchestrator Key")
+	}
+	if addrChanged && k.IsEthAddressRegistered(ctx, *addr) {
+		return nil, sdkerrors.Wrap(types.ErrDuplicate, "Ethereum Key")
+	}
+
+	// check that the validator controls the new ethereum key
+	nonce := k.GetDelegateKeyNonce(ctx, val)
+	if err := k.verifyDelegateKeysSignature(ctx, val, orch, *addr, nonce, msg.EthSignature); err != nil {
+		return nil, err
+	}
+
+	if orchChanged {
+		ctx.KVStore(k.storeKey).Delete(types.GetOrchestratorAddressKey(previousOrch))
+		k.SetOrchestratorValidator(ctx, val, orch)
+	}
+	if addrChanged {
+		k.SetEthAddressForValidator(ctx, val, *addr)
+	}
+	k.SetDelegateKeyRotation(ctx, types.DelegateKeyRotation{
+		Validator:            val.String(),
+		PreviousOrchestrator: previousOrch.String(),
+		PreviousEthAddress:   previousAddr.GetAddress().Hex(),
+		Height:               uint64(ctx.BlockHeight()),
+	})
+	k.SetDelegateKeyNonce(ctx, val, nonce+1)
+
+	// the Gravity contract only learns about the new eth address through a valset update
+	if addrChanged && validator.IsBonded() {
+		k.SetValsetRequest(ctx)
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventRotateDelegateKeys{
+			Message:      msg.Type(),
+			Validator:    val.String(),
+			Orchestrator: orch.String(),
+			EthAddress:   addr.GetAddress().Hex(),
+		},
+	)
+
+	return &types.MsgRotateDelegateKeysResponse{}, nil
+}
+
+// verifyDelegateKeysSignature checks that ethSignature is a signature of ethAddr over the delegate
+// keys sign hash of the validator, proving that the validator controls the ethereum key
+func (k msgServer) verifyDelegateKeysSignature(
+	ctx sdk.Context, val sdk.ValAddress, orch sdk.AccAddress, ethAddr types.EthAddress, nonce uint64, ethSignature string,
+) error {
+	sigBytes, err := hex.DecodeString(ethSignature)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, "signature decoding")
+	}
+	hash := types.GetDelegateKeysSignHash(k.GetGravityID(ctx), val, orch, nonce)
+	if err := types.ValidateEthereumSignature(hash, sigBytes, ethAddr); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf(
+			"signature verification failed expected sig by %s with gravity-id %s with delegate key nonce %d, err %s",
+			ethAddr.GetAddress().Hex(), k.GetGravityID(ctx), nonce, err.Error()))
+	}
+	return nil
+}
+
 // ValsetConfirm handles MsgValsetConfirm
 func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm) (*types.MsgValsetConfirmResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -86,13 +194,12 @@ func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm)
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "couldn't find valset")
 	}
 
-	gravityID := k.GetGravityID(ctx)
-	checkpoint := valset.GetCheckpoint(gravityID)
+	checkpoint := k.GetValsetCheckpoint(ctx, *valset)
 	orchaddr, err := sdk.AccAddressFromBech32(msg.Orchestrator)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "acc address invalid")
 	}
-	err = k.confirmHandlerCommon(ctx, msg.EthAddress, orchaddr, msg.Signature, checkpoint)
+	err = k.confirmHandlerCommon(ctx, msg.EthAddress, orchaddr, msg.Signature, checkpoint, valset.Height)
 	if err != nil {
 		return nil, err
 	}
@@ -192,14 +299,13 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "couldn't find batch")
 	}
 
-	gravityID := k.GetGravityID(ctx)
-	checkpoint := batch.GetCheckpoint(gravityID)
+	checkpoint := k.GetBatchCheckpoint(ctx, *batch)
 	orchaddr, err := sdk.AccAddressFromBech32(msg.Orchestrator)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "acc address invalid")
 	}
 
-	err = k.confirmHandlerCommon(ctx, msg.EthSigner, orchaddr, msg.Signature, checkpoint)
+	err = k.confirmHandlerCommon(ctx, msg.EthSigner, orchaddr, msg.Signature, checkpoint, batch.Block)
 	if err != nil {
 		return nil, err
 	}
@@ -234,13 +340,12 @@ func (k msgServer) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogi
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "couldn't find logic")
 	}
 
-	gravityID := k.GetGravityID(ctx)
-	checkpoint := logic.GetCheckpoint(gravityID)
+	checkpoint := k.GetLogicCallCheckpoint(ctx, *logic)
 	orchaddr, err := sdk.AccAddressFromBech32(msg.Orchestrator)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "acc address invalid")
 	}
-	err = k.confirmHandlerCommon(ctx, msg.EthSigner, orchaddr, msg.Signature, checkpoint)
+	err = k.confirmHandlerCommon(ctx, msg.EthSigner, orchaddr, msg.Signature, checkpoint, logic.Block)
 	if err != nil {
 		return nil, err
 	}
@@ -301,8 +406,10 @@ func (k msgServer) claimHandlerCommon(ctx sdk.Context, msgAny *codectypes.Any, m
 	return nil
 }
 
-// confirmHandlerCommon is an internal function that provides common code for processing claim messages
-func (k msgServer) confirmHandlerCommon(ctx sdk.Context, ethAddress string, orchestrator sdk.AccAddress, signature string, checkpoint []byte) error {
+// confirmHandlerCommon is an internal function that provides common code for processing claim messages, height is
+// the block height the signed valset, batch or logic call was created at. Confirms for something created before a
+// delegate key rotation may be signed by either the eth address of the validator at that height or its current one
+func (k msgServer) confirmHandlerCommon(ctx sdk.Context, ethAddress string, orchestrator sdk.AccAddress, signature string, checkpoint []byte, height uint64) error {
 	sigBytes, err := hex.DecodeString(signature)
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrInvalid, "signature decoding")
@@ -333,7 +440,11 @@ func (k msgServer) confirmHandlerCommon(ctx sdk.Context, ethAddress string, orch
 	}
 
 	if *ethAddressFromStore != *submittedEthAddress {
-		return sdkerrors.Wrap(types.ErrInvalid, "submitted eth address does not match delegate eth address")
+		ethAddressAtHeight, found := k.GetEthAddressByValidatorAtHeight(ctx, validator.GetOperator(), height)
+		if !found || *ethAddressAtHeight != *submittedEthAddress {
+			return sdkerrors.Wrap(types.ErrInvalid, "submitted eth address does not match delegate eth address")
+		}
+		ethAddressFromStore = ethAddressAtHeight
 	}
 
 	err = types.ValidateEthereumSignature(checkpoint, sigBytes, *ethAddressFromStore)
@@ -43,7 +43,7 @@ func TestConfirmHandlerCommon(t *testing.T) {
 	require.NoError(t, err)
 
 	sv := msgServer{input.GravityKeeper}
-	err = sv.confirmHandlerCommon(input.Context, ethAddress.GetAddress().Hex(), AccAddrs[0], hex.EncodeToString(ethSignature), checkpoint)
+	err = sv.confirmHandlerCommon(input.Context, ethAddress.GetAddress().Hex(), AccAddrs[0], hex.EncodeToString(ethSignature), checkpoint, batch.Block)
 	assert.Nil(t, err)
 }
 func confirmHandlerCommonWithAddress(t *testing.T, address string, testVar testInitStruct) error {
@@ -70,7 +70,7 @@ func confirmHandlerCommonWithAddress(t *testing.T, address string, testVar testI
 
 	sv := msgServer{input.GravityKeeper}
 
-	err = sv.confirmHandlerCommon(input.Context, address, AccAddrs[0], hex.EncodeToString(ethSignature), checkpoint)
+	err = sv.confirmHandlerCommon(input.Context, address, AccAddrs[0], hex.EncodeToString(ethSignature), checkpoint, batch.Block)
 
 	return err
 }
@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strconv"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -43,6 +44,7 @@ func (k Keeper) AddToOutgoingPool(
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, totalInVouchers); err != nil {
 		return 0, err
 	}
+	incrTokenCounter(ctx, MetricKeyTokensLocked, totalInVouchers)
 
 	// get next tx id from keeper
 	nextID := k.autoIncrementID(ctx, types.KeyLastTXPoolID)
@@ -150,6 +152,37 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 	return nil
 }
 
+// RemoveFromOutgoingPoolToCommunityPool
+// - checks that the provided tx actually exists
+// - deletes the unbatched tx from the pool
+// - sends its amount and fee to the community pool
+// It is used by governance to seize the pending transfers of sanctioned accounts
+func (k Keeper) RemoveFromOutgoingPoolToCommunityPool(ctx sdk.Context, txId uint64) error {
+	tx, err := k.GetUnbatchedTxById(ctx, txId)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "unknown transaction with id %d", txId)
+	}
+	if err := k.removeUnbatchedTX(ctx, *tx.Erc20Fee, txId); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalid, "txId %d not in unbatched index! Must be in a batch!", txId)
+	}
+
+	_, denom := k.ERC20ToDenomLookup(ctx, tx.Erc20Token.Contract)
+	total := sdk.NewCoins(sdk.NewCoin(denom, tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount)))
+	if err := k.SendToCommunityPool(ctx, total); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventWithdrawSeized{
+			Sender:         tx.Sender.String(),
+			TxId:           fmt.Sprint(txId),
+			BridgeContract: k.GetBridgeContractAddress(ctx).GetAddress().Hex(),
+			BridgeChainId:  strconv.Itoa(int(k.GetBridgeChainID(ctx))),
+		},
+	)
+	return nil
+}
+
 // addUnbatchedTx creates a new transaction in the pool
 // WARNING: Do not make this function public
 func (k Keeper) addUnbatchedTX(ctx sdk.Context, val *types.InternalOutgoingTransferTx) error {
@@ -167,6 +200,7 @@ func (k Keeper) addUnbatchedTX(ctx sdk.Context, val *types.InternalOutgoingTrans
 	}
 
 	store.Set(idxKey, bz)
+	k.updatePoolStats(ctx, *val.Erc20Fee, true)
 	return err
 }
 
@@ -179,9 +213,86 @@ func (k Keeper) removeUnbatchedTX(ctx sdk.Context, fee types.InternalERC20Token,
 		return sdkerrors.Wrap(types.ErrUnknown, "pool transaction")
 	}
 	store.Delete(idxKey)
+	k.updatePoolStats(ctx, fee, false)
 	return nil
 }
 
+// GetPoolStats returns the number of transactions and their total fees in the pool of a token
+func (k Keeper) GetPoolStats(ctx sdk.Context, tokenContract types.EthAddress) types.PoolStats {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPoolStatsKey(tokenContract))
+	if bz == nil {
+		return types.PoolStats{TxCount: 0, TotalFees: sdk.ZeroInt()}
+	}
+	var stats types.PoolStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+// updatePoolStats counts a transaction with the given fee as added to or removed from the pool and updates the pool
+// gauges of the token, the stats of a token are kept once its pool is empty so that the gauges keep reporting zero
+func (k Keeper) updatePoolStats(ctx sdk.Context, fee types.InternalERC20Token, added bool) {
+	stats := k.GetPoolStats(ctx, fee.Contract)
+	if added {
+		stats.TxCount++
+		stats.TotalFees = stats.TotalFees.Add(fee.Amount)
+	} else {
+		if stats.TxCount == 0 || stats.TotalFees.LT(fee.Amount) {
+			panic(fmt.Sprintf("pool stats of %s do not cover the removed transaction", fee.Contract.GetAddress().Hex()))
+		}
+		stats.TxCount--
+		stats.TotalFees = stats.TotalFees.Sub(fee.Amount)
+	}
+	// an emptied pool keeps no stats, as a pool imported from genesis would
+	if stats.TxCount == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.GetPoolStatsKey(fee.Contract))
+	} else {
+		ctx.KVStore(k.storeKey).Set(types.GetPoolStatsKey(fee.Contract), k.cdc.MustMarshal(&stats))
+	}
+	setPoolGauges(ctx, fee.Contract, stats)
+}
+
+// IteratePoolStats iterates through the pool stats of every token which has transactions in its pool
+func (k Keeper) IteratePoolStats(ctx sdk.Context, cb func(tokenContract types.EthAddress, stats types.PoolStats) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PoolStatsKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		tokenContract, err := types.NewEthAddressFromBytes(iter.Key())
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "invalid pool stats token contract"))
+		}
+		var stats types.PoolStats
+		k.cdc.MustUnmarshal(iter.Value(), &stats)
+		// cb returns true to stop early
+		if cb(*tokenContract, stats) {
+			break
+		}
+	}
+}
+
+// recountPoolStats sets the pool stats of every token from the transactions in its pool, this is done once when the
+// stats start to be kept
+func (k Keeper) recountPoolStats(ctx sdk.Context) {
+	stats := make(map[string]*types.PoolStats)
+	var tokens []types.EthAddress
+	k.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+		token := tx.Erc20Fee.Contract
+		s, ok := stats[token.GetAddress().Hex()]
+		if !ok {
+			s = &types.PoolStats{TxCount: 0, TotalFees: sdk.ZeroInt()}
+			stats[token.GetAddress().Hex()] = s
+			tokens = append(tokens, token)
+		}
+		s.TxCount++
+		s.TotalFees = s.TotalFees.Add(tx.Erc20Fee.Amount)
+		return false
+	})
+	for _, token := range tokens {
+		ctx.KVStore(k.storeKey).Set(types.GetPoolStatsKey(token), k.cdc.MustMarshal(stats[token.GetAddress().Hex()]))
+	}
+}
+
 // GetUnbatchedTxByFeeAndId grabs a tx from the pool given its fee and txID
 func (k Keeper) GetUnbatchedTxByFeeAndId(ctx sdk.Context, fee types.InternalERC20Token, txID uint64) (*types.InternalOutgoingTransferTx, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -31,7 +31,7 @@ func TestAddToOutgoingPool(t *testing.T) {
 	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
 	require.NoError(t, err)
 	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
-	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
+	err = MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers)
 	require.NoError(t, err)
 
 	// set senders balance
@@ -100,6 +100,12 @@ func TestAddToOutgoingPool(t *testing.T) {
 		},
 	}
 	assert.Equal(t, exp, got)
+
+	// the pool stats count every transaction and fee, and recounting the pool finds the same stats
+	expStats := types.PoolStats{TxCount: 4, TotalFees: sdk.NewInt(8)}
+	assert.Equal(t, expStats, input.GravityKeeper.GetPoolStats(ctx, *tokenContract))
+	input.GravityKeeper.recountPoolStats(ctx)
+	assert.Equal(t, expStats, input.GravityKeeper.GetPoolStats(ctx, *tokenContract))
 }
 
 // Checks some common edge cases like invalid inputs, user doesn't have enough tokens, token doesn't exist, inconsistent entry
@@ -131,7 +137,7 @@ func TestAddToOutgoingPoolEdgeCases(t *testing.T) {
 	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
 	require.NoError(t, err)
 	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
-	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
+	err = MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers)
 	require.NoError(t, err)
 
 	// set senders balance
@@ -201,7 +207,7 @@ func TestTotalBatchFeeInPool(t *testing.T) {
 	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
 	require.NoError(t, err)
 	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
-	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
+	err = MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers)
 	require.NoError(t, err)
 
 	// set senders balance
@@ -231,7 +237,7 @@ func TestTotalBatchFeeInPool(t *testing.T) {
 	allVouchersToken, err = types.NewInternalERC20Token(sdk.NewIntFromUint64(uint64(18446744073709551615)), myToken2ContractAddr)
 	require.NoError(t, err)
 	allVouchers = sdk.Coins{allVouchersToken.GravityCoin()}
-	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
+	err = MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers)
 	require.NoError(t, err)
 
 	// set senders balance
@@ -302,11 +308,11 @@ func TestGetBatchFeeByTokenType(t *testing.T) {
 	require.NoError(t, err)
 	allVouchers3 := sdk.Coins{allVouchersToken3.GravityCoin()}
 
-	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers1)
+	err = MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers1)
 	require.NoError(t, err)
-	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers2)
+	err = MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers2)
 	require.NoError(t, err)
-	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers3)
+	err = MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers3)
 	require.NoError(t, err)
 
 	// set senders balance
@@ -403,7 +409,7 @@ func TestRemoveFromOutgoingPoolAndRefund(t *testing.T) {
 	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(originalBal), myTokenContractAddr)
 	require.NoError(t, err)
 	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
-	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
+	err = MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers)
 	require.NoError(t, err)
 
 	// set senders balance
@@ -583,6 +589,11 @@ func TestRefundInconsistentTx(t *testing.T) {
 	require.Error(t, err)
 	newBalances := input.BankKeeper.GetAllBalances(ctx, mySender)
 	require.Equal(t, origBalances, newBalances)
+
+	// The inconsistent tx is caught by the invariants, clean it up before they are asserted
+	_, broken := OutgoingTxIndexInvariant(input.GravityKeeper)(ctx)
+	require.True(t, broken)
+	require.NoError(t, input.GravityKeeper.removeUnbatchedTX(ctx, *badFeeToken, uint64(5)))
 }
 
 func TestRefundNonexistentTx(t *testing.T) {
@@ -622,7 +633,7 @@ func TestRefundTwice(t *testing.T) {
 	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(originalBal), myTokenContractAddr)
 	require.NoError(t, err)
 	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
-	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
+	err = MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers)
 	require.NoError(t, err)
 
 	// set senders balance
@@ -680,13 +691,13 @@ func TestGetUnbatchedTransactions(t *testing.T) {
 	allVouchersToken1, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr1)
 	require.NoError(t, err)
 	allVouchers1 := sdk.Coins{allVouchersToken1.GravityCoin()}
-	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers1)
+	err = MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers1)
 	require.NoError(t, err)
 	allVouchersToken2, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr2)
 	require.NoError(t, err)
 	allVouchers2 := sdk.Coins{allVouchersToken2.GravityCoin()}
 	require.NoError(t, err)
-	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers2)
+	err = MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers2)
 	require.NoError(t, err)
 
 	// set senders balance
@@ -828,13 +839,13 @@ func TestIterateUnbatchedTransactions(t *testing.T) {
 	token1, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr1)
 	require.NoError(t, err)
 	allVouchers1 := sdk.Coins{token1.GravityCoin()}
-	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers1)
+	err = MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers1)
 	require.NoError(t, err)
 
 	token2, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr2)
 	require.NoError(t, err)
 	allVouchers2 := sdk.Coins{token2.GravityCoin()}
-	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers2)
+	err = MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers2)
 	require.NoError(t, err)
 
 	// set senders balance
@@ -945,7 +956,7 @@ func TestAddToOutgoingPoolExportGenesis(t *testing.T) {
 	require.NoError(t, err)
 	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
 
-	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
+	err = MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers)
 	require.NoError(t, err)
 
 	// set senders balance
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -278,10 +279,29 @@ func TestLastValsetRequests(t *testing.T) {
 	valArray := &types.Valsets{val1, val2, val3, val4, val5}
 
 	specs := map[string]struct {
+		req     types.QueryLastValsetRequestsRequest
 		expResp types.QueryLastValsetRequestsResponse
-	}{ // Expect only maxValsetRequestsReturns back
+	}{ // Expect only defaultValsetRequestsReturned back
 		"limit at 5": {
-			expResp: types.QueryLastValsetRequestsResponse{Valsets: *valArray},
+			req: types.QueryLastValsetRequestsRequest{},
+			expResp: types.QueryLastValsetRequestsResponse{
+				Valsets:    *valArray,
+				Pagination: &query.PageResponse{NextKey: types.UInt64Bytes(1)},
+			},
+		},
+		"paginated": {
+			req: types.QueryLastValsetRequestsRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true}},
+			expResp: types.QueryLastValsetRequestsResponse{
+				Valsets:    types.Valsets{val2, val3},
+				Pagination: &query.PageResponse{NextKey: types.UInt64Bytes(3), Total: 6},
+			},
+		},
+		"oldest first": {
+			req: types.QueryLastValsetRequestsRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 2, Reverse: true}},
+			expResp: types.QueryLastValsetRequestsResponse{
+				Valsets:    types.Valsets{val5, val4},
+				Pagination: &query.PageResponse{NextKey: types.UInt64Bytes(4)},
+			},
 		},
 	}
 	// any lower than this and a validator won't be created
@@ -304,7 +324,7 @@ func TestLastValsetRequests(t *testing.T) {
 	k := input.GravityKeeper
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
-			got, err := k.LastValsetRequests(ctx, &types.QueryLastValsetRequestsRequest{})
+			got, err := k.LastValsetRequests(ctx, &spec.req)
 			require.NoError(t, err)
 			assert.Equal(t, &spec.expResp, got)
 		})
@@ -563,7 +583,7 @@ func createTestBatch(t *testing.T, input TestInput, maxTxElements uint) {
 	token, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
 	require.NoError(t, err)
 	allVouchers := sdk.Coins{token.GravityCoin()}
-	err = input.BankKeeper.MintCoins(input.Context, types.ModuleName, allVouchers)
+	err = MintDepositedVouchers(input.Context, input.GravityKeeper, allVouchers)
 	require.NoError(t, err)
 
 	// set senders balance
@@ -921,9 +941,26 @@ func TestLastBatchesRequest(t *testing.T) {
 				TokenContract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 			},
 		},
+		Pagination: &query.PageResponse{Total: 2},
 	}
 
 	assert.Equal(t, &expectedRes, lastBatches, "json is equal")
+
+	// filter by token and paginate
+	filtered, err := k.OutgoingTxBatches(ctx, &types.QueryOutgoingTxBatchesRequest{
+		TokenContract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
+		Pagination:    &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, filtered.Batches, 1)
+	assert.Equal(t, expectedRes.Batches[1], filtered.Batches[0])
+	assert.NotNil(t, filtered.Pagination.NextKey)
+
+	filtered, err = k.OutgoingTxBatches(ctx, &types.QueryOutgoingTxBatchesRequest{
+		TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, filtered.Batches)
 }
 
 //nolint: exhaustivestruct
@@ -970,6 +1007,317 @@ func TestQueryCurrentValset(t *testing.T) {
 	assert.Equal(t, expectedValset, currentValset)
 }
 
+func TestQueryValsetPowerDiff(t *testing.T) {
+	input, _ := SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	sdkCtx := input.Context
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	k := input.GravityKeeper
+
+	// no valset requests yet
+	_, err := k.ValsetPowerDiff(ctx, &types.QueryValsetPowerDiffRequest{})
+	require.Error(t, err)
+
+	// store a valset with 4% of the power moved between two members
+	vs, err := k.GetCurrentValset(sdkCtx)
+	require.NoError(t, err)
+	internalMembers, err := types.BridgeValidators(vs.Members).ToInternal()
+	require.NoError(t, err)
+	delta := internalMembers.TotalPower() / 50
+	vs.Members[0].Power -= delta
+	vs.Members[1].Power += delta
+	k.StoreValset(sdkCtx, vs)
+	k.SetLatestValsetNonce(sdkCtx, vs.Nonce)
+
+	params := k.GetParams(sdkCtx)
+	params.MaxValsetAgeBlocks = 10
+	k.SetParams(sdkCtx, params)
+
+	sdkCtx = sdkCtx.WithBlockHeight(sdkCtx.BlockHeight() + 5)
+	res, err := k.ValsetPowerDiff(sdk.WrapSDKContext(sdkCtx), &types.QueryValsetPowerDiffRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, vs.Nonce, res.LatestValsetNonce)
+	assert.Equal(t, vs.Height, res.LatestValsetHeight)
+	assert.InDelta(t, 0.04, res.PowerDiff, 0.0001)
+	assert.Equal(t, params.ValsetPowerDiffThreshold, res.PowerDiffThreshold)
+	assert.Equal(t, uint64(5), res.LatestValsetAgeBlocks)
+	assert.False(t, res.Expired)
+
+	sdkCtx = sdkCtx.WithBlockHeight(sdkCtx.BlockHeight() + 5)
+	res, err = k.ValsetPowerDiff(sdk.WrapSDKContext(sdkCtx), &types.QueryValsetPowerDiffRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), res.LatestValsetAgeBlocks)
+	assert.True(t, res.Expired)
+}
+
+//nolint: exhaustivestruct
+func TestQueryBridgeStatus(t *testing.T) {
+	input, sdkCtx := SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	k := input.GravityKeeper
+	var (
+		mySender, err1      = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5" // Pickle
+		token, err2         = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
+		allVouchers         = sdk.NewCoins(token.GravityCoin())
+	)
+	require.NoError(t, err1)
+	require.NoError(t, err2)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+
+	// every validator confirms the first valset, only the first three confirm the second
+	vs, err := k.GetCurrentValset(sdkCtx)
+	require.NoError(t, err)
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		vs.Nonce = nonce
+		vs.Height = nonce
+		k.StoreValset(sdkCtx, vs)
+		k.SetLatestValsetNonce(sdkCtx, nonce)
+		for i, orch := range OrchAddrs {
+			if nonce == 2 && i >= 3 {
+				break
+			}
+			k.SetValsetConfirm(sdkCtx, types.MsgValsetConfirm{
+				Nonce:        nonce,
+				Orchestrator: orch.String(),
+				EthAddress:   EthAddrs[i].String(),
+				Signature:    "alksdjhflkasjdfoiasjdfiasjdfoiasdj",
+			})
+		}
+	}
+
+	// the last two validators leave the third valset, which the others confirm
+	vs3 := vs
+	vs3.Nonce, vs3.Height, vs3.Members = 3, 3, nil
+	for _, member := range vs.Members {
+		if member.EthereumAddress != EthAddrs[3].Hex() && member.EthereumAddress != EthAddrs[4].Hex() {
+			vs3.Members = append(vs3.Members, member)
+		}
+	}
+	require.Len(t, vs3.Members, 3)
+	k.StoreValset(sdkCtx, vs3)
+	k.SetLatestValsetNonce(sdkCtx, 3)
+	for i, orch := range OrchAddrs[:3] {
+		k.SetValsetConfirm(sdkCtx, types.MsgValsetConfirm{
+			Nonce:        3,
+			Orchestrator: orch.String(),
+			EthAddress:   EthAddrs[i].String(),
+			Signature:    "alksdjhflkasjdfoiasjdfiasjdfoiasdj",
+		})
+	}
+
+	// batches and logic calls only need the signatures of the valset in force at their block: the first batch
+	// is signed by every member of the first valset, the second and the logic call by every member of the third
+	// valset, the third batch is missing the signatures of the second and third validator
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	for _, b := range []struct {
+		nonce   uint64
+		block   uint64
+		signers int
+	}{{1, 1, 5}, {2, 3, 3}, {3, 4, 1}} {
+		batch, err := types.NewInternalOutgingTxBatch(b.nonce, 1000, nil, *tokenContract, b.block)
+		require.NoError(t, err)
+		k.StoreBatch(sdkCtx, *batch)
+		for i, orch := range OrchAddrs[:b.signers] {
+			k.SetBatchConfirm(sdkCtx, &types.MsgConfirmBatch{
+				Nonce:         b.nonce,
+				TokenContract: myTokenContractAddr,
+				EthSigner:     EthAddrs[i].String(),
+				Orchestrator:  orch.String(),
+				Signature:     "alksdjhflkasjdfoiasjdfiasjdfoiasdj",
+			})
+		}
+	}
+	call := types.OutgoingLogicCall{
+		InvalidationId:    []byte("invalidation_id"),
+		InvalidationNonce: 1,
+		Block:             3,
+	}
+	k.SetOutgoingLogicCall(sdkCtx, call)
+	for i, orch := range OrchAddrs[:3] {
+		k.SetLogicCallConfirm(sdkCtx, &types.MsgConfirmLogicCall{
+			InvalidationId:    hex.EncodeToString(call.InvalidationId),
+			InvalidationNonce: call.InvalidationNonce,
+			EthSigner:         EthAddrs[i].String(),
+			Orchestrator:      orch.String(),
+			Signature:         "alksdjhflkasjdfoiasjdfiasjdfoiasdj",
+		})
+	}
+
+	// the first validator is up to date, the others lag behind
+	k.setLastObservedEventNonce(sdkCtx, 5)
+	k.SetLastEventNonceByValidator(sdkCtx, ValAddrs[0], 5)
+	k.SetLastEventNonceByValidator(sdkCtx, ValAddrs[1], 3)
+
+	// add some TX to the pool
+	require.NoError(t, MintDepositedVouchers(sdkCtx, input.GravityKeeper, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(sdkCtx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(sdkCtx, types.ModuleName, mySender, allVouchers))
+	for i, v := range []uint64{2, 3, 1} {
+		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
+		require.NoError(t, err)
+		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr)
+		require.NoError(t, err)
+		_, err = k.AddToOutgoingPool(sdkCtx, mySender, *receiver, amountToken.GravityCoin(), feeToken.GravityCoin())
+		require.NoError(t, err)
+	}
+
+	res, err := k.BridgeStatus(sdk.WrapSDKContext(sdkCtx), &types.QueryBridgeStatusRequest{})
+	require.NoError(t, err)
+	status := res.Status
+
+	assert.Equal(t, uint64(5), status.LastObservedEventNonce)
+	require.Len(t, status.ValidatorEventNonceLags, len(ValAddrs))
+	lags := make(map[string]types.ValidatorEventNonceLag)
+	for _, lag := range status.ValidatorEventNonceLags {
+		lags[lag.ValidatorAddress] = lag
+	}
+	assert.Equal(t, uint64(0), lags[ValAddrs[0].String()].Lag)
+	assert.Equal(t, OrchAddrs[0].String(), lags[ValAddrs[0].String()].OrchestratorAddress)
+	assert.Equal(t, uint64(2), lags[ValAddrs[1].String()].Lag)
+	// validators that never submitted a claim start one nonce behind the last observed event
+	assert.Equal(t, uint64(1), lags[ValAddrs[4].String()].Lag)
+
+	require.NotNil(t, status.OldestUnsignedValset)
+	assert.Equal(t, uint64(2), status.OldestUnsignedValset.Nonce)
+	assert.ElementsMatch(t, []string{ValAddrs[3].String(), ValAddrs[4].String()}, status.OldestUnsignedValset.MissingValidators)
+	require.NotNil(t, status.OldestUnsignedBatch)
+	assert.Equal(t, uint64(3), status.OldestUnsignedBatch.BatchNonce)
+	assert.ElementsMatch(t, []string{ValAddrs[1].String(), ValAddrs[2].String()}, status.OldestUnsignedBatch.MissingValidators)
+	assert.Nil(t, status.OldestUnsignedLogicCall)
+
+	require.Len(t, status.Pools, 1)
+	assert.Equal(t, types.PoolStatus{
+		TokenContract: myTokenContractAddr,
+		TxCount:       3,
+		TotalAmount:   sdk.NewInt(303),
+		TotalFees:     sdk.NewInt(6),
+		OldestTxId:    1,
+	}, status.Pools[0])
+
+	assert.Equal(t, uint64(0), status.PendingIbcAutoForwards)
+	assert.True(t, status.BridgeActive)
+	assert.Equal(t, k.getBatchTimeoutHeight(sdkCtx), status.BatchTimeoutHeight)
+}
+
+// Tests that the bridge reserves account for every amount held by the bridge, with the lifetime totals counted by the
+// deposit, batch execution and community pool handlers
+//nolint: exhaustivestruct
+func TestQueryBridgeReserves(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	k := input.GravityKeeper
+	ethToken, err := types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+	cosmosToken, err := types.NewEthAddress("0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255")
+	require.NoError(t, err)
+	receiver, err := types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	require.NoError(t, err)
+	k.setCosmosOriginatedDenomToERC20(ctx, "ufoo", *cosmosToken)
+	// the locked tokens were withdrawn before the totals were counted
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("ufoo", 500))))
+	require.NoError(t, k.recordOpeningOutstandingBalances(ctx))
+
+	deposit := func(nonce uint64, token types.EthAddress, amount int64, cosmosReceiver string) {
+		claim := types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			TokenContract:  token.GetAddress().Hex(),
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: receiver.GetAddress().Hex(),
+			CosmosReceiver: cosmosReceiver,
+		}
+		require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+	}
+	deposit(1, *ethToken, 1000, AccAddrs[0].String())
+	// an invalid receiver sends the deposit to the community pool
+	deposit(2, *ethToken, 10, "gravity1invalid")
+	deposit(3, *cosmosToken, 30, AccAddrs[1].String())
+
+	// the first tx is batched and executed, the second stays in the pool and the third is batched
+	for _, amounts := range [][2]int64{{100, 2}, {50, 1}} {
+		_, err := k.AddToOutgoingPool(ctx, AccAddrs[0], *receiver,
+			sdk.NewInt64Coin(types.GravityDenom(*ethToken), amounts[0]), sdk.NewInt64Coin(types.GravityDenom(*ethToken), amounts[1]))
+		require.NoError(t, err)
+	}
+	executed, err := k.BuildOutgoingTXBatch(ctx, *ethToken, 1)
+	require.NoError(t, err)
+	k.OutgoingTxBatchExecuted(ctx, *ethToken, executed.BatchNonce)
+	_, err = k.AddToOutgoingPool(ctx, AccAddrs[0], *receiver,
+		sdk.NewInt64Coin(types.GravityDenom(*ethToken), 20), sdk.NewInt64Coin(types.GravityDenom(*ethToken), 3))
+	require.NoError(t, err)
+	_, err = k.BuildOutgoingTXBatch(ctx, *ethToken, 1)
+	require.NoError(t, err)
+
+	k.setPendingIbcAutoForward(ctx, types.PendingIbcAutoForward{
+		ForeignReceiver: "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
+		Token:           &sdk.Coin{Denom: "ufoo", Amount: sdk.NewInt(40)},
+		IbcChannel:      "channel-0",
+		EventNonce:      4,
+	})
+	// the queued forward was deposited
+	k.updateBridgeTotals(ctx, *cosmosToken, func(totals *types.BridgeTotals) {
+		totals.Deposited = totals.Deposited.AddRaw(40)
+	})
+
+	res, err := k.BridgeReserves(sdk.WrapSDKContext(ctx), &types.QueryBridgeReservesRequest{})
+	require.NoError(t, err)
+	reserves := make(map[string]types.BridgeReserve)
+	for i, r := range res.Reserves {
+		if i > 0 {
+			assert.Less(t, res.Reserves[i-1].TokenContract, r.TokenContract)
+		}
+		reserves[r.TokenContract] = r
+	}
+
+	assert.Equal(t, types.BridgeReserve{
+		TokenContract:          ethToken.GetAddress().Hex(),
+		Denom:                  types.GravityDenom(*ethToken),
+		CosmosOriginated:       false,
+		Locked:                 sdk.ZeroInt(),
+		VoucherSupply:          sdk.NewInt(1000 + 10 - 102),
+		PendingPool:            sdk.NewInt(51),
+		PendingBatches:         sdk.NewInt(23),
+		PendingIbcAutoForwards: sdk.ZeroInt(),
+		Totals: types.BridgeTotals{
+			TokenContract:      ethToken.GetAddress().Hex(),
+			Deposited:          sdk.NewInt(1010),
+			Withdrawn:          sdk.NewInt(102),
+			CommunityPool:      sdk.NewInt(10),
+			OpeningOutstanding: sdk.ZeroInt(),
+		},
+	}, reserves[ethToken.GetAddress().Hex()])
+	assert.Equal(t, types.BridgeReserve{
+		TokenContract:          cosmosToken.GetAddress().Hex(),
+		Denom:                  "ufoo",
+		CosmosOriginated:       true,
+		Locked:                 sdk.NewInt(500 - 30),
+		VoucherSupply:          sdk.ZeroInt(),
+		PendingPool:            sdk.ZeroInt(),
+		PendingBatches:         sdk.ZeroInt(),
+		PendingIbcAutoForwards: sdk.NewInt(40),
+		Totals: types.BridgeTotals{
+			TokenContract:      cosmosToken.GetAddress().Hex(),
+			Deposited:          sdk.NewInt(30 + 40),
+			Withdrawn:          sdk.ZeroInt(),
+			CommunityPool:      sdk.ZeroInt(),
+			OpeningOutstanding: sdk.NewInt(500),
+		},
+	}, reserves[cosmosToken.GetAddress().Hex()])
+
+	// the totals survive a genesis export
+	genesis := ExportGenesis(ctx, k)
+	assert.ElementsMatch(t, []types.BridgeTotals{
+		reserves[ethToken.GetAddress().Hex()].Totals, reserves[cosmosToken.GetAddress().Hex()].Totals,
+	}, genesis.BridgeTotals)
+}
+
 //nolint: exhaustivestruct
 func TestQueryERC20ToDenom(t *testing.T) {
 	var (
@@ -1044,7 +1392,7 @@ func TestQueryPendingSendToEth(t *testing.T) {
 	require.NoError(t, err)
 
 	// mint some voucher first
-	require.NoError(t, input.BankKeeper.MintCoins(sdkCtx, types.ModuleName, allVouchers))
+	require.NoError(t, MintDepositedVouchers(sdkCtx, input.GravityKeeper, allVouchers))
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(sdkCtx, mySender)
 	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(sdkCtx, types.ModuleName, mySender, allVouchers))
@@ -1137,7 +1485,17 @@ func TestQueryPendingSendToEth(t *testing.T) {
 				},
 			},
 		},
+		Pagination: &query.PageResponse{Total: 2},
 	}
 
 	assert.Equal(t, &expectedRes, response, "json is equal")
+
+	// paginate the unbatched transfers, transfers in batches are always returned
+	response, err = k.GetPendingSendToEth(ctx, &types.QueryPendingSendToEth{
+		SenderAddress: mySender.String(),
+		Pagination:    &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, expectedRes.TransfersInBatches, response.TransfersInBatches)
+	assert.Equal(t, expectedRes.UnbatchedTransfers[1:], response.UnbatchedTransfers)
 }
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Telemetry metric names, every metric is emitted under the gravity module name e.g. gravity_batches_created
+const (
+	MetricKeyPoolTxs                 = "pool_txs"
+	MetricKeyPoolFees                = "pool_fees"
+	MetricKeyBatchesCreated          = "batches_created"
+	MetricKeyBatchesExecuted         = "batches_executed"
+	MetricKeyBatchesTimedOut         = "batches_timed_out"
+	MetricKeyAttestationsPending     = "attestations_pending"
+	MetricKeyAttestationsObserved    = "attestations_observed"
+	MetricKeyTokensMinted            = "tokens_minted"
+	MetricKeyTokensLocked            = "tokens_locked"
+	MetricKeyTokensBurned            = "tokens_burned"
+	MetricKeySlashes                 = "slashes"
+	MetricKeyIbcAutoForwardsQueued   = "ibc_auto_forwards_queued"
+	MetricKeyIbcAutoForwardsExecuted = "ibc_auto_forwards_executed"
+	MetricKeyEventNonceLag           = "event_nonce_lag"
+)
+
+// Telemetry label names
+const (
+	MetricLabelTokenContract = "token_contract"
+	MetricLabelDenom         = "denom"
+	MetricLabelClaimType     = "claim_type"
+	MetricLabelReason        = "reason"
+	MetricLabelValidator     = "validator"
+	MetricLabelSuccess       = "success"
+)
+
+// Slashing reasons used as the reason label of the slashes counter
+const (
+	SlashReasonValset          = "valset"
+	SlashReasonUnbondingValset = "unbonding_valset"
+	SlashReasonBatch           = "batch"
+	SlashReasonLogicCall       = "logic_call"
+	SlashReasonBadEthSignature = "bad_eth_signature"
+)
+
+// telemetryEnabled returns false for the CheckTx, ReCheckTx and simulation contexts (a simulation runs on the
+// CheckTx state), so that counters only count state changes which are actually committed
+func telemetryEnabled(ctx sdk.Context) bool {
+	return !ctx.IsCheckTx() && !ctx.IsReCheckTx()
+}
+
+// IncrSlashCounter counts a slashing event for the given reason, see the SlashReason constants
+func IncrSlashCounter(ctx sdk.Context, reason string) {
+	if !telemetryEnabled(ctx) {
+		return
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, MetricKeySlashes},
+		1,
+		[]metrics.Label{telemetry.NewLabel(MetricLabelReason, reason)},
+	)
+}
+
+// IncrTimedOutBatchCounter counts a batch which was cancelled because it passed its timeout on Ethereum
+func IncrTimedOutBatchCounter(ctx sdk.Context, tokenContract types.EthAddress) {
+	incrTokenContractCounter(ctx, MetricKeyBatchesTimedOut, tokenContract)
+}
+
+func incrTokenContractCounter(ctx sdk.Context, key string, tokenContract types.EthAddress) {
+	if !telemetryEnabled(ctx) {
+		return
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, key},
+		1,
+		[]metrics.Label{telemetry.NewLabel(MetricLabelTokenContract, tokenContract.GetAddress().Hex())},
+	)
+}
+
+// incrTokenCounter adds the given coins to the counter with the provided key, labeled by denom
+func incrTokenCounter(ctx sdk.Context, key string, coins sdk.Coins) {
+	if !telemetryEnabled(ctx) {
+		return
+	}
+	for _, coin := range coins {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, key},
+			intToFloat32(coin.Amount),
+			[]metrics.Label{telemetry.NewLabel(MetricLabelDenom, coin.Denom)},
+		)
+	}
+}
+
+func incrAttestationsObservedCounter(ctx sdk.Context, claimType types.ClaimType) {
+	if !telemetryEnabled(ctx) {
+		return
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, MetricKeyAttestationsObserved},
+		1,
+		[]metrics.Label{telemetry.NewLabel(MetricLabelClaimType, claimType.String())},
+	)
+}
+
+func incrIbcAutoForwardsQueuedCounter(ctx sdk.Context) {
+	if !telemetryEnabled(ctx) {
+		return
+	}
+	telemetry.IncrCounter(1, types.ModuleName, MetricKeyIbcAutoForwardsQueued)
+}
+
+func incrIbcAutoForwardsExecutedCounter(ctx sdk.Context, success bool) {
+	if !telemetryEnabled(ctx) {
+		return
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, MetricKeyIbcAutoForwardsExecuted},
+		1,
+		[]metrics.Label{telemetry.NewLabel(MetricLabelSuccess, strconv.FormatBool(success))},
+	)
+}
+
+// intToFloat32 converts token amounts for telemetry, amounts of 18 decimal tokens routinely overflow an int64
+// so the conversion goes through big.Float, the precision loss is acceptable for metrics
+func intToFloat32(i sdk.Int) float32 {
+	f, _ := new(big.Float).SetInt(i.BigInt()).Float32()
+	return f
+}
+
+// setPoolGauges sets the pool size and fees gauges of a token
+func setPoolGauges(ctx sdk.Context, tokenContract types.EthAddress, stats types.PoolStats) {
+	if !telemetryEnabled(ctx) {
+		return
+	}
+	labels := []metrics.Label{telemetry.NewLabel(MetricLabelTokenContract, tokenContract.GetAddress().Hex())}
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, MetricKeyPoolTxs}, float32(stats.TxCount), labels)
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, MetricKeyPoolFees}, intToFloat32(stats.TotalFees), labels)
+}
+
+// setPendingAttestationsGauge sets the number of attestations of a claim type which have not been observed yet
+func setPendingAttestationsGauge(ctx sdk.Context, claimType types.ClaimType, count uint64) {
+	if !telemetryEnabled(ctx) {
+		return
+	}
+	telemetry.SetGaugeWithLabels(
+		[]string{types.ModuleName, MetricKeyAttestationsPending},
+		float32(count),
+		[]metrics.Label{telemetry.NewLabel(MetricLabelClaimType, claimType.String())},
+	)
+}
+
+// setEventNonceLagGauge sets how far the validator's last event nonce is behind the last observed one
+func setEventNonceLagGauge(ctx sdk.Context, val sdk.ValAddress, lag uint64) {
+	if !telemetryEnabled(ctx) {
+		return
+	}
+	telemetry.SetGaugeWithLabels(
+		[]string{types.ModuleName, MetricKeyEventNonceLag},
+		float32(lag),
+		[]metrics.Label{telemetry.NewLabel(MetricLabelValidator, val.String())},
+	)
+}
+
+// UpdateBridgeGauges sets every gauge from the values kept up to date in the store, it is called by the EndBlocker
+// since the gauges set by the handlers of a transaction which fails afterwards are not reverted, and since the event
+// nonce lag of every validator changes when an event is observed. This reads the pool stats and pending attestation
+// counts of every token and claim type and the last event nonce of every bonded validator, the pool and the
+// attestations themselves are not iterated over
+func (k Keeper) UpdateBridgeGauges(ctx sdk.Context) {
+	if !telemetryEnabled(ctx) {
+		return
+	}
+	k.IteratePoolStats(ctx, func(tokenContract types.EthAddress, stats types.PoolStats) bool {
+		setPoolGauges(ctx, tokenContract, stats)
+		return false
+	})
+	for _, claimType := range claimTypes() {
+		setPendingAttestationsGauge(ctx, claimType, k.GetPendingAttestations(ctx, claimType))
+	}
+	lastObservedNonce := k.GetLastObservedEventNonce(ctx)
+	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		_, lag := k.getEventNonceLag(ctx, val.GetOperator(), lastObservedNonce)
+		setEventNonceLagGauge(ctx, val.GetOperator(), lag)
+	}
+}
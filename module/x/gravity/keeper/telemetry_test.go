@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Tests that creating and executing a batch updates the telemetry counters and the pool gauges, and that nothing
+// is counted during CheckTx
+//nolint: exhaustivestruct
+func TestTelemetry(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	defer metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{}) //nolint: errcheck
+
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
+	require.NoError(t, MintDepositedVouchers(ctx, input.GravityKeeper, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	// a send to Ethereum simulated or checked on the CheckTx state is not counted
+	amountToken, err := types.NewInternalERC20Token(sdk.NewInt(1000), myTokenContractAddr)
+	require.NoError(t, err)
+	feeToken, err := types.NewInternalERC20Token(sdk.NewInt(1000), myTokenContractAddr)
+	require.NoError(t, err)
+	checkCtx, _ := ctx.WithIsCheckTx(true).CacheContext()
+	_, err = input.GravityKeeper.AddToOutgoingPool(checkCtx, mySender, *receiver, amountToken.GravityCoin(), feeToken.GravityCoin())
+	require.NoError(t, err)
+
+	for i, v := range []uint64{2, 3, 1} {
+		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
+		require.NoError(t, err)
+		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr)
+		require.NoError(t, err)
+		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amountToken.GravityCoin(), feeToken.GravityCoin())
+		require.NoError(t, err)
+	}
+	batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	require.NoError(t, err)
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, *tokenContract, batch.BatchNonce)
+
+	intervals := sink.Data()
+	require.NotEmpty(t, intervals)
+	data := intervals[len(intervals)-1]
+	tokenLabel := ";" + MetricLabelTokenContract + "=" + myTokenContractAddr
+	denomLabel := ";" + MetricLabelDenom + "=" + allVouchersToken.GravityCoin().Denom
+
+	// 100+2 + 101+3 + 102+1 locked, 101+3 + 100+2 burned
+	assert.Equal(t, float64(309), data.Counters[types.ModuleName+"."+MetricKeyTokensLocked+denomLabel].Sum)
+	assert.Equal(t, float64(206), data.Counters[types.ModuleName+"."+MetricKeyTokensBurned+denomLabel].Sum)
+	assert.Equal(t, 1, data.Counters[types.ModuleName+"."+MetricKeyBatchesCreated+tokenLabel].Count)
+	assert.Equal(t, 1, data.Counters[types.ModuleName+"."+MetricKeyBatchesExecuted+tokenLabel].Count)
+	assert.Equal(t, float32(1), data.Gauges[types.ModuleName+"."+MetricKeyPoolTxs+tokenLabel].Value)
+	assert.Equal(t, float32(1), data.Gauges[types.ModuleName+"."+MetricKeyPoolFees+tokenLabel].Value)
+	stats := input.GravityKeeper.GetPoolStats(ctx, *tokenContract)
+	assert.Equal(t, uint64(1), stats.TxCount)
+	assert.Equal(t, sdk.NewInt(1), stats.TotalFees)
+
+	// the EndBlocker refreshes the gauges of every claim type, including those with nothing pending
+	input.GravityKeeper.UpdateBridgeGauges(ctx)
+	claimTypeLabel := ";" + MetricLabelClaimType + "=" + types.CLAIM_TYPE_SEND_TO_COSMOS.String()
+	gauge, ok := sink.Data()[len(sink.Data())-1].Gauges[types.ModuleName+"."+MetricKeyAttestationsPending+claimTypeLabel]
+	require.True(t, ok)
+	assert.Equal(t, float32(0), gauge.Value)
+}
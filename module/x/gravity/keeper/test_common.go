@@ -8,6 +8,7 @@ import (
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	ibchost "github.com/cosmos/ibc-go/v2/modules/core/24-host"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"testing"
 	"time"
 
@@ -237,6 +238,12 @@ var (
 		SlashFractionBadEthSignature: sdk.NewDecWithPrec(1, 2),
 		ValsetReward:                 sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
 		BridgeActive:                 true,
+		ValsetPowerDiffThreshold:     sdk.NewDecWithPrec(5, 2),
+		MaxValsetAgeBlocks:           0,
+		MaxValsetAgeTime:             0,
+		MaxBadSignatureEvidenceAge:   0,
+		CheckpointRetentionWindow:    0,
+		MaxEndBlockerItems:           1000,
 	}
 )
 
@@ -250,10 +257,15 @@ type TestInput struct {
 	BankKeeper        bankkeeper.BaseKeeper
 	GovKeeper         govkeeper.Keeper
 	IbcTransferKeeper ibctransferkeeper.Keeper
+	IbcKeeper         ibckeeper.Keeper
+	ParamsKeeper      paramskeeper.Keeper
+	CapabilityKeeper  capabilitykeeper.Keeper
 	Context           sdk.Context
 	Marshaler         codec.Codec
 	LegacyAmino       *codec.LegacyAmino
 	GravityStoreKey   *sdk.KVStoreKey
+	// WasmStoreKey is mounted for tests building a wasm keeper on top of the test env
+	WasmStoreKey *sdk.KVStoreKey
 }
 
 // SetupFiveValChain does all the initialization for a 5 Validator chain using the keys here
@@ -385,7 +397,7 @@ func SetupTestChain(t *testing.T, weights []uint64, setDelegateAddresses bool) (
 }
 
 // CreateTestEnv creates the keeper testing environment for gravity
-func CreateTestEnv(t *testing.T) TestInput {
+func CreateTestEnv(t testing.TB) TestInput {
 	t.Helper()
 
 	// Initialize store keys
@@ -403,6 +415,8 @@ func CreateTestEnv(t *testing.T) TestInput {
 	keyIbc := sdk.NewKVStoreKey(ibchost.StoreKey)
 	keyIbcTransfer := sdk.NewKVStoreKey(ibctransfertypes.StoreKey)
 	keyBech32Ibc := sdk.NewKVStoreKey(bech32ibctypes.StoreKey)
+	keyWasm := sdk.NewKVStoreKey(wasmtypes.StoreKey)
+	tkeyGravity := sdk.NewTransientStoreKey(types.TStoreKey)
 
 	// Initialize memory database and mount stores on it
 	db := dbm.NewMemDB()
@@ -421,6 +435,8 @@ func CreateTestEnv(t *testing.T) TestInput {
 	ms.MountStoreWithDB(keyIbc, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyIbcTransfer, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyBech32Ibc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyWasm, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyGravity, sdk.StoreTypeTransient, db)
 	err := ms.LoadLatestVersion()
 	require.Nil(t, err)
 
@@ -465,6 +481,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 	paramsKeeper.Subspace(ibchost.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(bech32ibctypes.ModuleName)
+	paramsKeeper.Subspace(wasmtypes.ModuleName)
 
 	// this is also used to initialize module accounts for all the map keys
 	maccPerms := map[string][]string{
@@ -547,8 +564,6 @@ func CreateTestEnv(t *testing.T) TestInput {
 		AppModuleBasic: distribution.AppModuleBasic{},
 	}.Route())
 
-	// Load default wasm config
-
 	govRouter := govtypes.NewRouter().
 		AddRoute(paramsproposal.RouterKey, params.NewParamChangeProposalHandler(paramsKeeper)).
 		AddRoute(govtypes.RouterKey, govtypes.ProposalHandler)
@@ -612,7 +627,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 		panic("Test Env Creation failure, could not set native hrp")
 	}
 
-	k := NewKeeper(gravityKey, getSubspace(paramsKeeper, types.DefaultParamspace), marshaler, &bankKeeper,
+	k := NewKeeper(gravityKey, tkeyGravity, getSubspace(paramsKeeper, types.DefaultParamspace), marshaler, &bankKeeper,
 		&stakingKeeper, &slashingKeeper, &distKeeper, &accountKeeper, &ibcTransferKeeper, &bech32IbcKeeper)
 
 	stakingKeeper = *stakingKeeper.SetHooks(
@@ -638,17 +653,22 @@ func CreateTestEnv(t *testing.T) TestInput {
 	fmt.Println(params)
 
 	testInput := TestInput{
-		GravityKeeper:   k,
-		AccountKeeper:   accountKeeper,
-		BankKeeper:      bankKeeper,
-		StakingKeeper:   stakingKeeper,
-		SlashingKeeper:  slashingKeeper,
-		DistKeeper:      distKeeper,
-		GovKeeper:       govKeeper,
-		Context:         ctx,
-		Marshaler:       marshaler,
-		LegacyAmino:     cdc,
-		GravityStoreKey: gravityKey,
+		GravityKeeper:     k,
+		AccountKeeper:     accountKeeper,
+		BankKeeper:        bankKeeper,
+		StakingKeeper:     stakingKeeper,
+		SlashingKeeper:    slashingKeeper,
+		DistKeeper:        distKeeper,
+		GovKeeper:         govKeeper,
+		IbcTransferKeeper: ibcTransferKeeper,
+		IbcKeeper:         ibcKeeper,
+		ParamsKeeper:      paramsKeeper,
+		CapabilityKeeper:  capabilityKeeper,
+		Context:           ctx,
+		Marshaler:         marshaler,
+		LegacyAmino:       cdc,
+		GravityStoreKey:   gravityKey,
+		WasmStoreKey:      keyWasm,
 	}
 	// check invariants before starting
 	testInput.Context.Logger().Info("Asserting invariants on new test env")
@@ -732,13 +752,32 @@ func MakeTestEncodingConfig() gravityparams.EncodingConfig {
 func MintVouchersFromAir(t *testing.T, ctx sdk.Context, k Keeper, dest sdk.AccAddress, amount types.InternalERC20Token) sdk.Coin {
 	coin := amount.GravityCoin()
 	vouchers := sdk.Coins{coin}
-	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers)
+	err := MintDepositedVouchers(ctx, k, vouchers)
 	require.NoError(t, err)
 	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, dest, vouchers)
 	require.NoError(t, err)
 	return coin
 }
 
+// MintDepositedVouchers mints gravity vouchers to the gravity module and counts them in the deposited bridge totals,
+// as if they had been sent to Cosmos, so that the bridge liabilities invariant holds
+func MintDepositedVouchers(ctx sdk.Context, k Keeper, vouchers sdk.Coins) error {
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers); err != nil {
+		return err
+	}
+	for _, voucher := range vouchers {
+		contract, err := types.GravityDenomToERC20(voucher.Denom)
+		if err != nil {
+			return err
+		}
+		amount := voucher.Amount
+		k.updateBridgeTotals(ctx, *contract, func(totals *types.BridgeTotals) {
+			totals.Deposited = addToTotal(totals.Deposited, amount)
+		})
+	}
+	return nil
+}
+
 func NewTestMsgCreateValidator(address sdk.ValAddress, pubKey ccrypto.PubKey, amt sdk.Int) *stakingtypes.MsgCreateValidator {
 	commission := stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
 	out, err := stakingtypes.NewMsgCreateValidator(
@@ -66,6 +66,7 @@ func TestMigrateCosmosOriginatedDenomToERC20(t *testing.T) {
 	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
 
 	input.Context.KVStore(input.GravityStoreKey).Set([]byte(oldGetDenomToERC20Key(denom)), []byte(tokenContract))
+	input.Context.KVStore(input.GravityStoreKey).Set([]byte(oldGetERC20ToDenomKey(tokenContract)), []byte(denom))
 
 	err := v2.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler)
 	assert.NoError(t, err)
@@ -82,6 +83,7 @@ func TestMigrateCosmosOriginatedERC20ToDenom(t *testing.T) {
 	input := keeper.CreateTestEnv(t)
 	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
 
+	input.Context.KVStore(input.GravityStoreKey).Set([]byte(oldGetDenomToERC20Key(denom)), []byte(tokenContract))
 	input.Context.KVStore(input.GravityStoreKey).Set([]byte(oldGetERC20ToDenomKey(tokenContract)), []byte(denom))
 
 	err := v2.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler)
@@ -209,6 +211,9 @@ func TestMigrateOutgoingTxs(t *testing.T) {
 
 	inputBytes := input.Marshaler.MustMarshal(outtx)
 	input.Context.KVStore(input.GravityStoreKey).Set([]byte(oldKey), inputBytes)
+	// the pending amounts are locked in the module
+	pending := sdk.NewCoin(types.GravityDenom(*addr), sdk.NewInt(104))
+	require.NoError(t, keeper.MintDepositedVouchers(input.Context, input.GravityKeeper, sdk.NewCoins(pending)))
 
 	err = v2.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler)
 	assert.NoError(t, err)
@@ -238,6 +243,11 @@ func TestMigrateOutgoingTxBatches(t *testing.T) {
 
 	inputBytes := input.Marshaler.MustMarshal(&batch)
 	input.Context.KVStore(input.GravityStoreKey).Set([]byte(oldKey), inputBytes)
+	// the checkpoint archive was not affected by the key format change
+	internalBatch, err := batch.ToInternal()
+	require.NoError(t, err)
+	checkpoint := internalBatch.GetCheckpoint(input.GravityKeeper.GetGravityID(input.Context))
+	input.Context.KVStore(input.GravityStoreKey).Set(types.GetPastEthSignatureCheckpointKey(checkpoint), []byte{0x1})
 
 	err = v2.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler)
 	assert.NoError(t, err)
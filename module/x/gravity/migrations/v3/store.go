@@ -0,0 +1,131 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. The migration
+// includes:
+//
+// - Set the new valset creation params to their default values, every param in the
+//   ParamSet must be present in the store or GetParams will panic.
+// - Record the upgrade block time as the latest valset time, so that MaxValsetAgeTime
+//   counts from the upgrade rather than being skipped until the next valset request.
+// - Record the validity of every registered eth address, so that bad signature evidence
+//   keeps resolving them. Their start height is unknown and left at zero.
+// - Set the checkpoint retention window to its default, the checkpoints archived so far keep
+//   their old format without a height and type and are never pruned.
+// - Set the limit of items processed by each EndBlocker phase per block to its default.
+// - Index the outgoing batches and logic calls by the block they were created in, so that slashing can iterate
+//   them in block order.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("v3 Upgrade: Enter MigrateStore")
+
+	defaults := types.DefaultParams()
+	paramSpace.Set(ctx, types.ParamStoreValsetPowerDiffThreshold, defaults.ValsetPowerDiffThreshold)
+	paramSpace.Set(ctx, types.ParamStoreMaxValsetAgeBlocks, defaults.MaxValsetAgeBlocks)
+	paramSpace.Set(ctx, types.ParamStoreMaxValsetAgeTime, defaults.MaxValsetAgeTime)
+	paramSpace.Set(ctx, types.ParamStoreMaxBadSignatureEvidenceAge, defaults.MaxBadSignatureEvidenceAge)
+	paramSpace.Set(ctx, types.ParamStoreCheckpointRetentionWindow, defaults.CheckpointRetentionWindow)
+	paramSpace.Set(ctx, types.ParamStoreMaxEndBlockerItems, defaults.MaxEndBlockerItems)
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.LatestValsetTime, sdk.FormatTimeBytes(ctx.BlockTime()))
+
+	if err := migrateEthAddressValidities(store, cdc); err != nil {
+		return err
+	}
+	if err := indexBatchesByBlock(store, cdc); err != nil {
+		return err
+	}
+	indexLogicCallsByBlock(store, cdc)
+
+	ctx.Logger().Info("v3 Upgrade: Finished MigrateStore")
+	return nil
+}
+
+// migrateEthAddressValidities records a validity without a start height for the eth address of every validator
+func migrateEthAddressValidities(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	prefixStore := prefix.NewStore(store, types.EthAddressByValidatorKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	// collect the validities first, the store must not be written to while it is iterated
+	validities := []types.EthAddressValidity{}
+	for ; iter.Valid(); iter.Next() {
+		validator := sdk.ValAddress(iter.Key())
+		if err := sdk.VerifyAddressFormat(validator); err != nil {
+			return sdkerrors.Wrapf(err, "invalid validator address %v", iter.Key())
+		}
+		ethAddr, err := types.NewEthAddressFromBytes(iter.Value())
+		if err != nil {
+			return sdkerrors.Wrapf(err, "invalid eth address of validator %s", validator.String())
+		}
+		validities = append(validities, types.EthAddressValidity{
+			EthAddress:  ethAddr.GetAddress().Hex(),
+			Validator:   validator.String(),
+			StartHeight: 0,
+			EndHeight:   0,
+		})
+	}
+
+	for _, validity := range validities {
+		validity := validity
+		// the address was checked above
+		ethAddr, _ := types.NewEthAddress(validity.EthAddress)
+		store.Set(types.GetEthAddressValidityKey(*ethAddr), cdc.MustMarshal(&validity))
+	}
+	return nil
+}
+
+// indexBatchesByBlock adds every outgoing batch to the block index
+func indexBatchesByBlock(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	prefixStore := prefix.NewStore(store, types.OutgoingTXBatchKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	// collect the index entries first, the store must not be written to while it is iterated
+	var indexKeys, keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		var batch types.OutgoingTxBatch
+		cdc.MustUnmarshal(iter.Value(), &batch)
+		intBatch, err := batch.ToInternal()
+		if err != nil {
+			return sdkerrors.Wrapf(err, "invalid batch %d", batch.BatchNonce)
+		}
+		indexKeys = append(indexKeys, types.GetOutgoingTxBatchBlockKey(intBatch.Block, intBatch.TokenContract, intBatch.BatchNonce))
+		keys = append(keys, types.GetOutgoingTxBatchKey(intBatch.TokenContract, intBatch.BatchNonce))
+	}
+
+	for i, indexKey := range indexKeys {
+		store.Set(indexKey, keys[i])
+	}
+	return nil
+}
+
+// indexLogicCallsByBlock adds every outgoing logic call to the block index
+func indexLogicCallsByBlock(store storetypes.KVStore, cdc codec.BinaryCodec) {
+	prefixStore := prefix.NewStore(store, types.KeyOutgoingLogicCall)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	// collect the index entries first, the store must not be written to while it is iterated
+	var indexKeys, keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		var call types.OutgoingLogicCall
+		cdc.MustUnmarshal(iter.Value(), &call)
+		indexKeys = append(indexKeys, types.GetOutgoingLogicCallBlockKey(call.Block, call.InvalidationId, call.InvalidationNonce))
+		keys = append(keys, types.GetOutgoingLogicCallKey(call.InvalidationId, call.InvalidationNonce))
+	}
+
+	for i, indexKey := range indexKeys {
+		store.Set(indexKey, keys[i])
+	}
+}
@@ -0,0 +1,197 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/Gravity-Bridge/Gravity-Bridge/module/config"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	v3 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v3"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+const tokenContract string = "0x2a24af0501A534fcA004eE1bD667b783F205A546"
+
+// setupV2State returns a five validator chain whose gravity store looks like it did before the v3 upgrade: the params
+// added in v3 hold values other than their defaults and the eth address validities, the latest valset time and the
+// block indexes of batches and logic calls are missing
+func setupV2State(t *testing.T) keeper.TestInput {
+	input, ctx := keeper.SetupFiveValChain(t)
+	input.Context = ctx
+	store := ctx.KVStore(input.GravityStoreKey)
+
+	paramSpace, found := input.ParamsKeeper.GetSubspace(types.DefaultParamspace)
+	require.True(t, found)
+	paramSpace.Set(ctx, types.ParamStoreValsetPowerDiffThreshold, sdk.NewDecWithPrec(1, 1))
+	paramSpace.Set(ctx, types.ParamStoreMaxValsetAgeBlocks, uint64(1))
+	paramSpace.Set(ctx, types.ParamStoreMaxValsetAgeTime, uint64(1))
+	paramSpace.Set(ctx, types.ParamStoreMaxBadSignatureEvidenceAge, uint64(1))
+	paramSpace.Set(ctx, types.ParamStoreCheckpointRetentionWindow, uint64(1))
+	paramSpace.Set(ctx, types.ParamStoreMaxEndBlockerItems, uint64(1))
+
+	for _, ethAddr := range keeper.EthAddrs {
+		addr, err := types.NewEthAddress(ethAddr.String())
+		require.NoError(t, err)
+		store.Delete(types.GetEthAddressValidityKey(*addr))
+	}
+	store.Delete(types.LatestValsetTime)
+
+	return input
+}
+
+func TestMigrateParams(t *testing.T) {
+	input := setupV2State(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	ctx := input.Context
+
+	paramSpace, found := input.ParamsKeeper.GetSubspace(types.DefaultParamspace)
+	require.True(t, found)
+	before := input.GravityKeeper.GetParams(ctx)
+
+	require.NoError(t, v3.MigrateStore(ctx, input.GravityStoreKey, paramSpace, input.Marshaler))
+
+	// the new params are set to their defaults, the others are untouched
+	expected := types.DefaultParams()
+	params := input.GravityKeeper.GetParams(ctx)
+	assert.Equal(t, expected.ValsetPowerDiffThreshold, params.ValsetPowerDiffThreshold)
+	assert.Equal(t, expected.MaxValsetAgeBlocks, params.MaxValsetAgeBlocks)
+	assert.Equal(t, expected.MaxValsetAgeTime, params.MaxValsetAgeTime)
+	assert.Equal(t, expected.MaxBadSignatureEvidenceAge, params.MaxBadSignatureEvidenceAge)
+	assert.Equal(t, expected.CheckpointRetentionWindow, params.CheckpointRetentionWindow)
+	assert.Equal(t, expected.MaxEndBlockerItems, params.MaxEndBlockerItems)
+	assert.Equal(t, before.GravityId, params.GravityId)
+	assert.Equal(t, before.SignedValsetsWindow, params.SignedValsetsWindow)
+	assert.Equal(t, before.BridgeActive, params.BridgeActive)
+	require.NoError(t, params.ValidateBasic())
+}
+
+func TestMigrateLatestValsetTime(t *testing.T) {
+	input := setupV2State(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	blockTime := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	ctx := input.Context.WithBlockTime(blockTime)
+
+	paramSpace, found := input.ParamsKeeper.GetSubspace(types.DefaultParamspace)
+	require.True(t, found)
+	require.True(t, input.GravityKeeper.GetLatestValsetTime(ctx).IsZero())
+
+	require.NoError(t, v3.MigrateStore(ctx, input.GravityStoreKey, paramSpace, input.Marshaler))
+
+	// the valset age counts from the upgrade
+	assert.True(t, blockTime.Equal(input.GravityKeeper.GetLatestValsetTime(ctx)))
+}
+
+func TestMigrateEthAddressValidities(t *testing.T) {
+	input := setupV2State(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	paramSpace, found := input.ParamsKeeper.GetSubspace(types.DefaultParamspace)
+	require.True(t, found)
+	require.Empty(t, k.GetEthAddressValidities(ctx))
+
+	require.NoError(t, v3.MigrateStore(ctx, input.GravityStoreKey, paramSpace, input.Marshaler))
+
+	// every registered eth address is valid from the start of the chain
+	require.Len(t, k.GetEthAddressValidities(ctx), len(keeper.ValAddrs))
+	for i, val := range keeper.ValAddrs {
+		ethAddr, err := types.NewEthAddress(keeper.EthAddrs[i].String())
+		require.NoError(t, err)
+		validity, found := k.GetEthAddressValidity(ctx, *ethAddr)
+		require.True(t, found)
+		assert.Equal(t, types.EthAddressValidity{
+			EthAddress:  ethAddr.GetAddress().Hex(),
+			Validator:   val.String(),
+			StartHeight: 0,
+			EndHeight:   0,
+		}, *validity)
+
+		// bad signature evidence resolves the address to its validator again
+		validator, found := k.GetValidatorByEthAddress(ctx, *ethAddr)
+		require.True(t, found)
+		assert.Equal(t, val, validator.GetOperator())
+	}
+}
+
+// nolint: exhaustivestruct
+func TestMigrateBlockIndexes(t *testing.T) {
+	input := setupV2State(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	ctx := input.Context
+	k := input.GravityKeeper
+	store := ctx.KVStore(input.GravityStoreKey)
+
+	// store the batches and logic calls, then drop their block index which v2 did not have
+	contract, err := types.NewEthAddress(tokenContract)
+	require.NoError(t, err)
+	for _, b := range []struct{ nonce, block uint64 }{{1, 30}, {2, 10}, {3, 20}} {
+		batch, err := types.NewInternalOutgingTxBatch(b.nonce, 1000, nil, *contract, b.block)
+		require.NoError(t, err)
+		k.StoreBatch(ctx, *batch)
+		store.Delete(types.GetOutgoingTxBatchBlockKey(b.block, *contract, b.nonce))
+	}
+	for _, c := range []struct {
+		id    string
+		block uint64
+	}{{"a", 25}, {"b", 5}} {
+		call := types.OutgoingLogicCall{
+			InvalidationId:    []byte(c.id),
+			InvalidationNonce: 1,
+			Timeout:           1000,
+			Block:             c.block,
+		}
+		k.SetOutgoingLogicCall(ctx, call)
+		store.Delete(types.GetOutgoingLogicCallBlockKey(call.Block, call.InvalidationId, call.InvalidationNonce))
+	}
+
+	batchNonces := func() (nonces []uint64) {
+		k.IterateOutgoingTxBatchesByBlock(ctx, 0, func(batch types.InternalOutgoingTxBatch) bool {
+			nonces = append(nonces, batch.BatchNonce)
+			return false
+		})
+		return nonces
+	}
+	callIds := func() (ids []string) {
+		k.IterateOutgoingLogicCallsByBlock(ctx, 0, func(call types.OutgoingLogicCall) bool {
+			ids = append(ids, string(call.InvalidationId))
+			return false
+		})
+		return ids
+	}
+	require.Empty(t, batchNonces())
+	require.Empty(t, callIds())
+
+	paramSpace, found := input.ParamsKeeper.GetSubspace(types.DefaultParamspace)
+	require.True(t, found)
+	require.NoError(t, v3.MigrateStore(ctx, input.GravityStoreKey, paramSpace, input.Marshaler))
+
+	// the batches and logic calls are found in block order
+	assert.Equal(t, []uint64{2, 3, 1}, batchNonces())
+	assert.Equal(t, []string{"b", "a"}, callIds())
+	assert.Len(t, k.GetUnSlashedBatches(ctx, 25, 100), 2)
+
+	// the indexes are removed with the objects
+	batch := k.GetOutgoingTXBatch(ctx, *contract, 2)
+	require.NotNil(t, batch)
+	k.DeleteBatch(ctx, *batch)
+	k.DeleteOutgoingLogicCall(ctx, []byte("b"), 1)
+	assert.Equal(t, []uint64{3, 1}, batchNonces())
+	assert.Equal(t, []string{"a"}, callIds())
+}
+
+func TestMigrateEmptyStore(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	paramSpace, found := input.ParamsKeeper.GetSubspace(types.DefaultParamspace)
+	require.True(t, found)
+	require.NoError(t, v3.MigrateStore(input.Context, input.GravityStoreKey, paramSpace, input.Marshaler))
+
+	assert.Empty(t, input.GravityKeeper.GetEthAddressValidities(input.Context))
+	assert.Empty(t, input.GravityKeeper.GetOutgoingTxBatches(input.Context))
+}
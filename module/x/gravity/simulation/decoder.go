@@ -0,0 +1,172 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding gravity type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.ValsetRequestKey),
+			bytes.HasPrefix(kvA.Key, types.LastObservedValsetKey):
+			var valsetA, valsetB types.Valset
+			cdc.MustUnmarshal(kvA.Value, &valsetA)
+			cdc.MustUnmarshal(kvB.Value, &valsetB)
+			return fmt.Sprintf("%v\n%v", valsetA, valsetB)
+
+		case bytes.HasPrefix(kvA.Key, types.ValsetConfirmKey):
+			var confirmA, confirmB types.MsgValsetConfirm
+			cdc.MustUnmarshal(kvA.Value, &confirmA)
+			cdc.MustUnmarshal(kvB.Value, &confirmB)
+			return fmt.Sprintf("%v\n%v", confirmA, confirmB)
+
+		case bytes.HasPrefix(kvA.Key, types.OracleAttestationKey):
+			var attA, attB types.Attestation
+			cdc.MustUnmarshal(kvA.Value, &attA)
+			cdc.MustUnmarshal(kvB.Value, &attB)
+			return fmt.Sprintf("%v\n%v", attA, attB)
+
+		case bytes.HasPrefix(kvA.Key, types.OutgoingTXPoolKey):
+			var txA, txB types.OutgoingTransferTx
+			cdc.MustUnmarshal(kvA.Value, &txA)
+			cdc.MustUnmarshal(kvB.Value, &txB)
+			return fmt.Sprintf("%v\n%v", txA, txB)
+
+		case bytes.HasPrefix(kvA.Key, types.OutgoingTXBatchKey):
+			var batchA, batchB types.OutgoingTxBatch
+			cdc.MustUnmarshal(kvA.Value, &batchA)
+			cdc.MustUnmarshal(kvB.Value, &batchB)
+			return fmt.Sprintf("%v\n%v", batchA, batchB)
+
+		case bytes.HasPrefix(kvA.Key, types.BatchConfirmKey):
+			var confirmA, confirmB types.MsgConfirmBatch
+			cdc.MustUnmarshal(kvA.Value, &confirmA)
+			cdc.MustUnmarshal(kvB.Value, &confirmB)
+			return fmt.Sprintf("%v\n%v", confirmA, confirmB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyOutgoingLogicCall):
+			var callA, callB types.OutgoingLogicCall
+			cdc.MustUnmarshal(kvA.Value, &callA)
+			cdc.MustUnmarshal(kvB.Value, &callB)
+			return fmt.Sprintf("%v\n%v", callA, callB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyOutgoingLogicConfirm):
+			var confirmA, confirmB types.MsgConfirmLogicCall
+			cdc.MustUnmarshal(kvA.Value, &confirmA)
+			cdc.MustUnmarshal(kvB.Value, &confirmB)
+			return fmt.Sprintf("%v\n%v", confirmA, confirmB)
+
+		case bytes.HasPrefix(kvA.Key, types.LastObservedEthereumBlockHeightKey):
+			var heightA, heightB types.LastObservedEthereumBlockHeight
+			cdc.MustUnmarshal(kvA.Value, &heightA)
+			cdc.MustUnmarshal(kvB.Value, &heightB)
+			return fmt.Sprintf("%v\n%v", heightA, heightB)
+
+		case bytes.HasPrefix(kvA.Key, types.PendingIbcAutoForwards):
+			var forwardA, forwardB types.PendingIbcAutoForward
+			cdc.MustUnmarshal(kvA.Value, &forwardA)
+			cdc.MustUnmarshal(kvB.Value, &forwardB)
+			return fmt.Sprintf("%v\n%v", forwardA, forwardB)
+
+		case bytes.HasPrefix(kvA.Key, types.DelegateKeyRotationKey):
+			var rotationA, rotationB types.DelegateKeyRotation
+			cdc.MustUnmarshal(kvA.Value, &rotationA)
+			cdc.MustUnmarshal(kvB.Value, &rotationB)
+			return fmt.Sprintf("%v\n%v", rotationA, rotationB)
+
+		case bytes.HasPrefix(kvA.Key, types.EthAddressValidityKey):
+			var validityA, validityB types.EthAddressValidity
+			cdc.MustUnmarshal(kvA.Value, &validityA)
+			cdc.MustUnmarshal(kvB.Value, &validityB)
+			return fmt.Sprintf("%v\n%v", validityA, validityB)
+
+		case bytes.HasPrefix(kvA.Key, types.BridgeTotalsKey):
+			var totalsA, totalsB types.BridgeTotals
+			cdc.MustUnmarshal(kvA.Value, &totalsA)
+			cdc.MustUnmarshal(kvB.Value, &totalsB)
+			return fmt.Sprintf("%v\n%v", totalsA, totalsB)
+
+		case bytes.HasPrefix(kvA.Key, types.BatchTxRemovalsKey):
+			var removalsA, removalsB types.BatchTxRemovals
+			cdc.MustUnmarshal(kvA.Value, &removalsA)
+			cdc.MustUnmarshal(kvB.Value, &removalsB)
+			return fmt.Sprintf("%v\n%v", removalsA, removalsB)
+
+		case bytes.HasPrefix(kvA.Key, types.PoolStatsKey):
+			var statsA, statsB types.PoolStats
+			cdc.MustUnmarshal(kvA.Value, &statsA)
+			cdc.MustUnmarshal(kvB.Value, &statsB)
+			return fmt.Sprintf("%v\n%v", statsA, statsB)
+
+		case bytes.HasPrefix(kvA.Key, types.EthereumBlockTimeEstimateKey):
+			var estimateA, estimateB types.EthereumBlockTimeEstimate
+			cdc.MustUnmarshal(kvA.Value, &estimateA)
+			cdc.MustUnmarshal(kvB.Value, &estimateB)
+			return fmt.Sprintf("%v\n%v", estimateA, estimateB)
+
+		case bytes.HasPrefix(kvA.Key, types.LastEventNonceByValidatorKey),
+			bytes.HasPrefix(kvA.Key, types.LastObservedEventNonceKey),
+			bytes.HasPrefix(kvA.Key, types.KeyLastTXPoolID),
+			bytes.HasPrefix(kvA.Key, types.KeyLastOutgoingBatchID),
+			bytes.HasPrefix(kvA.Key, types.LastSlashedValsetNonce),
+			bytes.HasPrefix(kvA.Key, types.LatestValsetNonce),
+			bytes.HasPrefix(kvA.Key, types.LastSlashedBatchBlock),
+			bytes.HasPrefix(kvA.Key, types.LastSlashedLogicCallBlock),
+			bytes.HasPrefix(kvA.Key, types.LastUnBondingBlockHeight),
+			bytes.HasPrefix(kvA.Key, types.DelegateKeyNonceKey),
+			bytes.HasPrefix(kvA.Key, types.PrunedCheckpointNonceKey),
+			bytes.HasPrefix(kvA.Key, types.PendingAttestationsKey):
+			return fmt.Sprintf("%d\n%d", types.UInt64FromBytes(kvA.Value), types.UInt64FromBytes(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.KeyOrchestratorAddress),
+			bytes.HasPrefix(kvA.Key, types.RetiredOrchestratorAddressKey),
+			bytes.HasPrefix(kvA.Key, types.ValidatorByEthAddressKey):
+			return fmt.Sprintf("%v\n%v", sdk.ValAddress(kvA.Value), sdk.ValAddress(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.EthAddressByValidatorKey),
+			bytes.HasPrefix(kvA.Key, types.DenomToERC20Key):
+			return fmt.Sprintf("%v\n%v", common.BytesToAddress(kvA.Value).Hex(), common.BytesToAddress(kvB.Value).Hex())
+
+		case bytes.HasPrefix(kvA.Key, types.ERC20ToDenomKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.PastEthSignatureCheckpointKey):
+			// checkpoints of an unspecified type are stored as a single 0x1 byte
+			if bytes.Equal(kvA.Value, []byte{0x1}) || bytes.Equal(kvB.Value, []byte{0x1}) {
+				return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+			}
+			var recordA, recordB types.PastEthSignatureCheckpoint
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.HasPrefix(kvA.Key, types.PastEthSignatureCheckpointHeightKey),
+			bytes.HasPrefix(kvA.Key, types.OutgoingTXBatchBlockKey),
+			bytes.HasPrefix(kvA.Key, types.OutgoingLogicCallBlockKey),
+			bytes.HasPrefix(kvA.Key, types.BatchTimeoutCursorKey),
+			bytes.HasPrefix(kvA.Key, types.LogicCallTimeoutCursorKey):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.LatestValsetTime):
+			timeA, errA := sdk.ParseTimeBytes(kvA.Value)
+			timeB, errB := sdk.ParseTimeBytes(kvB.Value)
+			if errA != nil || errB != nil {
+				panic(fmt.Sprintf("invalid latest valset time %X, %X", kvA.Value, kvB.Value))
+			}
+			return fmt.Sprintf("%v\n%v", timeA, timeB)
+
+		default:
+			panic(fmt.Sprintf("invalid gravity key prefix %X", kvA.Key))
+		}
+	}
+}
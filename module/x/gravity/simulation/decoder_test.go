@@ -0,0 +1,60 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/simulation"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := keeper.MakeTestMarshaler()
+	dec := simulation.NewDecodeStore(cdc)
+
+	valset := types.Valset{Nonce: 7, Height: 100, Members: []types.BridgeValidator{
+		{Power: 100, EthereumAddress: keeper.EthAddrs[0].String()},
+	}}
+	attestation := types.Attestation{Observed: true, Votes: []string{keeper.ValAddrs[0].String()}, Height: 12}
+	erc20, err := types.NewEthAddress(simulation.SimCosmosOriginatedContract)
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetValsetKey(valset.Nonce), Value: cdc.MustMarshal(&valset)},
+			{Key: types.GetAttestationKey(1, []byte("hash")), Value: cdc.MustMarshal(&attestation)},
+			{Key: types.GetLastEventNonceByValidatorKey(keeper.ValAddrs[0]), Value: types.UInt64Bytes(42)},
+			{Key: types.GetOrchestratorAddressKey(keeper.AccAddrs[0]), Value: keeper.ValAddrs[0]},
+			{Key: types.GetERC20ToDenomKey(*erc20), Value: []byte(sdk.DefaultBondDenom)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Valset", fmt.Sprintf("%v\n%v", valset, valset)},
+		{"Attestation", fmt.Sprintf("%v\n%v", attestation, attestation)},
+		{"LastEventNonceByValidator", "42\n42"},
+		{"OrchestratorAddress", fmt.Sprintf("%v\n%v", keeper.ValAddrs[0], keeper.ValAddrs[0])},
+		{"ERC20ToDenom", fmt.Sprintf("%s\n%s", sdk.DefaultBondDenom, sdk.DefaultBondDenom)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Simulation parameter constants
+const (
+	SignedValsetsWindow       = "signed_valsets_window"
+	SignedBatchesWindow       = "signed_batches_window"
+	SignedLogicCallsWindow    = "signed_logic_calls_window"
+	TargetBatchTimeout        = "target_batch_timeout"
+	SlashFractionValset       = "slash_fraction_valset"
+	SlashFractionBatch        = "slash_fraction_batch"
+	ValsetPowerDiffThreshold  = "valset_power_diff_threshold"
+	CheckpointRetentionWindow = "checkpoint_retention_window"
+	MaxEndBlockerItems        = "max_end_blocker_items"
+)
+
+const (
+	// SimEthOriginatedContract is the token contract used by simulated SendToCosmos events, its vouchers are the
+	// only Ethereum originated tokens in the simulation
+	SimEthOriginatedContract = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	// SimCosmosOriginatedContract is the ERC20 representing the bond denom in the simulation
+	SimCosmosOriginatedContract = "0x2f0D1f3b45e38C4Fa7dd5C5A6E1D5C1B1Bd1c1d6"
+)
+
+// GenSignedWindow randomized signed valsets/batches/logic calls window, kept long enough that simulated
+// orchestrators are not constantly slashed
+func GenSignedWindow(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1000, 20000))
+}
+
+// GenTargetBatchTimeout randomized TargetBatchTimeout, between one and twenty four hours in milliseconds
+func GenTargetBatchTimeout(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 3600000, 86400000))
+}
+
+// GenSlashFraction randomized slash fraction, between 0.01% and 1%
+func GenSlashFraction(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 4)
+}
+
+// GenValsetPowerDiffThreshold randomized ValsetPowerDiffThreshold, between 1% and 10%
+func GenValsetPowerDiffThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 11)), 2)
+}
+
+// GenCheckpointRetentionWindow randomized CheckpointRetentionWindow, archive mode half of the time and otherwise
+// just above the longest window generated by GenSignedWindow, which it must not be shorter than
+func GenCheckpointRetentionWindow(r *rand.Rand) uint64 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return uint64(simtypes.RandIntBetween(r, 20000, 20100))
+}
+
+// GenMaxEndBlockerItems randomized MaxEndBlockerItems, small enough that the EndBlocker regularly has to carry
+// work over to the next block
+func GenMaxEndBlockerItems(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 50))
+}
+
+// EthPrivateKey derives the Ethereum key a simulated account uses as its delegate eth key, the key is derived
+// from the cosmos private key so that operations can sign on behalf of any orchestrator without extra state
+func EthPrivateKey(acc simtypes.Account) *ecdsa.PrivateKey {
+	key, err := crypto.ToECDSA(tmhash.Sum(acc.PrivKey.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// EthAddress returns the Ethereum address of the key derived by EthPrivateKey
+func EthAddress(acc simtypes.Account) types.EthAddress {
+	addr, err := types.NewEthAddress(crypto.PubkeyToAddress(EthPrivateKey(acc).PublicKey).Hex())
+	if err != nil {
+		panic(err)
+	}
+	return *addr
+}
+
+// RandomizedGenState generates a random GenesisState for gravity, every initially bonded validator is given
+// delegate keys so that the bridge is operational from the first block
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SignedValsetsWindow, &params.SignedValsetsWindow, simState.Rand,
+		func(r *rand.Rand) { params.SignedValsetsWindow = GenSignedWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SignedBatchesWindow, &params.SignedBatchesWindow, simState.Rand,
+		func(r *rand.Rand) { params.SignedBatchesWindow = GenSignedWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SignedLogicCallsWindow, &params.SignedLogicCallsWindow, simState.Rand,
+		func(r *rand.Rand) { params.SignedLogicCallsWindow = GenSignedWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TargetBatchTimeout, &params.TargetBatchTimeout, simState.Rand,
+		func(r *rand.Rand) { params.TargetBatchTimeout = GenTargetBatchTimeout(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionValset, &params.SlashFractionValset, simState.Rand,
+		func(r *rand.Rand) { params.SlashFractionValset = GenSlashFraction(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionBatch, &params.SlashFractionBatch, simState.Rand,
+		func(r *rand.Rand) { params.SlashFractionBatch = GenSlashFraction(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ValsetPowerDiffThreshold, &params.ValsetPowerDiffThreshold, simState.Rand,
+		func(r *rand.Rand) { params.ValsetPowerDiffThreshold = GenValsetPowerDiffThreshold(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CheckpointRetentionWindow, &params.CheckpointRetentionWindow, simState.Rand,
+		func(r *rand.Rand) { params.CheckpointRetentionWindow = GenCheckpointRetentionWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxEndBlockerItems, &params.MaxEndBlockerItems, simState.Rand,
+		func(r *rand.Rand) { params.MaxEndBlockerItems = GenMaxEndBlockerItems(r) },
+	)
+
+	// the staking simulation bonds the first NumBonded accounts, each one operating its own validator. A few
+	// validators are left without delegate keys so that they have to be registered during the simulation
+	delegateKeys := make([]types.MsgSetOrchestratorAddress, 0, simState.NumBonded)
+	for _, acc := range simState.Accounts[:simState.NumBonded] {
+		if simState.Rand.Intn(10) == 0 {
+			continue
+		}
+		delegateKeys = append(delegateKeys,
+			*types.NewMsgSetOrchestratorAddress(sdk.ValAddress(acc.Address), acc.Address, EthAddress(acc), nil))
+	}
+
+	gravityGenesis := types.DefaultGenesisState()
+	gravityGenesis.Params = params
+	gravityGenesis.DelegateKeys = delegateKeys
+	gravityGenesis.Erc20ToDenoms = []types.ERC20ToDenom{
+		{Erc20: SimCosmosOriginatedContract, Denom: sdk.DefaultBondDenom},
+	}
+
+	bz, err := json.MarshalIndent(&gravityGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated gravity parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(gravityGenesis)
+}
@@ -0,0 +1,58 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/simulation"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// TestRandomizedGenState tests the normal scenario of applying RandomizedGenState, the generated genesis
+// must be valid and give delegate keys to bonded validators only
+func TestRandomizedGenState(t *testing.T) {
+	cdc := keeper.MakeTestMarshaler()
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    10,
+		Accounts:     simtypes.RandomAccounts(r, 20),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var gravityGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &gravityGenesis)
+
+	require.NoError(t, gravityGenesis.ValidateBasic())
+	require.NotEmpty(t, gravityGenesis.DelegateKeys)
+	require.LessOrEqual(t, len(gravityGenesis.DelegateKeys), int(simState.NumBonded))
+
+	bonded := make(map[string]simtypes.Account)
+	for _, acc := range simState.Accounts[:simState.NumBonded] {
+		bonded[sdk.ValAddress(acc.Address).String()] = acc
+	}
+	for _, key := range gravityGenesis.DelegateKeys {
+		acc, ok := bonded[key.Validator]
+		require.True(t, ok, "delegate keys set for unbonded account %s", key.Validator)
+		require.Equal(t, acc.Address.String(), key.Orchestrator)
+		require.Equal(t, simulation.EthAddress(acc).GetAddress().Hex(), key.EthAddress)
+	}
+
+	require.Equal(t, []types.ERC20ToDenom{
+		{Erc20: simulation.SimCosmosOriginatedContract, Denom: sdk.DefaultBondDenom},
+	}, gravityGenesis.Erc20ToDenoms)
+}
@@ -0,0 +1,486 @@
+package simulation
+
+import (
+	"context"
+	"encoding/hex"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSetOrchestratorAddress = "op_weight_msg_set_orchestrator_address"
+	OpWeightMsgSendToEth              = "op_weight_msg_send_to_eth"
+	OpWeightMsgCancelSendToEth        = "op_weight_msg_cancel_send_to_eth"
+	OpWeightMsgRequestBatch           = "op_weight_msg_request_batch"
+	OpWeightMsgValsetConfirm          = "op_weight_msg_valset_confirm"
+	OpWeightMsgConfirmBatch           = "op_weight_msg_confirm_batch"
+	OpWeightMsgEthereumClaim          = "op_weight_msg_ethereum_claim"
+
+	DefaultWeightMsgSetOrchestratorAddress = 20
+	DefaultWeightMsgSendToEth              = 50
+	DefaultWeightMsgCancelSendToEth        = 10
+	DefaultWeightMsgRequestBatch           = 20
+	DefaultWeightMsgValsetConfirm          = 100
+	DefaultWeightMsgConfirmBatch           = 100
+	DefaultWeightMsgEthereumClaim          = 100
+)
+
+// SimGas is the gas limit of every gravity transaction delivered by the simulation
+const SimGas = 10 * helpers.DefaultGenTxGas
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak authkeeper.AccountKeeper,
+	bk bankkeeper.Keeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgSetOrchestratorAddress int
+		weightMsgSendToEth              int
+		weightMsgCancelSendToEth        int
+		weightMsgRequestBatch           int
+		weightMsgValsetConfirm          int
+		weightMsgConfirmBatch           int
+		weightMsgEthereumClaim          int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetOrchestratorAddress, &weightMsgSetOrchestratorAddress, nil,
+		func(_ *rand.Rand) { weightMsgSetOrchestratorAddress = DefaultWeightMsgSetOrchestratorAddress },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendToEth, &weightMsgSendToEth, nil,
+		func(_ *rand.Rand) { weightMsgSendToEth = DefaultWeightMsgSendToEth },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelSendToEth, &weightMsgCancelSendToEth, nil,
+		func(_ *rand.Rand) { weightMsgCancelSendToEth = DefaultWeightMsgCancelSendToEth },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgRequestBatch, &weightMsgRequestBatch, nil,
+		func(_ *rand.Rand) { weightMsgRequestBatch = DefaultWeightMsgRequestBatch },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgValsetConfirm, &weightMsgValsetConfirm, nil,
+		func(_ *rand.Rand) { weightMsgValsetConfirm = DefaultWeightMsgValsetConfirm },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgConfirmBatch, &weightMsgConfirmBatch, nil,
+		func(_ *rand.Rand) { weightMsgConfirmBatch = DefaultWeightMsgConfirmBatch },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgEthereumClaim, &weightMsgEthereumClaim, nil,
+		func(_ *rand.Rand) { weightMsgEthereumClaim = DefaultWeightMsgEthereumClaim },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgSetOrchestratorAddress, SimulateMsgSetOrchestratorAddress(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSendToEth, SimulateMsgSendToEth(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgCancelSendToEth, SimulateMsgCancelSendToEth(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgRequestBatch, SimulateMsgRequestBatch(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgValsetConfirm, SimulateMsgValsetConfirm(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgConfirmBatch, SimulateMsgConfirmBatch(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgEthereumClaim, SimulateMsgEthereumClaim(ak, bk, k)),
+	}
+}
+
+// SimulateMsgSetOrchestratorAddress registers delegate keys for a random validator which has none yet,
+// the validator operator account doubles as the orchestrator
+func SimulateMsgSetOrchestratorAddress(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgSetOrchestratorAddress{}).Type()
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		valAddr := sdk.ValAddress(simAccount.Address)
+		if k.StakingKeeper.Validator(ctx, valAddr) == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account is not a validator"), nil, nil
+		}
+
+		// prove possession of the eth key the same way a validator's orchestrator would
+		hash := types.GetDelegateKeysSignHash(k.GetGravityID(ctx), valAddr, simAccount.Address, k.GetDelegateKeyNonce(ctx, valAddr))
+		signature, err := types.NewEthereumSignature(hash, EthPrivateKey(simAccount))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, err
+		}
+
+		msg := types.NewMsgSetOrchestratorAddress(valAddr, simAccount.Address, EthAddress(simAccount), signature)
+		if err := dryRun(ctx, func(c context.Context) error {
+			_, err := keeper.NewMsgServerImpl(k).SetOrchestratorAddress(c, msg)
+			return err
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msgType, nil)
+	}
+}
+
+// SimulateMsgSendToEth sends a random amount of a bridged token held by a random account to Ethereum
+func SimulateMsgSendToEth(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgSendToEth{}.Type()
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+
+		// only tokens with an ERC20 representation can be sent
+		var bridgeable sdk.Coins
+		for _, coin := range spendable {
+			if _, _, err := k.DenomToERC20Lookup(ctx, coin.Denom); err == nil && coin.Amount.GTE(sdk.NewInt(2)) {
+				bridgeable = append(bridgeable, coin)
+			}
+		}
+		if bridgeable.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bridgeable tokens"), nil, nil
+		}
+		coin := bridgeable[r.Intn(len(bridgeable))]
+
+		// keep the send small relative to the balance so that the account can still pay for fees
+		amount, err := simtypes.RandPositiveInt(r, coin.Amount.QuoRaw(2))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate amount"), nil, nil
+		}
+		fee, err := simtypes.RandPositiveInt(r, coin.Amount.Sub(amount))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate fee"), nil, nil
+		}
+		dest, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgSendToEth(simAccount.Address, EthAddress(dest),
+			sdk.NewCoin(coin.Denom, amount), sdk.NewCoin(coin.Denom, fee))
+		if err := dryRun(ctx, func(c context.Context) error {
+			_, err := keeper.NewMsgServerImpl(k).SendToEth(c, msg)
+			return err
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msgType, sdk.NewCoins(msg.Amount.Add(msg.BridgeFee)))
+	}
+}
+
+// SimulateMsgCancelSendToEth cancels a random unbatched transfer sent by one of the simulated accounts
+func SimulateMsgCancelSendToEth(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgCancelSendToEth{}).Type()
+		unbatched := k.GetUnbatchedTransactions(ctx)
+		if len(unbatched) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unbatched transactions"), nil, nil
+		}
+		tx := unbatched[r.Intn(len(unbatched))]
+		simAccount, found := simtypes.FindAccount(accs, tx.Sender)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sender is not a simulated account"), nil, nil
+		}
+
+		msg := types.NewMsgCancelSendToEth(simAccount.Address, tx.Id)
+		if err := dryRun(ctx, func(c context.Context) error {
+			_, err := keeper.NewMsgServerImpl(k).CancelSendToEth(c, msg)
+			return err
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msgType, nil)
+	}
+}
+
+// SimulateMsgRequestBatch requests a batch for the token of a random unbatched transfer
+func SimulateMsgRequestBatch(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgRequestBatch{}.Type()
+		unbatched := k.GetUnbatchedTransactions(ctx)
+		if len(unbatched) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unbatched transactions"), nil, nil
+		}
+		tx := unbatched[r.Intn(len(unbatched))]
+		_, denom := k.ERC20ToDenomLookup(ctx, tx.Erc20Token.Contract)
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgRequestBatch(simAccount.Address)
+		msg.Denom = denom
+		if err := dryRun(ctx, func(c context.Context) error {
+			_, err := keeper.NewMsgServerImpl(k).RequestBatch(c, msg)
+			return err
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msgType, nil)
+	}
+}
+
+// SimulateMsgValsetConfirm signs the oldest valset which is still missing the signature of a simulated orchestrator
+func SimulateMsgValsetConfirm(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgValsetConfirm{}).Type()
+		orchestrators := signingOrchestrators(ctx, k, accs, false)
+		if len(orchestrators) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no simulated orchestrators"), nil, nil
+		}
+		simAccount := orchestrators[r.Intn(len(orchestrators))]
+
+		// valsets are sorted newest first
+		valsets := k.GetValsets(ctx)
+		for i := len(valsets) - 1; i >= 0; i-- {
+			valset := valsets[i]
+			if k.GetValsetConfirm(ctx, valset.Nonce, simAccount.Address) != nil {
+				continue
+			}
+			signature, err := types.NewEthereumSignature(valset.GetCheckpoint(k.GetGravityID(ctx)), EthPrivateKey(simAccount))
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign valset"), nil, err
+			}
+			msg := types.NewMsgValsetConfirm(valset.Nonce, EthAddress(simAccount), simAccount.Address, hex.EncodeToString(signature))
+			if err := dryRun(ctx, func(c context.Context) error {
+				_, err := keeper.NewMsgServerImpl(k).ValsetConfirm(c, msg)
+				return err
+			}); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+			}
+			return deliver(r, app, ctx, ak, bk, simAccount, msg, msgType, nil)
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "no unsigned valsets"), nil, nil
+	}
+}
+
+// SimulateMsgConfirmBatch signs the oldest batch which is still missing the signature of a simulated orchestrator
+func SimulateMsgConfirmBatch(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgConfirmBatch{}.Type()
+		orchestrators := signingOrchestrators(ctx, k, accs, false)
+		if len(orchestrators) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no simulated orchestrators"), nil, nil
+		}
+		simAccount := orchestrators[r.Intn(len(orchestrators))]
+
+		for _, batch := range k.GetOutgoingTxBatches(ctx) {
+			if k.GetBatchConfirm(ctx, batch.BatchNonce, batch.TokenContract, simAccount.Address) != nil {
+				continue
+			}
+			signature, err := types.NewEthereumSignature(batch.GetCheckpoint(k.GetGravityID(ctx)), EthPrivateKey(simAccount))
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign batch"), nil, err
+			}
+			msg := &types.MsgConfirmBatch{
+				Nonce:         batch.BatchNonce,
+				TokenContract: batch.TokenContract.GetAddress().Hex(),
+				EthSigner:     EthAddress(simAccount).GetAddress().Hex(),
+				Orchestrator:  simAccount.Address.String(),
+				Signature:     hex.EncodeToString(signature),
+			}
+			if err := dryRun(ctx, func(c context.Context) error {
+				_, err := keeper.NewMsgServerImpl(k).ConfirmBatch(c, msg)
+				return err
+			}); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+			}
+			return deliver(r, app, ctx, ak, bk, simAccount, msg, msgType, nil)
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "no unsigned batches"), nil, nil
+	}
+}
+
+// SimulateMsgEthereumClaim plays the role of the Ethereum oracle for a random simulated orchestrator. If an event
+// already exists at the orchestrator's next event nonce the orchestrator attests to it, otherwise a new deposit or
+// batch execution event is made up. New events are only created once every earlier event has been observed, so
+// that the simulated Ethereum history stays linear.
+func SimulateMsgEthereumClaim(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgSendToCosmosClaim{}.Type()
+		orchestrators := signingOrchestrators(ctx, k, accs, true)
+		if len(orchestrators) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no simulated orchestrators"), nil, nil
+		}
+		simAccount := orchestrators[r.Intn(len(orchestrators))]
+		valAddr, _ := k.GetOrchestratorValidatorAddr(ctx, simAccount.Address)
+
+		lastObserved := k.GetLastObservedEventNonce(ctx)
+		lastNonce := k.GetLastEventNonceByValidator(ctx, valAddr)
+		nonce := lastNonce + 1
+
+		var claim types.EthereumClaim
+		attestations, _ := k.GetAttestationMapping(ctx)
+		if atts := attestations[nonce]; len(atts) > 0 {
+			existing, err := k.UnpackAttestationClaim(&atts[0])
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to unpack claim"), nil, err
+			}
+			claim = copyClaim(existing, simAccount.Address)
+		} else if lastNonce == lastObserved {
+			claim = newClaim(r, ctx, k, accs, nonce, simAccount.Address)
+		}
+		if claim == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no event to attest to"), nil, nil
+		}
+
+		msgServer := keeper.NewMsgServerImpl(k)
+		var (
+			msg     sdk.Msg
+			handler func(c context.Context) error
+		)
+		switch c := claim.(type) {
+		case *types.MsgSendToCosmosClaim:
+			msg, msgType = c, c.Type()
+			handler = func(goCtx context.Context) error {
+				_, err := msgServer.SendToCosmosClaim(goCtx, c)
+				return err
+			}
+		case *types.MsgBatchSendToEthClaim:
+			msg, msgType = c, c.Type()
+			handler = func(goCtx context.Context) error {
+				_, err := msgServer.BatchSendToEthClaim(goCtx, c)
+				return err
+			}
+		}
+		if err := dryRun(ctx, handler); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msgType, nil)
+	}
+}
+
+// newClaim makes up a new Ethereum event at the given nonce, either a deposit of the simulated Ethereum originated
+// token or the execution of an outstanding batch which has not timed out yet
+func newClaim(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, nonce uint64, orchestrator sdk.AccAddress,
+) types.EthereumClaim {
+	ethHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight + uint64(simtypes.RandIntBetween(r, 1, 20))
+
+	var executable []types.InternalOutgoingTxBatch
+	for _, batch := range k.GetOutgoingTxBatches(ctx) {
+		if batch.BatchTimeout > ethHeight {
+			executable = append(executable, batch)
+		}
+	}
+	if len(executable) > 0 && r.Intn(2) == 0 {
+		batch := executable[r.Intn(len(executable))]
+		return &types.MsgBatchSendToEthClaim{
+			EventNonce:    nonce,
+			BlockHeight:   ethHeight,
+			BatchNonce:    batch.BatchNonce,
+			TokenContract: batch.TokenContract.GetAddress().Hex(),
+			Orchestrator:  orchestrator.String(),
+		}
+	}
+
+	sender, _ := simtypes.RandomAcc(r, accs)
+	receiver, _ := simtypes.RandomAcc(r, accs)
+	return &types.MsgSendToCosmosClaim{
+		EventNonce:     nonce,
+		BlockHeight:    ethHeight,
+		TokenContract:  SimEthOriginatedContract,
+		Amount:         sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1000000))),
+		EthereumSender: EthAddress(sender).GetAddress().Hex(),
+		CosmosReceiver: receiver.Address.String(),
+		Orchestrator:   orchestrator.String(),
+	}
+}
+
+// copyClaim returns the same event as claim, claimed by orchestrator instead, or nil for event types which
+// the simulation never creates
+func copyClaim(claim types.EthereumClaim, orchestrator sdk.AccAddress) types.EthereumClaim {
+	switch c := claim.(type) {
+	case *types.MsgSendToCosmosClaim:
+		cpy := *c
+		cpy.Orchestrator = orchestrator.String()
+		return &cpy
+	case *types.MsgBatchSendToEthClaim:
+		cpy := *c
+		cpy.Orchestrator = orchestrator.String()
+		return &cpy
+	default:
+		return nil
+	}
+}
+
+// signingOrchestrators returns the simulated accounts registered as orchestrators of validators which may
+// currently sign, claims additionally require the validator to be in the active set
+func signingOrchestrators(ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, bondedOnly bool) []simtypes.Account {
+	var orchestrators []simtypes.Account
+	for _, key := range k.GetDelegateKeys(ctx) {
+		orch, err := sdk.AccAddressFromBech32(key.Orchestrator)
+		if err != nil {
+			panic("Invalid orchestrator addr in store!")
+		}
+		simAccount, found := simtypes.FindAccount(accs, orch)
+		if !found {
+			continue
+		}
+		val, found := k.GetOrchestratorValidator(ctx, orch)
+		if !found || !(val.IsBonded() || (!bondedOnly && val.IsUnbonding())) {
+			continue
+		}
+		orchestrators = append(orchestrators, simAccount)
+	}
+	return orchestrators
+}
+
+// dryRun executes a message handler against a throwaway copy of the state, operations use it to turn messages
+// the chain would reject into no-ops instead of failing the simulation
+func dryRun(ctx sdk.Context, handler func(c context.Context) error) error {
+	cacheCtx, _ := ctx.CacheContext()
+	return handler(sdk.WrapSDKContext(cacheCtx))
+}
+
+// deliver signs and delivers msg with random fees, the gas limit is raised above the simulation default since
+// requesting a full batch or attesting with a large validator set is costly
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+	simAccount simtypes.Account, msg sdk.Msg, msgType string, coinsSpentInMsg sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := ak.GetAccount(ctx, simAccount.Address)
+	spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+	var fees sdk.Coins
+	coins, hasNeg := spendable.SafeSub(coinsSpentInMsg)
+	if !hasNeg {
+		var err error
+		fees, err = simtypes.RandomFees(r, ctx, coins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate fees"), nil, err
+		}
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		SimGas,
+		ctx.ChainID(),
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+}
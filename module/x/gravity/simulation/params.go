@@ -0,0 +1,55 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreKeySignedValsetsWindow),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenSignedWindow(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreKeySignedBatchesWindow),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenSignedWindow(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreKeyTargetBatchTimeout),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenTargetBatchTimeout(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreSlashFractionValset),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenSlashFraction(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreValsetPowerDiffThreshold),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenValsetPowerDiffThreshold(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreCheckpointRetentionWindow),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenCheckpointRetentionWindow(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreMaxEndBlockerItems),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxEndBlockerItems(r))
+			},
+		),
+	}
+}
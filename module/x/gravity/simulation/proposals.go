@@ -0,0 +1,163 @@
+package simulation
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Simulation proposal weights constants
+const (
+	OpWeightSubmitUnhaltBridgeProposal = "op_weight_submit_unhalt_bridge_proposal"
+	OpWeightSubmitAirdropProposal      = "op_weight_submit_airdrop_proposal"
+	OpWeightSubmitIBCMetadataProposal  = "op_weight_submit_ibc_metadata_proposal"
+
+	OpWeightSubmitRemovePendingTransfersProposal = "op_weight_submit_remove_pending_transfers_proposal"
+
+	DefaultWeightUnhaltBridgeProposal = 5
+	DefaultWeightAirdropProposal      = 5
+	DefaultWeightIBCMetadataProposal  = 5
+
+	DefaultWeightRemovePendingTransfersProposal = 5
+)
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitUnhaltBridgeProposal,
+			DefaultWeightUnhaltBridgeProposal,
+			SimulateUnhaltBridgeProposalContent(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitAirdropProposal,
+			DefaultWeightAirdropProposal,
+			SimulateAirdropProposalContent(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitIBCMetadataProposal,
+			DefaultWeightIBCMetadataProposal,
+			SimulateIBCMetadataProposalContent(),
+		),
+		// cancel batch proposals are not simulated, the Ethereum heights of simulated claims do not increase with
+		// their event nonce, so a batch cancelled after its timeout may still be executed by a pending claim
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitRemovePendingTransfersProposal,
+			DefaultWeightRemovePendingTransfersProposal,
+			SimulateRemovePendingTransfersProposalContent(k),
+		),
+	}
+}
+
+// SimulateUnhaltBridgeProposalContent generates an unhalt bridge proposal that rolls back every attestation
+// which has not been observed yet
+func SimulateUnhaltBridgeProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		return &types.UnhaltBridgeProposal{
+			Title:       simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 100),
+			TargetNonce: k.GetLastObservedEventNonce(ctx),
+		}
+	}
+}
+
+// SimulateAirdropProposalContent generates an airdrop of part of the community pool to random accounts
+func SimulateAirdropProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		balance := k.DistKeeper.GetFeePool(ctx).CommunityPool
+		if balance.Empty() {
+			return nil
+		}
+		coin := balance[r.Intn(len(balance))]
+		available := coin.Amount.TruncateInt()
+
+		numRecipients := simtypes.RandIntBetween(r, 1, 10)
+		if available.LT(sdk.NewInt(int64(numRecipients))) {
+			return nil
+		}
+		// split at most the whole pool evenly, leaving each recipient at least one token
+		maxAmount := available.QuoRaw(int64(numRecipients))
+		if !maxAmount.IsInt64() {
+			return nil
+		}
+
+		var recipients []byte
+		amounts := make([]uint64, numRecipients)
+		for i := range amounts {
+			acc, _ := simtypes.RandomAcc(r, accs)
+			recipients = append(recipients, acc.Address.Bytes()...)
+			amounts[i] = uint64(r.Int63n(maxAmount.Int64())) + 1
+		}
+
+		return &types.AirdropProposal{
+			Title:       simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 100),
+			Denom:       coin.Denom,
+			Recipients:  recipients,
+			Amounts:     amounts,
+		}
+	}
+}
+
+// SimulateIBCMetadataProposalContent generates denom metadata for a random IBC denom
+func SimulateIBCMetadataProposalContent() simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) simtypes.Content {
+		hash := sha256.Sum256([]byte(simtypes.RandStringOfLength(r, 10)))
+		ibcDenom := fmt.Sprintf("ibc/%X", hash)
+		display := strings.ToLower(simtypes.RandStringOfLength(r, 5))
+		if sdk.ValidateDenom(display) != nil {
+			display = "a" + display
+		}
+
+		return &types.IBCMetadataProposal{
+			Title:       simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 100),
+			Metadata: banktypes.Metadata{
+				Description: simtypes.RandStringOfLength(r, 20),
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: ibcDenom, Exponent: 0},
+					{Denom: display, Exponent: 6},
+				},
+				Base:    ibcDenom,
+				Display: display,
+				Name:    display,
+				Symbol:  strings.ToUpper(display),
+			},
+			IbcDenom: ibcDenom,
+		}
+	}
+}
+
+// SimulateRemovePendingTransfersProposalContent generates the removal of the pending transfers of the sender or to
+// the destination of a random transaction in the pool
+func SimulateRemovePendingTransfersProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		pool := k.GetUnbatchedTransactions(ctx)
+		if len(pool) == 0 {
+			return nil
+		}
+		tx := pool[r.Intn(len(pool))]
+
+		//nolint: exhaustivestruct
+		proposal := &types.RemovePendingTransfersProposal{
+			Title:         simtypes.RandStringOfLength(r, 10),
+			Description:   simtypes.RandStringOfLength(r, 100),
+			RefundSenders: r.Intn(2) == 0,
+		}
+		if r.Intn(2) == 0 {
+			proposal.Senders = []string{tx.Sender.String()}
+		} else {
+			proposal.Destinations = []string{tx.DestAddress.GetAddress().Hex()}
+		}
+		return proposal
+	}
+}
@@ -0,0 +1,88 @@
+package streaming
+
+import (
+	"path/filepath"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	flagEnable      = "gravity-streaming.enable"
+	flagDir         = "gravity-streaming.dir"
+	flagMaxFileSize = "gravity-streaming.max-file-size"
+	flagMaxFiles    = "gravity-streaming.max-files"
+
+	// DefaultMaxFileSize is the size in bytes after which a stream file is rotated, 100 MiB
+	DefaultMaxFileSize = 100 << 20
+)
+
+// Config configures the gravity store streaming, it is read from the [gravity-streaming] section of app.toml
+type Config struct {
+	Enable bool `mapstructure:"enable"`
+	// Dir is the directory the stream files are written to, relative paths are relative to the node home
+	Dir         string `mapstructure:"dir"`
+	MaxFileSize int64  `mapstructure:"max-file-size"`
+	MaxFiles    int    `mapstructure:"max-files"`
+}
+
+// DefaultConfig returns the default streaming config, streaming is disabled by default
+func DefaultConfig() Config {
+	return Config{
+		Enable:      false,
+		Dir:         filepath.Join("data", "gravity-stream"),
+		MaxFileSize: DefaultMaxFileSize,
+		MaxFiles:    0,
+	}
+}
+
+// ConfigTemplate is the app.toml section of the streaming config
+const ConfigTemplate = `
+###############################################################################
+###                       Gravity Streaming Configuration                   ###
+###############################################################################
+
+[gravity-streaming]
+
+# Enable writes every change committed to the gravity store pool, batches, attestations, valsets and
+# IBC auto-forwards to files as JSON lines, one change per line. It can not be combined with state sync snapshots,
+# state-sync.snapshot-interval must be 0. A node which committed blocks it did not write to the stream refuses to
+# start, removing the last-height file in dir resumes streaming with the next block
+enable = {{ .GravityStreaming.Enable }}
+
+# Dir is the directory the stream files are written to, relative paths are relative to the node home
+dir = "{{ .GravityStreaming.Dir }}"
+
+# MaxFileSize is the size in bytes after which a new stream file is started
+max-file-size = {{ .GravityStreaming.MaxFileSize }}
+
+# MaxFiles is the number of most recent stream files kept, 0 keeps all files
+max-files = {{ .GravityStreaming.MaxFiles }}
+`
+
+// ReadConfig reads the streaming config from the app options, with the defaults for unset options
+func ReadConfig(opts servertypes.AppOptions) (Config, error) {
+	cfg := DefaultConfig()
+	var err error
+	if v := opts.Get(flagEnable); v != nil {
+		if cfg.Enable, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagDir); v != nil {
+		if cfg.Dir, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxFileSize); v != nil {
+		if cfg.MaxFileSize, err = cast.ToInt64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxFiles); v != nil {
+		if cfg.MaxFiles, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	return cfg, nil
+}
@@ -0,0 +1,158 @@
+package streaming
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	filePrefix = "gravity-"
+	fileSuffix = ".jsonl"
+	// heightFile holds the height of the last block written to the stream
+	heightFile = "last-height"
+)
+
+// rotatingFiles writes the stream to a sequence of files in dir named after the first block height they contain, so
+// that they sort in stream order. The current file is closed once it grows beyond maxFileSize bytes, blocks are
+// never split across files. Only the maxFiles most recent files are kept, all files are kept if maxFiles is zero.
+// A node always starts a new file, so the last file of a previous run is never appended to.
+type rotatingFiles struct {
+	dir         string
+	maxFileSize int64
+	maxFiles    int
+	file        *os.File
+	size        int64
+}
+
+func newRotatingFiles(dir string, maxFileSize int64, maxFiles int) (*rotatingFiles, error) {
+	if maxFileSize <= 0 {
+		return nil, fmt.Errorf("max file size must be positive, got %d", maxFileSize)
+	}
+	if maxFiles < 0 {
+		return nil, fmt.Errorf("max files must not be negative, got %d", maxFiles)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	//nolint: exhaustivestruct
+	return &rotatingFiles{dir: dir, maxFileSize: maxFileSize, maxFiles: maxFiles}, nil
+}
+
+// write appends the lines of the block at height to the current file and syncs it to disk. Nothing is left in the
+// file if writing fails.
+func (r *rotatingFiles) write(height int64, lines []byte) error {
+	if r.file == nil {
+		name := filepath.Join(r.dir, fmt.Sprintf("%s%020d%s", filePrefix, height, fileSuffix))
+		file, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+		if err != nil {
+			return err
+		}
+		r.file = file
+		r.size = 0
+	}
+
+	if _, err := r.file.Write(lines); err != nil {
+		r.rollback()
+		return err
+	}
+	if err := r.file.Sync(); err != nil {
+		r.rollback()
+		return err
+	}
+	r.size += int64(len(lines))
+	return nil
+}
+
+// rotate closes the current file if it grew beyond maxFileSize and removes the oldest files until at most maxFiles
+// remain
+func (r *rotatingFiles) rotate() error {
+	if r.file == nil || r.size < r.maxFileSize {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	if err != nil {
+		return err
+	}
+	return r.prune()
+}
+
+// rollback drops a partially written block from the current file, if that fails the file is abandoned and the next
+// block starts a new one
+func (r *rotatingFiles) rollback() {
+	if err := r.file.Truncate(r.size); err == nil {
+		if _, err := r.file.Seek(r.size, 0); err == nil {
+			return
+		}
+	}
+	r.file.Close()
+	r.file = nil
+}
+
+// prune removes the oldest files until at most maxFiles remain
+func (r *rotatingFiles) prune() error {
+	if r.maxFiles == 0 {
+		return nil
+	}
+	entries, err := ioutil.ReadDir(r.dir)
+	if err != nil {
+		return err
+	}
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasPrefix(entry.Name(), filePrefix) && strings.HasSuffix(entry.Name(), fileSuffix) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	for len(names) > r.maxFiles {
+		if err := os.Remove(filepath.Join(r.dir, names[0])); err != nil {
+			return err
+		}
+		names = names[1:]
+	}
+	return nil
+}
+
+// readHeight returns the height of the last block written to the stream, or zero if no block was ever written
+func (r *rotatingFiles) readHeight() (int64, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(r.dir, heightFile))
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	height, err := strconv.ParseInt(strings.TrimSpace(string(bz)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid stream height in %s: %w", filepath.Join(r.dir, heightFile), err)
+	}
+	return height, nil
+}
+
+// writeHeight records height as the last block written to the stream, the record is replaced atomically so that it
+// is never lost or partially written
+func (r *rotatingFiles) writeHeight(height int64) error {
+	name := filepath.Join(r.dir, heightFile)
+	tmp, err := os.OpenFile(name+".tmp", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.WriteString(strconv.FormatInt(height, 10) + "\n"); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(name+".tmp", name)
+}
@@ -0,0 +1,101 @@
+package streaming
+
+import (
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// commitMultiStore is a root multistore passing the writes to one of its stores to listeners. The listeners of the
+// SDK (rootmulti.Store.AddListeners) can not be used: every branch of a listened store is branched twice and the
+// inner branch is never written to its parent, so the writes of every block would be lost. Instead only the branches
+// taken from the root store are written through a listenkv store, the listeners see exactly the writes which reach
+// the root store.
+// The embedded rootmulti store is never given the tracer, so that its GetKVStore returns the stores unwrapped.
+type commitMultiStore struct {
+	*rootmulti.Store
+	db           dbm.DB
+	keys         map[string]storetypes.StoreKey
+	listenedKey  storetypes.StoreKey
+	listeners    []storetypes.WriteListener
+	traceWriter  io.Writer
+	traceContext storetypes.TraceContext
+}
+
+// NewCommitMultiStore returns a root multistore on db which passes the writes to the store under key to listeners.
+// It must replace the multistore of the app (baseapp.SetCMS) before any other baseapp option is applied. Nodes using
+// it can not take state sync snapshots, baseapp requires a plain rootmulti store for that.
+func NewCommitMultiStore(db dbm.DB, key storetypes.StoreKey, listeners ...storetypes.WriteListener) storetypes.CommitMultiStore {
+	//nolint: exhaustivestruct
+	return &commitMultiStore{
+		Store:       rootmulti.NewStore(db),
+		db:          db,
+		keys:        make(map[string]storetypes.StoreKey),
+		listenedKey: key,
+		listeners:   listeners,
+	}
+}
+
+// MountStoreWithDB implements CommitMultiStore
+func (s *commitMultiStore) MountStoreWithDB(key storetypes.StoreKey, typ storetypes.StoreType, db dbm.DB) {
+	s.Store.MountStoreWithDB(key, typ, db)
+	s.keys[key.Name()] = key
+}
+
+// SetTracer implements MultiStore
+func (s *commitMultiStore) SetTracer(w io.Writer) storetypes.MultiStore {
+	s.traceWriter = w
+	return s
+}
+
+// SetTracingContext implements MultiStore, merging tc into the current context
+func (s *commitMultiStore) SetTracingContext(tc storetypes.TraceContext) storetypes.MultiStore {
+	if s.traceContext == nil {
+		s.traceContext = make(storetypes.TraceContext)
+	}
+	for k, v := range tc {
+		s.traceContext[k] = v
+	}
+	return s
+}
+
+// TracingEnabled implements MultiStore
+func (s *commitMultiStore) TracingEnabled() bool {
+	return s.traceWriter != nil
+}
+
+// CacheMultiStore implements MultiStore, the branch of the listened store is written to it through the listeners
+func (s *commitMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(s.keys))
+	for _, key := range s.keys {
+		store := s.Store.GetKVStore(key)
+		if key == s.listenedKey {
+			store = listenedStore{KVStore: store, key: key, listeners: s.listeners}
+		}
+		stores[key] = store
+	}
+	return cachemulti.NewStore(s.db, stores, s.keys, s.traceWriter, s.traceContext, nil)
+}
+
+// listenedStore is a KVStore whose branches are written to it through a listenkv store
+type listenedStore struct {
+	storetypes.KVStore
+	key       storetypes.StoreKey
+	listeners []storetypes.WriteListener
+}
+
+// CacheWrap implements CacheWrapper
+func (s listenedStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(s.KVStore, s.key, s.listeners))
+}
+
+// CacheWrapWithTrace implements CacheWrapper
+func (s listenedStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(listenkv.NewStore(s.KVStore, s.key, s.listeners), w, tc))
+}
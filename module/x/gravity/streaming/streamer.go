@@ -0,0 +1,167 @@
+// Package streaming writes the changes made to the gravity store to local files as JSON lines, giving downstream
+// tools a change feed which, unlike events, reflects exactly what each block committed.
+package streaming
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Change is a single write to the gravity store, one line of a stream file
+type Change struct {
+	Height int64 `json:"height"`
+	// Op is either "set" or "delete"
+	Op string `json:"op"`
+	// Type names the kind of object stored under Key, e.g. "outgoing_tx_batch"
+	Type string `json:"type"`
+	// Key is the hex encoded store key
+	Key string `json:"key"`
+	// Value is the JSON encoding of the object written, it is omitted for deletes
+	Value json.RawMessage `json:"value,omitempty"`
+	// Raw holds the hex encoded value written if it could not be decoded
+	Raw string `json:"raw,omitempty"`
+}
+
+// streamedPrefix is a gravity store prefix whose writes are streamed, with the type of the values stored under it
+type streamedPrefix struct {
+	prefix   []byte
+	name     string
+	newValue func() codec.ProtoMarshaler
+}
+
+var streamedPrefixes = []streamedPrefix{
+	{types.OutgoingTXPoolKey, "outgoing_tx", func() codec.ProtoMarshaler { return new(types.OutgoingTransferTx) }},
+	{types.OutgoingTXBatchKey, "outgoing_tx_batch", func() codec.ProtoMarshaler { return new(types.OutgoingTxBatch) }},
+	{types.OracleAttestationKey, "attestation", func() codec.ProtoMarshaler { return new(types.Attestation) }},
+	{types.ValsetRequestKey, "valset", func() codec.ProtoMarshaler { return new(types.Valset) }},
+	{types.PendingIbcAutoForwards, "ibc_auto_forward", func() codec.ProtoMarshaler { return new(types.PendingIbcAutoForward) }},
+}
+
+// Streamer is a store WriteListener on the gravity store which writes the changes of every block to a rotating set
+// of files. It must listen to the writes reaching the root store, see NewCommitMultiStore, which happen when the
+// final state of the block is written during Commit. Those writes are ordered by key and contain each changed key
+// exactly once. Writes made outside of ListenCommit do not belong to a block and are ignored.
+// The height of the last block written is recorded with the files, so that blocks the node committed without
+// writing them, because it stopped first, are detected on startup by CheckHeight.
+type Streamer struct {
+	storeKey   storetypes.StoreKey
+	cdc        codec.Codec
+	files      *rotatingFiles
+	lastHeight int64
+	committing bool
+	changes    []Change
+	// pending holds lines which could not be written yet, they are retried with the next block
+	pending bytes.Buffer
+}
+
+var _ storetypes.WriteListener = (*Streamer)(nil)
+
+// NewStreamer creates a Streamer for the gravity store under storeKey writing to files in dir, see rotatingFiles
+// for the meaning of maxFileSize and maxFiles
+func NewStreamer(storeKey storetypes.StoreKey, cdc codec.Codec, dir string, maxFileSize int64, maxFiles int) (*Streamer, error) {
+	files, err := newRotatingFiles(dir, maxFileSize, maxFiles)
+	if err != nil {
+		return nil, err
+	}
+	lastHeight, err := files.readHeight()
+	if err != nil {
+		return nil, err
+	}
+	//nolint: exhaustivestruct
+	return &Streamer{storeKey: storeKey, cdc: cdc, files: files, lastHeight: lastHeight}, nil
+}
+
+// CheckHeight returns an error unless the stream ends at height, the last block committed by the node, which is the
+// case if the node stopped before it could write its last blocks. A stream which was never written to starts with
+// the next block.
+func (s *Streamer) CheckHeight(height int64) error {
+	if s.lastHeight == 0 || s.lastHeight == height {
+		return nil
+	}
+	name := filepath.Join(s.files.dir, heightFile)
+	if s.lastHeight > height {
+		return fmt.Errorf("the gravity stream ends at height %d after the last block committed %d, "+
+			"remove %s to stream again from height %d", s.lastHeight, height, name, height+1)
+	}
+	return fmt.Errorf("the gravity stream ends at height %d but blocks up to %d were committed, "+
+		"the changes of blocks %d to %d are missing, remove %s to accept the gap and stream from height %d",
+		s.lastHeight, height, s.lastHeight+1, height, name, height+1)
+}
+
+// OnWrite records the writes of the block being committed to the streamed gravity prefixes
+func (s *Streamer) OnWrite(storeKey storetypes.StoreKey, key []byte, value []byte, delete bool) error {
+	if !s.committing || storeKey.Name() != s.storeKey.Name() {
+		return nil
+	}
+	for _, p := range streamedPrefixes {
+		if !bytes.HasPrefix(key, p.prefix) {
+			continue
+		}
+		//nolint: exhaustivestruct
+		change := Change{Op: "set", Type: p.name, Key: hex.EncodeToString(key)}
+		if delete {
+			change.Op = "delete"
+		} else if change.Value = s.decode(value, p.newValue()); change.Value == nil {
+			change.Raw = hex.EncodeToString(value)
+		}
+		s.changes = append(s.changes, change)
+		return nil
+	}
+	return nil
+}
+
+// decode returns the JSON encoding of value stored as msg, or nil if it can not be decoded
+func (s *Streamer) decode(value []byte, msg codec.ProtoMarshaler) json.RawMessage {
+	if err := s.cdc.Unmarshal(value, msg); err != nil {
+		return nil
+	}
+	bz, err := s.cdc.MarshalJSON(msg)
+	if err != nil {
+		return nil
+	}
+	return bz
+}
+
+// ListenCommit calls commit, which must commit the current block and return its height, and writes the changes it
+// made to the gravity store to the stream files. Failing to write does not lose the changes, they are written
+// together with the changes of the next block instead, unless the node stops first which CheckHeight reports.
+func (s *Streamer) ListenCommit(commit func() int64) error {
+	s.committing = true
+	height := commit()
+	s.committing = false
+
+	for _, change := range s.changes {
+		change.Height = height
+		line, err := json.Marshal(change)
+		if err != nil {
+			// unreachable, all fields are plain values or valid JSON
+			panic(err)
+		}
+		s.pending.Write(line)
+		s.pending.WriteByte('\n')
+	}
+	s.changes = s.changes[:0]
+
+	if s.pending.Len() != 0 {
+		if err := s.files.write(height, s.pending.Bytes()); err != nil {
+			return err
+		}
+		s.pending.Reset()
+		if err := s.files.rotate(); err != nil {
+			return err
+		}
+	}
+	if err := s.files.writeHeight(height); err != nil {
+		return err
+	}
+	s.lastHeight = height
+	return nil
+}
@@ -0,0 +1,190 @@
+package streaming
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// setupStore mounts a gravity store listened to by a new Streamer writing to dir
+func setupStore(t *testing.T, dir string, maxFileSize int64, maxFiles int) (storetypes.CommitMultiStore, *sdk.KVStoreKey, *Streamer) {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	streamer, err := NewStreamer(key, keeper.MakeTestMarshaler(), dir, maxFileSize, maxFiles)
+	require.NoError(t, err)
+
+	ms := NewCommitMultiStore(dbm.NewMemDB(), key, streamer)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	// an unlistened store
+	ms.MountStoreWithDB(sdk.NewKVStoreKey("other"), sdk.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	return ms, key, streamer
+}
+
+// commitBlock runs write on a branch of the store like a block's deliver state, then commits it through the
+// streamer, write receives the block branch
+func commitBlock(t *testing.T, ms storetypes.CommitMultiStore, streamer *Streamer, write func(block storetypes.CacheMultiStore)) {
+	block := ms.CacheMultiStore()
+	write(block)
+	err := streamer.ListenCommit(func() int64 {
+		block.Write()
+		return ms.Commit().Version
+	})
+	require.NoError(t, err)
+}
+
+// streamFiles returns the names of the stream files in dir in stream order
+func streamFiles(t *testing.T, dir string) []string {
+	names, err := filepath.Glob(filepath.Join(dir, filePrefix+"*"+fileSuffix))
+	require.NoError(t, err)
+	return names
+}
+
+func readChanges(t *testing.T, dir string) (changes []Change) {
+	for _, name := range streamFiles(t, dir) {
+		f, err := os.Open(name)
+		require.NoError(t, err)
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var change Change
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &change))
+			changes = append(changes, change)
+		}
+		require.NoError(t, f.Close())
+	}
+	return changes
+}
+
+func testTx(id uint64) (key []byte, value []byte) {
+	fee, err := types.NewInternalERC20Token(sdk.NewInt(1), "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	if err != nil {
+		panic(err)
+	}
+	tx := types.OutgoingTransferTx{
+		Id:          id,
+		Sender:      "gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm",
+		DestAddress: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+		Erc20Token:  fee.ToExternal(),
+		Erc20Fee:    fee.ToExternal(),
+	}
+	return types.GetOutgoingTxPoolKey(*fee, id), keeper.MakeTestMarshaler().MustMarshal(&tx)
+}
+
+// Tests that exactly the committed writes to streamed prefixes are written, whatever branches they went through,
+// and that they are committed
+func TestStreamerCommittedChanges(t *testing.T) {
+	dir := t.TempDir()
+	ms, key, streamer := setupStore(t, dir, DefaultMaxFileSize, 0)
+	key1, tx1 := testTx(1)
+	key2, tx2 := testTx(2)
+	badBatchKey := types.AppendBytes(types.OutgoingTXBatchKey, []byte{1})
+
+	commitBlock(t, ms, streamer, func(block storetypes.CacheMultiStore) {
+		// a tx branch which is written to the block, overwritten later in the same block
+		txBranch := block.CacheMultiStore()
+		txBranch.GetKVStore(key).Set(key1, tx2)
+		txBranch.Write()
+		// a reverted tx branch
+		reverted := block.CacheMultiStore()
+		reverted.GetKVStore(key).Set(key2, tx2)
+
+		block.GetKVStore(key).Set(key1, tx1)
+		// not a streamed prefix
+		block.GetKVStore(key).Set(types.LatestValsetNonce, types.UInt64Bytes(1))
+		// does not decode
+		block.GetKVStore(key).Set(badBatchKey, []byte{0xff})
+	})
+	assert.Equal(t, tx1, ms.CacheMultiStore().GetKVStore(key).Get(key1))
+	assert.False(t, ms.CacheMultiStore().GetKVStore(key).Has(key2))
+	// writes outside of Commit, like CheckTx, are never streamed
+	checkState := ms.CacheMultiStore()
+	checkState.GetKVStore(key).Set(key2, tx2)
+	checkState.Write()
+	ms.Commit()
+	// an empty block
+	commitBlock(t, ms, streamer, func(storetypes.CacheMultiStore) {})
+	commitBlock(t, ms, streamer, func(block storetypes.CacheMultiStore) {
+		block.GetKVStore(key).Delete(key1)
+	})
+
+	assert.False(t, ms.CacheMultiStore().GetKVStore(key).Has(key1))
+	assert.Equal(t, tx2, ms.CacheMultiStore().GetKVStore(key).Get(key2))
+
+	changes := readChanges(t, dir)
+	require.Len(t, changes, 3)
+	// the store orders the writes of a commit by key
+	assert.Equal(t, int64(1), changes[0].Height)
+	assert.Equal(t, "set", changes[0].Op)
+	assert.Equal(t, "outgoing_tx", changes[0].Type)
+	assert.Equal(t, hex.EncodeToString(key1), changes[0].Key)
+	var tx types.OutgoingTransferTx
+	require.NoError(t, keeper.MakeTestMarshaler().UnmarshalJSON(changes[0].Value, &tx))
+	assert.Equal(t, uint64(1), tx.Id)
+
+	assert.Equal(t, Change{
+		Height: 1, Op: "set", Type: "outgoing_tx_batch",
+		Key: hex.EncodeToString(badBatchKey), Value: nil, Raw: "ff",
+	}, changes[1])
+
+	assert.Equal(t, Change{Height: 4, Op: "delete", Type: "outgoing_tx", Key: hex.EncodeToString(key1)}, changes[2])
+}
+
+// Tests that stream files are rotated between blocks and the oldest files are removed
+func TestStreamerRotation(t *testing.T) {
+	dir := t.TempDir()
+	// every block exceeds the file size
+	ms, key, streamer := setupStore(t, dir, 1, 2)
+	for i := uint64(1); i <= 4; i++ {
+		k, v := testTx(i)
+		commitBlock(t, ms, streamer, func(block storetypes.CacheMultiStore) {
+			block.GetKVStore(key).Set(k, v)
+		})
+	}
+
+	files := streamFiles(t, dir)
+	require.Len(t, files, 2)
+	assert.Equal(t, "gravity-00000000000000000003.jsonl", filepath.Base(files[0]))
+	assert.Equal(t, "gravity-00000000000000000004.jsonl", filepath.Base(files[1]))
+	changes := readChanges(t, dir)
+	require.Len(t, changes, 2)
+	assert.Equal(t, int64(3), changes[0].Height)
+	assert.Equal(t, int64(4), changes[1].Height)
+}
+
+// Tests that a node restarting with blocks committed but not written to the stream reports the gap
+func TestStreamerCheckHeight(t *testing.T) {
+	dir := t.TempDir()
+	ms, key, streamer := setupStore(t, dir, DefaultMaxFileSize, 0)
+	// a stream which was never written to starts with the next block
+	require.NoError(t, streamer.CheckHeight(5))
+
+	for i := uint64(1); i <= 2; i++ {
+		k, v := testTx(i)
+		commitBlock(t, ms, streamer, func(block storetypes.CacheMultiStore) {
+			block.GetKVStore(key).Set(k, v)
+		})
+	}
+	// an empty block is recorded too
+	commitBlock(t, ms, streamer, func(storetypes.CacheMultiStore) {})
+
+	restarted, err := NewStreamer(key, keeper.MakeTestMarshaler(), dir, DefaultMaxFileSize, 0)
+	require.NoError(t, err)
+	require.NoError(t, restarted.CheckHeight(3))
+	// the node committed blocks 4 and 5 without writing them
+	err = restarted.CheckHeight(5)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "blocks 4 to 5 are missing")
+	// the node was rolled back behind the stream
+	require.Error(t, restarted.CheckHeight(2))
+}
@@ -1,5 +1,13 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
 // The go-ethereum ABI encoder *only* encodes function calls and then it only encodes
 // function calls for which you provide an ABI json just like you would get out of the
 // solidity compiler with your compiled contract.
@@ -74,3 +82,42 @@ const (
       ]
     }]`
 )
+
+// The checkpoint ABIs are parsed once at startup instead of on every checkpoint computation, parsing the JSON
+// dominated the cost of GetCheckpoint for the small objects that are signed most often
+var (
+	outgoingBatchTxCheckpointEncoder = mustParseCheckpointEncoder(OutgoingBatchTxCheckpointABIJSON, "submitBatch")
+	valsetCheckpointEncoder          = mustParseCheckpointEncoder(ValsetCheckpointABIJSON, "checkpoint")
+	outgoingLogicCallEncoder         = mustParseCheckpointEncoder(OutgoingLogicCallABIJSON, "checkpoint")
+)
+
+// checkpointEncoder emulates Solidity's abi.encode() for the arguments of a single method from the above
+// specifications. Packing only the method inputs leaves out the 4 byte function selector that abi.Pack() prepends,
+// so the output can be hashed without truncating it first
+type checkpointEncoder struct {
+	inputs abi.Arguments
+}
+
+// mustParseCheckpointEncoder parses abiJSON and returns an encoder for the inputs of method, it panics since the
+// specifications are constants and a failure here is a programmer error
+func mustParseCheckpointEncoder(abiJSON string, method string) checkpointEncoder {
+	contractAbi, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		panic("Bad ABI constant!")
+	}
+	m, ok := contractAbi.Methods[method]
+	if !ok {
+		panic(fmt.Sprintf("ABI constant has no method %s", method))
+	}
+	return checkpointEncoder{inputs: m.Inputs}
+}
+
+// Hash abi encodes args and returns the keccak256 hash of the encoding, which is the checkpoint the Gravity
+// contract computes for the same arguments
+func (e checkpointEncoder) Hash(args ...interface{}) ([]byte, error) {
+	encoded, err := e.inputs.Pack(args...)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256Hash(encoded).Bytes(), nil
+}
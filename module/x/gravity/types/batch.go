@@ -3,14 +3,11 @@ package types
 import (
 	"fmt"
 	"math/big"
-	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 )
 
 func (o OutgoingTransferTx) ToInternal() (*InternalOutgoingTransferTx, error) {
@@ -206,11 +203,6 @@ func (o OutgoingTxBatch) GetCheckpoint(gravityIDstring string) []byte {
 // GetCheckpoint gets the checkpoint signature from the given outgoing tx batch
 func (i InternalOutgoingTxBatch) GetCheckpoint(gravityIDstring string) []byte {
 
-	abi, err := abi.JSON(strings.NewReader(OutgoingBatchTxCheckpointABIJSON))
-	if err != nil {
-		panic("Bad ABI constant!")
-	}
-
 	// the contract argument is not a arbitrary length array but a fixed length 32 byte
 	// array, therefore we have to utf8 encode the string (the default in this case) and
 	// then copy the variable length encoded data into a fixed length array. This function
@@ -235,10 +227,7 @@ func (i InternalOutgoingTxBatch) GetCheckpoint(gravityIDstring string) []byte {
 		txFees[j] = tx.Erc20Fee.Amount.BigInt()
 	}
 
-	// the methodName needs to be the same as the 'name' above in the checkpointAbiJson
-	// but other than that it's a constant that has no impact on the output. This is because
-	// it gets encoded as a function name which we must then discard.
-	abiEncodedBatch, err := abi.Pack("submitBatch",
+	checkpoint, err := outgoingBatchTxCheckpointEncoder.Hash(
 		gravityID,
 		batchMethodName,
 		txAmounts,
@@ -255,20 +244,12 @@ func (i InternalOutgoingTxBatch) GetCheckpoint(gravityIDstring string) []byte {
 		panic(fmt.Sprintf("Error packing checkpoint! %s/n", err))
 	}
 
-	// we hash the resulting encoded bytes discarding the first 4 bytes these 4 bytes are the constant
-	// method name 'checkpoint'. If you were to replace the checkpoint constant in this code you would
-	// then need to adjust how many bytes you truncate off the front to get the output of abi.encode()
-	return crypto.Keccak256Hash(abiEncodedBatch[4:]).Bytes()
+	return checkpoint
 }
 
 // GetCheckpoint gets the checkpoint signature from the given outgoing tx batch
 func (c OutgoingLogicCall) GetCheckpoint(gravityIDstring string) []byte {
 
-	abi, err := abi.JSON(strings.NewReader(OutgoingLogicCallABIJSON))
-	if err != nil {
-		panic("Bad ABI constant!")
-	}
-
 	// Create the methodName argument which salts the signature
 	methodNameBytes := []uint8("logicCall")
 	var logicCallMethodName [32]uint8
@@ -301,10 +282,7 @@ func (c OutgoingLogicCall) GetCheckpoint(gravityIDstring string) []byte {
 	var invalidationId [32]byte
 	copy(invalidationId[:], c.InvalidationId)
 
-	// the methodName needs to be the same as the 'name' above in the checkpointAbiJson
-	// but other than that it's a constant that has no impact on the output. This is because
-	// it gets encoded as a function name which we must then discard.
-	abiEncodedCall, err := abi.Pack("checkpoint",
+	checkpoint, err := outgoingLogicCallEncoder.Hash(
 		gravityID,
 		logicCallMethodName,
 		transferAmounts,
@@ -324,5 +302,5 @@ func (c OutgoingLogicCall) GetCheckpoint(gravityIDstring string) []byte {
 		panic(fmt.Sprintf("Error packing checkpoint! %s/n", err))
 	}
 
-	return crypto.Keccak256Hash(abiEncodedCall[4:]).Bytes()
+	return checkpoint
 }
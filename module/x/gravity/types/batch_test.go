@@ -2,10 +2,12 @@ package types
 
 import (
 	"encoding/hex"
+	"math/big"
 	"testing"
 
 	_ "github.com/Gravity-Bridge/Gravity-Bridge/module/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -84,3 +86,37 @@ func TestOutgoingLogicCallCheckpointGold1(t *testing.T) {
 	// a different hash.
 	assert.Equal(t, goldHash, hex.EncodeToString(ourHash))
 }
+
+// benchmarkBatch builds a batch of n transfers to distinct destinations, the size the relayers submit in practice
+//nolint: exhaustivestruct
+func benchmarkBatch(b *testing.B, n int) InternalOutgoingTxBatch {
+	erc20Addr := "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4"
+	senderAddr, err := sdk.AccAddressFromHex("527FBEE652609AB150F0AEE9D61A2F76CFC4A73E")
+	require.NoError(b, err)
+	batch := OutgoingTxBatch{
+		BatchNonce:    1,
+		BatchTimeout:  2111,
+		TokenContract: erc20Addr,
+	}
+	for i := 0; i < n; i++ {
+		batch.Transactions = append(batch.Transactions, OutgoingTransferTx{
+			Id:          uint64(i + 1),
+			Sender:      senderAddr.String(),
+			DestAddress: gethcommon.BigToAddress(big.NewInt(int64(i + 1))).Hex(),
+			Erc20Token:  ERC20Token{Amount: sdk.NewInt(int64(1000 + i)), Contract: erc20Addr},
+			Erc20Fee:    ERC20Token{Amount: sdk.NewInt(int64(i + 1)), Contract: erc20Addr},
+		})
+	}
+	internal, err := batch.ToInternal()
+	require.NoError(b, err)
+	return *internal
+}
+
+func BenchmarkBatchCheckpoint100Txs(b *testing.B) {
+	batch := benchmarkBatch(b, 100)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		batch.GetCheckpoint("foo")
+	}
+}
@@ -28,6 +28,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBatchSendToEthClaim{},
 		&MsgERC20DeployedClaim{},
 		&MsgSetOrchestratorAddress{},
+		&MsgRotateDelegateKeys{},
 		&MsgLogicCallExecutedClaim{},
 		&MsgValsetUpdatedClaim{},
 		&MsgCancelSendToEth{},
@@ -44,7 +45,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgValsetUpdatedClaim{},
 	)
 
-	registry.RegisterImplementations((*govtypes.Content)(nil), &UnhaltBridgeProposal{}, &AirdropProposal{}, &IBCMetadataProposal{})
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&UnhaltBridgeProposal{},
+		&AirdropProposal{},
+		&IBCMetadataProposal{},
+		&CancelBatchProposal{},
+		&RemovePendingTransfersProposal{},
+	)
 
 	registry.RegisterInterface("gravity.v1beta1.EthereumSigned", (*EthereumSigned)(nil), &Valset{}, &OutgoingTxBatch{}, &OutgoingLogicCall{})
 
@@ -56,6 +63,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*EthereumClaim)(nil), nil)
 	cdc.RegisterConcrete(&MsgSetOrchestratorAddress{}, "gravity/MsgSetOrchestratorAddress", nil)
+	cdc.RegisterConcrete(&MsgRotateDelegateKeys{}, "gravity/MsgRotateDelegateKeys", nil)
 	cdc.RegisterConcrete(&MsgValsetConfirm{}, "gravity/MsgValsetConfirm", nil)
 	cdc.RegisterConcrete(&MsgSendToEth{}, "gravity/MsgSendToEth", nil)
 	cdc.RegisterConcrete(&MsgRequestBatch{}, "gravity/MsgRequestBatch", nil)
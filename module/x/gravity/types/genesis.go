@@ -2,13 +2,17 @@ package types
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 // DefaultParamspace defines the default auth module parameter subspace
@@ -82,6 +86,25 @@ var (
 	// this could be for technical reasons (zero address) or non-technical reasons, these apply across all ERC20 tokens
 	ParamStoreEthereumBlacklist = []byte("EthereumBlacklist")
 
+	// ParamStoreValsetPowerDiffThreshold stores the fraction of power change which triggers a new valset request
+	ParamStoreValsetPowerDiffThreshold = []byte("ValsetPowerDiffThreshold")
+
+	// ParamStoreMaxValsetAgeBlocks stores the number of blocks after which a new valset request is forced
+	ParamStoreMaxValsetAgeBlocks = []byte("MaxValsetAgeBlocks")
+
+	// ParamStoreMaxValsetAgeTime stores the time in milliseconds after which a new valset request is forced
+	ParamStoreMaxValsetAgeTime = []byte("MaxValsetAgeTime")
+
+	// ParamStoreMaxBadSignatureEvidenceAge stores the number of blocks after the end of an eth key's validity after
+	// which bad signature evidence made with it is rejected
+	ParamStoreMaxBadSignatureEvidenceAge = []byte("MaxBadSignatureEvidenceAge")
+
+	// ParamStoreCheckpointRetentionWindow stores the number of blocks a past eth signature checkpoint is kept for
+	ParamStoreCheckpointRetentionWindow = []byte("CheckpointRetentionWindow")
+
+	// ParamStoreMaxEndBlockerItems stores the maximum number of items each EndBlocker phase processes per block
+	ParamStoreMaxEndBlockerItems = []byte("MaxEndBlockerItems")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{
 		GravityId:                    "",
@@ -103,17 +126,457 @@ var (
 			Denom:  "",
 			Amount: sdk.Int{},
 		},
-		BridgeActive:      true,
-		EthereumBlacklist: []string{},
+		BridgeActive:             true,
+		EthereumBlacklist:        []string{},
+		ValsetPowerDiffThreshold: sdk.Dec{},
+		MaxValsetAgeBlocks:         0,
+		MaxValsetAgeTime:           0,
+		MaxBadSignatureEvidenceAge: 0,
+		CheckpointRetentionWindow:  0,
+		MaxEndBlockerItems:         0,
 	}
 )
 
 // ValidateBasic validates genesis state by looping through the params and
-// calling their validation functions
+// calling their validation functions, then cross references the bridge state
+// so that a corrupt genesis is caught before the chain is started from it
 func (s GenesisState) ValidateBasic() error {
 	if err := s.Params.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "params")
 	}
+	if err := s.validateDelegateKeys(); err != nil {
+		return sdkerrors.Wrap(err, "delegate keys")
+	}
+	if err := s.validateDelegateKeyHistory(); err != nil {
+		return sdkerrors.Wrap(err, "delegate key history")
+	}
+	if err := s.validateErc20ToDenoms(); err != nil {
+		return sdkerrors.Wrap(err, "erc20 to denoms")
+	}
+	if err := s.validateConfirms(); err != nil {
+		return sdkerrors.Wrap(err, "confirms")
+	}
+	if err := s.validateTransactionIds(); err != nil {
+		return sdkerrors.Wrap(err, "transactions")
+	}
+	if err := s.validateAttestations(); err != nil {
+		return sdkerrors.Wrap(err, "attestations")
+	}
+	if err := s.validateCheckpointArchive(); err != nil {
+		return sdkerrors.Wrap(err, "checkpoint archive")
+	}
+	if err := s.validateBridgeTotals(); err != nil {
+		return sdkerrors.Wrap(err, "bridge totals")
+	}
+	if err := s.validateEthereumBlockTimeEstimate(); err != nil {
+		return sdkerrors.Wrap(err, "ethereum block time estimate")
+	}
+	if err := s.validateTimeoutCursors(); err != nil {
+		return sdkerrors.Wrap(err, "timeout cursors")
+	}
+	if err := s.validateBatchTxRemovals(); err != nil {
+		return sdkerrors.Wrap(err, "batch tx removals")
+	}
+	for _, forward := range s.PendingIbcAutoForwards {
+		if err := forward.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "pending ibc auto forward %d", forward.EventNonce)
+		}
+	}
+	for _, n := range s.LastEventNonces {
+		if _, err := sdk.ValAddressFromBech32(n.Validator); err != nil {
+			return sdkerrors.Wrapf(err, "last event nonce validator %s", n.Validator)
+		}
+	}
+	validators := make(map[string]struct{}, len(s.DelegateKeyNonces))
+	for _, n := range s.DelegateKeyNonces {
+		if _, err := sdk.ValAddressFromBech32(n.Validator); err != nil {
+			return sdkerrors.Wrapf(err, "delegate key nonce validator %s", n.Validator)
+		}
+		if _, ok := validators[n.Validator]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "delegate key nonce validator %s", n.Validator)
+		}
+		validators[n.Validator] = struct{}{}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces, caching the claim
+// of every attestation so that it can be inspected by ValidateBasic and InitGenesis
+func (s GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, att := range s.Attestations {
+		var claim EthereumClaim
+		if err := unpacker.UnpackAny(att.Claim, &claim); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateDelegateKeys requires every delegate key to be valid and each validator, orchestrator
+// and Ethereum address to be registered at most once
+func (s GenesisState) validateDelegateKeys() error {
+	validators := make(map[string]struct{}, len(s.DelegateKeys))
+	orchestrators := make(map[string]struct{}, len(s.DelegateKeys))
+	ethAddresses := make(map[string]struct{}, len(s.DelegateKeys))
+	for _, keys := range s.DelegateKeys {
+		keys := keys
+		if err := keys.ValidateAddresses(); err != nil {
+			return sdkerrors.Wrapf(err, "validator %s", keys.Validator)
+		}
+		// ValidateBasic has checked the address, normalize it so differently cased duplicates are caught
+		ethAddr, _ := NewEthAddress(keys.EthAddress)
+
+		if _, ok := validators[keys.Validator]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "validator %s", keys.Validator)
+		}
+		if _, ok := orchestrators[keys.Orchestrator]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "orchestrator %s", keys.Orchestrator)
+		}
+		if _, ok := ethAddresses[ethAddr.GetAddress().Hex()]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "ethereum address %s", keys.EthAddress)
+		}
+		validators[keys.Validator] = struct{}{}
+		orchestrators[keys.Orchestrator] = struct{}{}
+		ethAddresses[ethAddr.GetAddress().Hex()] = struct{}{}
+	}
+	return nil
+}
+
+// validateDelegateKeyHistory requires every rotation to belong to a validator with delegate keys, every
+// orchestrator and eth address, current or retired, to belong to a single validator and the eth address
+// validities to agree with them
+func (s GenesisState) validateDelegateKeyHistory() error {
+	orchestrators := make(map[string]string, len(s.DelegateKeys)+len(s.DelegateKeyRotations))
+	ethAddresses := make(map[string]string, len(s.DelegateKeys)+len(s.DelegateKeyRotations))
+	for _, keys := range s.DelegateKeys {
+		// validateDelegateKeys has checked the address
+		ethAddr, _ := NewEthAddress(keys.EthAddress)
+		orchestrators[keys.Orchestrator] = keys.Validator
+		ethAddresses[ethAddr.GetAddress().Hex()] = keys.Validator
+	}
+	rotations := make(map[string]struct{}, len(s.DelegateKeyRotations))
+	for _, rotation := range s.DelegateKeyRotations {
+		if _, err := sdk.ValAddressFromBech32(rotation.Validator); err != nil {
+			return sdkerrors.Wrapf(err, "validator %s", rotation.Validator)
+		}
+		if _, err := sdk.AccAddressFromBech32(rotation.PreviousOrchestrator); err != nil {
+			return sdkerrors.Wrapf(err, "previous orchestrator %s", rotation.PreviousOrchestrator)
+		}
+		ethAddr, err := NewEthAddress(rotation.PreviousEthAddress)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "previous ethereum address %s", rotation.PreviousEthAddress)
+		}
+		key := fmt.Sprintf("%s/%d", rotation.Validator, rotation.Height)
+		if _, ok := rotations[key]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "validator %s at height %d", rotation.Validator, rotation.Height)
+		}
+		rotations[key] = struct{}{}
+		if !s.hasDelegateKeys(rotation.Validator) {
+			return sdkerrors.Wrapf(ErrEmpty, "no delegate keys for validator %s", rotation.Validator)
+		}
+		if val, ok := orchestrators[rotation.PreviousOrchestrator]; ok && val != rotation.Validator {
+			return sdkerrors.Wrapf(ErrDuplicate, "orchestrator %s", rotation.PreviousOrchestrator)
+		}
+		if val, ok := ethAddresses[ethAddr.GetAddress().Hex()]; ok && val != rotation.Validator {
+			return sdkerrors.Wrapf(ErrDuplicate, "ethereum address %s", rotation.PreviousEthAddress)
+		}
+		orchestrators[rotation.PreviousOrchestrator] = rotation.Validator
+		ethAddresses[ethAddr.GetAddress().Hex()] = rotation.Validator
+	}
+	validities := make(map[string]struct{}, len(s.EthAddressValidities))
+	for _, validity := range s.EthAddressValidities {
+		ethAddr, err := NewEthAddress(validity.EthAddress)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "validity of ethereum address %s", validity.EthAddress)
+		}
+		if _, err := sdk.ValAddressFromBech32(validity.Validator); err != nil {
+			return sdkerrors.Wrapf(err, "validity validator %s", validity.Validator)
+		}
+		if _, ok := validities[ethAddr.GetAddress().Hex()]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "validity of ethereum address %s", validity.EthAddress)
+		}
+		validities[ethAddr.GetAddress().Hex()] = struct{}{}
+		if val, ok := ethAddresses[ethAddr.GetAddress().Hex()]; ok && val != validity.Validator {
+			return sdkerrors.Wrapf(ErrMismatched, "validity of ethereum address %s", validity.EthAddress)
+		}
+		if validity.EndHeight != 0 && validity.EndHeight < validity.StartHeight {
+			return sdkerrors.Wrapf(ErrInvalid, "validity of ethereum address %s ends before it starts", validity.EthAddress)
+		}
+	}
+	return nil
+}
+
+// hasDelegateKeys returns true if the genesis state contains the delegate keys of the validator
+func (s GenesisState) hasDelegateKeys(validator string) bool {
+	for _, keys := range s.DelegateKeys {
+		if keys.Validator == validator {
+			return true
+		}
+	}
+	return false
+}
+
+// validateErc20ToDenoms requires the cosmos originated ERC20 to denom mapping to be a bijection
+func (s GenesisState) validateErc20ToDenoms() error {
+	erc20s := make(map[string]struct{}, len(s.Erc20ToDenoms))
+	denoms := make(map[string]struct{}, len(s.Erc20ToDenoms))
+	for _, item := range s.Erc20ToDenoms {
+		erc20, err := NewEthAddress(item.Erc20)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "erc20 %s", item.Erc20)
+		}
+		if err := sdk.ValidateDenom(item.Denom); err != nil {
+			return sdkerrors.Wrapf(err, "denom %s", item.Denom)
+		}
+		if _, ok := erc20s[erc20.GetAddress().Hex()]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "erc20 %s is mapped more than once", item.Erc20)
+		}
+		if _, ok := denoms[item.Denom]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "denom %s is mapped more than once", item.Denom)
+		}
+		erc20s[erc20.GetAddress().Hex()] = struct{}{}
+		denoms[item.Denom] = struct{}{}
+	}
+	return nil
+}
+
+// validateConfirms requires every valset, batch and logic call confirm to reference a valset,
+// batch or logic call present in the genesis state
+func (s GenesisState) validateConfirms() error {
+	valsets := make(map[uint64]struct{}, len(s.Valsets))
+	for _, vs := range s.Valsets {
+		valsets[vs.Nonce] = struct{}{}
+	}
+	for _, conf := range s.ValsetConfirms {
+		if _, ok := valsets[conf.Nonce]; !ok {
+			return sdkerrors.Wrapf(ErrUnknown, "valset confirm by %s for missing valset %d", conf.Orchestrator, conf.Nonce)
+		}
+	}
+
+	batches := make(map[string]struct{}, len(s.Batches))
+	for _, batch := range s.Batches {
+		contract, err := NewEthAddress(batch.TokenContract)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "batch %d token contract", batch.BatchNonce)
+		}
+		batches[fmt.Sprintf("%s/%d", contract.GetAddress().Hex(), batch.BatchNonce)] = struct{}{}
+	}
+	for _, conf := range s.BatchConfirms {
+		contract, err := NewEthAddress(conf.TokenContract)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "batch confirm by %s token contract", conf.Orchestrator)
+		}
+		if _, ok := batches[fmt.Sprintf("%s/%d", contract.GetAddress().Hex(), conf.Nonce)]; !ok {
+			return sdkerrors.Wrapf(ErrUnknown, "batch confirm by %s for missing batch %s %d",
+				conf.Orchestrator, conf.TokenContract, conf.Nonce)
+		}
+	}
+
+	calls := make(map[string]struct{}, len(s.LogicCalls))
+	for _, call := range s.LogicCalls {
+		calls[fmt.Sprintf("%x/%d", call.InvalidationId, call.InvalidationNonce)] = struct{}{}
+	}
+	for _, conf := range s.LogicCallConfirms {
+		invalidationID, err := hex.DecodeString(conf.InvalidationId)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "logic call confirm by %s invalidation id", conf.Orchestrator)
+		}
+		if _, ok := calls[fmt.Sprintf("%x/%d", invalidationID, conf.InvalidationNonce)]; !ok {
+			return sdkerrors.Wrapf(ErrUnknown, "logic call confirm by %s for missing logic call %s %d",
+				conf.Orchestrator, conf.InvalidationId, conf.InvalidationNonce)
+		}
+	}
+	return nil
+}
+
+// validateTransactionIds requires every transaction to be either unbatched or in a single batch, and
+// every transaction id and batch nonce to have been issued by the counters in GravityNonces
+func (s GenesisState) validateTransactionIds() error {
+	txIds := make(map[uint64]struct{}, len(s.UnbatchedTransfers))
+	checkTx := func(tx OutgoingTransferTx) error {
+		if _, ok := txIds[tx.Id]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "transaction %d", tx.Id)
+		}
+		if tx.Id > s.GravityNonces.LastTxPoolId {
+			return sdkerrors.Wrapf(ErrInvalid, "transaction %d is above the last tx pool id %d", tx.Id, s.GravityNonces.LastTxPoolId)
+		}
+		txIds[tx.Id] = struct{}{}
+		return nil
+	}
+
+	for _, tx := range s.UnbatchedTransfers {
+		if err := checkTx(tx); err != nil {
+			return sdkerrors.Wrap(err, "unbatched")
+		}
+	}
+	for _, batch := range s.Batches {
+		if batch.BatchNonce > s.GravityNonces.LastBatchId {
+			return sdkerrors.Wrapf(ErrInvalid, "batch %d is above the last batch id %d", batch.BatchNonce, s.GravityNonces.LastBatchId)
+		}
+		for _, tx := range batch.Transactions {
+			if err := checkTx(tx); err != nil {
+				return sdkerrors.Wrapf(err, "batch %d", batch.BatchNonce)
+			}
+		}
+	}
+	return nil
+}
+
+// validateAttestations requires every vote to come from a validator with delegate keys and no
+// attestation to be observed beyond the last observed event nonce
+func (s GenesisState) validateAttestations() error {
+	validators := make(map[string]struct{}, len(s.DelegateKeys))
+	for _, keys := range s.DelegateKeys {
+		validators[keys.Validator] = struct{}{}
+	}
+
+	for _, att := range s.Attestations {
+		claim, ok := att.Claim.GetCachedValue().(EthereumClaim)
+		if !ok {
+			return sdkerrors.Wrapf(ErrInvalid, "attestation claim %s has not been unpacked", att.Claim.GetTypeUrl())
+		}
+		if att.Observed && claim.GetEventNonce() > s.GravityNonces.LastObservedNonce {
+			return sdkerrors.Wrapf(ErrInvalid, "attestation %d is observed beyond the last observed nonce %d",
+				claim.GetEventNonce(), s.GravityNonces.LastObservedNonce)
+		}
+		for _, vote := range att.Votes {
+			if _, ok := validators[vote]; !ok {
+				return sdkerrors.Wrapf(ErrUnknown, "attestation %d has a vote from validator %s without delegate keys",
+					claim.GetEventNonce(), vote)
+			}
+		}
+	}
+	return nil
+}
+
+// validateCheckpointArchive requires every archived checkpoint to be set once and to have a nonce if its type is
+// known, and the pruned nonces to be of a known type
+func (s GenesisState) validateCheckpointArchive() error {
+	checkpoints := make(map[string]struct{}, len(s.PastEthSignatureCheckpoints)+len(s.PastEthSignatureCheckpointRecords))
+	for _, checkpoint := range s.PastEthSignatureCheckpoints {
+		if len(checkpoint) == 0 {
+			return sdkerrors.Wrap(ErrEmpty, "checkpoint")
+		}
+		checkpoints[string(checkpoint)] = struct{}{}
+	}
+	for _, record := range s.PastEthSignatureCheckpointRecords {
+		if len(record.Checkpoint) == 0 {
+			return sdkerrors.Wrap(ErrEmpty, "checkpoint")
+		}
+		if _, ok := checkpoints[string(record.Checkpoint)]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "checkpoint %X", record.Checkpoint)
+		}
+		checkpoints[string(record.Checkpoint)] = struct{}{}
+		if _, ok := CheckpointType_name[int32(record.Type)]; !ok {
+			return sdkerrors.Wrapf(ErrInvalid, "checkpoint %X has unknown type %d", record.Checkpoint, record.Type)
+		}
+		if record.Type != CHECKPOINT_TYPE_UNSPECIFIED && record.Nonce == 0 {
+			return sdkerrors.Wrapf(ErrInvalid, "checkpoint %X has no nonce", record.Checkpoint)
+		}
+		if record.Type == CHECKPOINT_TYPE_BATCH {
+			if err := ValidateEthAddress(record.TokenContract); err != nil {
+				return sdkerrors.Wrapf(err, "token contract of checkpoint %X", record.Checkpoint)
+			}
+		}
+	}
+	for _, pruned := range s.PrunedCheckpointNonces {
+		if _, ok := CheckpointType_name[int32(pruned.Type)]; !ok || pruned.Type == CHECKPOINT_TYPE_UNSPECIFIED {
+			return sdkerrors.Wrapf(ErrInvalid, "pruned nonce of unknown checkpoint type %d", pruned.Type)
+		}
+		if pruned.Type == CHECKPOINT_TYPE_BATCH {
+			if err := ValidateEthAddress(pruned.TokenContract); err != nil {
+				return sdkerrors.Wrap(err, "token contract of pruned batch nonce")
+			}
+		}
+	}
+	return nil
+}
+
+// validateBridgeTotals requires the bridge totals to be of distinct tokens and to not be negative
+func (s GenesisState) validateBridgeTotals() error {
+	tokens := make(map[string]struct{}, len(s.BridgeTotals))
+	for _, totals := range s.BridgeTotals {
+		contract, err := NewEthAddress(totals.TokenContract)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "token contract %s", totals.TokenContract)
+		}
+		if _, ok := tokens[contract.GetAddress().Hex()]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "token contract %s", totals.TokenContract)
+		}
+		tokens[contract.GetAddress().Hex()] = struct{}{}
+		for _, amount := range []sdk.Int{totals.Deposited, totals.Withdrawn, totals.CommunityPool, totals.OpeningOutstanding} {
+			if amount.IsNil() || amount.IsNegative() {
+				return sdkerrors.Wrapf(ErrInvalid, "token contract %s has a negative or missing total", totals.TokenContract)
+			}
+		}
+	}
+	return nil
+}
+
+// validateEthereumBlockTimeEstimate requires an estimate to have been sampled exactly when it has a block time, and
+// every sample to be taken after the start of the first one
+func (s GenesisState) validateEthereumBlockTimeEstimate() error {
+	estimate := s.EthereumBlockTimeEstimate
+	if (estimate.Samples == 0) != (estimate.AverageEthereumBlockTime == 0) {
+		return sdkerrors.Wrapf(ErrInvalid, "%d samples with an average block time of %d", estimate.Samples, estimate.AverageEthereumBlockTime)
+	}
+	if estimate.Samples != 0 && (estimate.CosmosBlockTime == 0 || estimate.EthereumBlockHeight == 0) {
+		return sdkerrors.Wrap(ErrInvalid, "sampled estimate without a last sample")
+	}
+	return nil
+}
+
+// validateTimeoutCursors requires the cursors to be empty or to have the length of a batch key, a token contract
+// followed by a nonce, and at least the length of a logic call key nonce respectively
+func (s GenesisState) validateTimeoutCursors() error {
+	if len(s.BatchTimeoutCursor) != 0 && len(s.BatchTimeoutCursor) != gethcommon.AddressLength+8 {
+		return sdkerrors.Wrapf(ErrInvalid, "batch timeout cursor %X", s.BatchTimeoutCursor)
+	}
+	if len(s.LogicCallTimeoutCursor) != 0 && len(s.LogicCallTimeoutCursor) < 8 {
+		return sdkerrors.Wrapf(ErrInvalid, "logic call timeout cursor %X", s.LogicCallTimeoutCursor)
+	}
+	return nil
+}
+
+// validateBatchTxRemovals requires the transactions recorded for removal to be in their batch
+func (s GenesisState) validateBatchTxRemovals() error {
+	batches := make(map[string]map[uint64]struct{}, len(s.Batches))
+	for _, batch := range s.Batches {
+		contract, err := NewEthAddress(batch.TokenContract)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "batch %d token contract", batch.BatchNonce)
+		}
+		ids := make(map[uint64]struct{}, len(batch.Transactions))
+		for _, tx := range batch.Transactions {
+			ids[tx.Id] = struct{}{}
+		}
+		batches[string(GetOutgoingTxBatchKey(*contract, batch.BatchNonce))] = ids
+	}
+	seen := make(map[string]struct{}, len(s.BatchTxRemovals))
+	for _, removals := range s.BatchTxRemovals {
+		contract, err := NewEthAddress(removals.TokenContract)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "token contract %s", removals.TokenContract)
+		}
+		key := string(GetOutgoingTxBatchKey(*contract, removals.BatchNonce))
+		ids, ok := batches[key]
+		if !ok {
+			return sdkerrors.Wrapf(ErrUnknown, "batch %d of token %s", removals.BatchNonce, removals.TokenContract)
+		}
+		if len(removals.TxIds) == 0 {
+			return sdkerrors.Wrapf(ErrEmpty, "removals of batch %d", removals.BatchNonce)
+		}
+		for _, id := range removals.TxIds {
+			if _, ok := ids[id]; !ok {
+				return sdkerrors.Wrapf(ErrInvalid, "tx %d is not in batch %d or listed twice", id, removals.BatchNonce)
+			}
+			delete(ids, id)
+		}
+		if _, ok := seen[key]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "removals of batch %d", removals.BatchNonce)
+		}
+		seen[key] = struct{}{}
+	}
 	return nil
 }
 
@@ -132,6 +595,24 @@ func DefaultGenesisState() *GenesisState {
 		DelegateKeys:       []MsgSetOrchestratorAddress{},
 		Erc20ToDenoms:      []ERC20ToDenom{},
 		UnbatchedTransfers: []OutgoingTransferTx{},
+
+		PendingIbcAutoForwards:      []PendingIbcAutoForward{},
+		PastEthSignatureCheckpoints: [][]byte{},
+		LastObservedEthereumHeight:  LastObservedEthereumBlockHeight{},
+		LastObservedValset:          nil,
+		LastEventNonces:             []LastEventNonceByValidator{},
+		LatestValsetTime:            time.Time{},
+		DelegateKeyNonces:           []DelegateKeyNonce{},
+		DelegateKeyRotations:        []DelegateKeyRotation{},
+		EthAddressValidities:        []EthAddressValidity{},
+
+		PastEthSignatureCheckpointRecords: []PastEthSignatureCheckpoint{},
+		PrunedCheckpointNonces:            []PrunedCheckpointNonce{},
+		BridgeTotals:                      []BridgeTotals{},
+		EthereumBlockTimeEstimate:         EthereumBlockTimeEstimate{},
+		BatchTimeoutCursor:                nil,
+		LogicCallTimeoutCursor:            nil,
+		BatchTxRemovals:                   []BatchTxRemovals{},
 	}
 }
 
@@ -156,6 +637,12 @@ func DefaultParams() *Params {
 		ValsetReward:                 sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
 		BridgeActive:                 true,
 		EthereumBlacklist:            []string{},
+		ValsetPowerDiffThreshold:     sdk.NewDecWithPrec(5, 2),
+		MaxValsetAgeBlocks:           0,
+		MaxValsetAgeTime:             0,
+		MaxBadSignatureEvidenceAge:   0,
+		CheckpointRetentionWindow:    0,
+		MaxEndBlockerItems:           1000,
 	}
 }
 
@@ -209,9 +696,43 @@ func (p Params) ValidateBasic() error {
 	if err := validateValsetRewardAmount(p.ValsetReward); err != nil {
 		return sdkerrors.Wrap(err, "ValsetReward amount")
 	}
+	if err := validateValsetPowerDiffThreshold(p.ValsetPowerDiffThreshold); err != nil {
+		return sdkerrors.Wrap(err, "valset power diff threshold")
+	}
+	if err := validateMaxValsetAgeBlocks(p.MaxValsetAgeBlocks); err != nil {
+		return sdkerrors.Wrap(err, "max valset age blocks")
+	}
+	if err := validateMaxValsetAgeTime(p.MaxValsetAgeTime); err != nil {
+		return sdkerrors.Wrap(err, "max valset age time")
+	}
+	if err := validateMaxBadSignatureEvidenceAge(p.MaxBadSignatureEvidenceAge); err != nil {
+		return sdkerrors.Wrap(err, "max bad signature evidence age")
+	}
+	if err := validateCheckpointRetentionWindow(p.CheckpointRetentionWindow); err != nil {
+		return sdkerrors.Wrap(err, "checkpoint retention window")
+	}
+	if window := p.CheckpointRetentionWindow; window != 0 && window < p.MinCheckpointRetentionWindow() {
+		return sdkerrors.Wrapf(ErrInvalid, "checkpoint retention window %d is shorter than %d", window, p.MinCheckpointRetentionWindow())
+	}
+	if err := validateMaxEndBlockerItems(p.MaxEndBlockerItems); err != nil {
+		return sdkerrors.Wrap(err, "max end blocker items")
+	}
 	return nil
 }
 
+// MinCheckpointRetentionWindow returns the shortest CheckpointRetentionWindow other than zero, a checkpoint has to be
+// kept for as long as validators may still sign it and as long as bad signature evidence made with a replaced key
+// is accepted
+func (p Params) MinCheckpointRetentionWindow() uint64 {
+	min := p.SignedValsetsWindow
+	for _, window := range []uint64{p.SignedBatchesWindow, p.SignedLogicCallsWindow, p.MaxBadSignatureEvidenceAge} {
+		if window > min {
+			min = window
+		}
+	}
+	return min
+}
+
 // ParamKeyTable for auth module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{
@@ -234,6 +755,12 @@ func ParamKeyTable() paramtypes.KeyTable {
 			Denom:  "",
 			Amount: sdk.Int{},
 		},
+		ValsetPowerDiffThreshold: sdk.Dec{},
+		MaxValsetAgeBlocks:         0,
+		MaxValsetAgeTime:           0,
+		MaxBadSignatureEvidenceAge: 0,
+		CheckpointRetentionWindow:  0,
+		MaxEndBlockerItems:         0,
 	})
 }
 
@@ -258,6 +785,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreValsetRewardAmount, &p.ValsetReward, validateValsetRewardAmount),
 		paramtypes.NewParamSetPair(ParamStoreBridgeActive, &p.BridgeActive, validateBridgeActive),
 		paramtypes.NewParamSetPair(ParamStoreEthereumBlacklist, &p.EthereumBlacklist, validateEthereumBlacklistAddresses),
+		paramtypes.NewParamSetPair(ParamStoreValsetPowerDiffThreshold, &p.ValsetPowerDiffThreshold, validateValsetPowerDiffThreshold),
+		paramtypes.NewParamSetPair(ParamStoreMaxValsetAgeBlocks, &p.MaxValsetAgeBlocks, validateMaxValsetAgeBlocks),
+		paramtypes.NewParamSetPair(ParamStoreMaxValsetAgeTime, &p.MaxValsetAgeTime, validateMaxValsetAgeTime),
+		paramtypes.NewParamSetPair(ParamStoreMaxBadSignatureEvidenceAge, &p.MaxBadSignatureEvidenceAge, validateMaxBadSignatureEvidenceAge),
+		paramtypes.NewParamSetPair(ParamStoreCheckpointRetentionWindow, &p.CheckpointRetentionWindow, validateCheckpointRetentionWindow),
+		paramtypes.NewParamSetPair(ParamStoreMaxEndBlockerItems, &p.MaxEndBlockerItems, validateMaxEndBlockerItems),
 	}
 }
 
@@ -433,6 +966,64 @@ func validateEthereumBlacklistAddresses(i interface{}) error {
 	return nil
 }
 
+func validateValsetPowerDiffThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("valset power diff threshold must be positive")
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("valset power diff threshold must not be greater than one")
+	}
+	return nil
+}
+
+func validateMaxValsetAgeBlocks(i interface{}) error {
+	// zero disables the block based valset age limit
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxValsetAgeTime(i interface{}) error {
+	// zero disables the time based valset age limit
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxBadSignatureEvidenceAge(i interface{}) error {
+	// zero disables the bad signature evidence age limit
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateCheckpointRetentionWindow(i interface{}) error {
+	// zero keeps every checkpoint forever
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxEndBlockerItems(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// the EndBlocker would never make progress
+	if v == 0 {
+		return fmt.Errorf("max end blocker items must be positive")
+	}
+	return nil
+}
+
 func strToFixByteArray(s string) ([32]byte, error) {
 	var out [32]byte
 	if len([]byte(s)) > 32 {
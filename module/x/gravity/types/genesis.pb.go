@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -56,6 +60,38 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // set and steal funds on Ethereum without consequence.
 // The practical outcome of this flag being set to 'false' is that deposits from Ethereum will not show up and withdraws from
 // Cosmos will not execute on Ethereum.
+//
+// valset_power_diff_threshold
+//
+// The fraction of total voting power that must have changed between the current validator set and the latest
+// validator set request before a new validator set request is created. Should be between 0 (exclusive) and 1.
+//
+// max_valset_age_blocks
+// max_valset_age_time
+//
+// The maximum age of the latest validator set request, in Cosmos blocks and in milliseconds respectively.
+// Once either limit is crossed a new validator set request is created regardless of how much power has
+// changed, this keeps the powers on Ethereum from going stale under slow drift. A value of zero disables the limit.
+//
+// max_bad_signature_evidence_age
+//
+// The number of Cosmos blocks after an Ethereum key stopped being the key of its validator, because the validator
+// rotated its delegate keys, after which bad signature evidence made with that key is rejected. The signed subject
+// is made up by the offender and can not tell when it was signed, so the age is counted from the end of the key's
+// validity instead. A value of zero disables the limit.
+//
+// checkpoint_retention_window
+//
+// The number of Cosmos blocks the checkpoint of a validator set, batch or logic call is kept for after its creation.
+// Once a checkpoint is pruned, bad signature evidence over a subject of the same type with an equal or lower nonce is
+// rejected, since a genuine signature could no longer be told apart from a bad one. A value of zero keeps every
+// checkpoint forever (archive mode).
+//
+// max_end_blocker_items
+//
+// The maximum number of items each phase of the EndBlocker processes in a single block: attestations tallied and
+// pruned, batches and logic calls checked for a timeout or slashed, valsets slashed or pruned and checkpoints pruned.
+// A phase with more work left continues where it stopped in the next block.
 type Params struct {
 	GravityId                    string                                 `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
 	ContractSourceHash           string                                 `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
@@ -76,7 +112,13 @@ type Params struct {
 	BridgeActive                 bool                                   `protobuf:"varint,18,opt,name=bridge_active,json=bridgeActive,proto3" json:"bridge_active,omitempty"`
 	// addresses on this blacklist are forbidden from depositing or withdrawing
 	// from Ethereum to the bridge
-	EthereumBlacklist []string `protobuf:"bytes,19,rep,name=ethereum_blacklist,json=ethereumBlacklist,proto3" json:"ethereum_blacklist,omitempty"`
+	EthereumBlacklist          []string                               `protobuf:"bytes,19,rep,name=ethereum_blacklist,json=ethereumBlacklist,proto3" json:"ethereum_blacklist,omitempty"`
+	ValsetPowerDiffThreshold   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=valset_power_diff_threshold,json=valsetPowerDiffThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valset_power_diff_threshold"`
+	MaxValsetAgeBlocks         uint64                                 `protobuf:"varint,21,opt,name=max_valset_age_blocks,json=maxValsetAgeBlocks,proto3" json:"max_valset_age_blocks,omitempty"`
+	MaxValsetAgeTime           uint64                                 `protobuf:"varint,22,opt,name=max_valset_age_time,json=maxValsetAgeTime,proto3" json:"max_valset_age_time,omitempty"`
+	MaxBadSignatureEvidenceAge uint64                                 `protobuf:"varint,23,opt,name=max_bad_signature_evidence_age,json=maxBadSignatureEvidenceAge,proto3" json:"max_bad_signature_evidence_age,omitempty"`
+	CheckpointRetentionWindow  uint64                                 `protobuf:"varint,24,opt,name=checkpoint_retention_window,json=checkpointRetentionWindow,proto3" json:"checkpoint_retention_window,omitempty"`
+	MaxEndBlockerItems         uint64                                 `protobuf:"varint,25,opt,name=max_end_blocker_items,json=maxEndBlockerItems,proto3" json:"max_end_blocker_items,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -210,6 +252,41 @@ func (m *Params) GetEthereumBlacklist() []string {
 	return nil
 }
 
+func (m *Params) GetMaxValsetAgeBlocks() uint64 {
+	if m != nil {
+		return m.MaxValsetAgeBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxValsetAgeTime() uint64 {
+	if m != nil {
+		return m.MaxValsetAgeTime
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBadSignatureEvidenceAge() uint64 {
+	if m != nil {
+		return m.MaxBadSignatureEvidenceAge
+	}
+	return 0
+}
+
+func (m *Params) GetCheckpointRetentionWindow() uint64 {
+	if m != nil {
+		return m.CheckpointRetentionWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxEndBlockerItems() uint64 {
+	if m != nil {
+		return m.MaxEndBlockerItems
+	}
+	return 0
+}
+
 // GenesisState struct, containing all persistant data required by the Gravity module
 type GenesisState struct {
 	Params             *Params                     `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
@@ -224,6 +301,39 @@ type GenesisState struct {
 	DelegateKeys       []MsgSetOrchestratorAddress `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys"`
 	Erc20ToDenoms      []ERC20ToDenom              `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms"`
 	UnbatchedTransfers []OutgoingTransferTx        `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers"`
+	// the queue of SendToCosmos deposits waiting to be forwarded over IBC
+	PendingIbcAutoForwards []PendingIbcAutoForward `protobuf:"bytes,13,rep,name=pending_ibc_auto_forwards,json=pendingIbcAutoForwards,proto3" json:"pending_ibc_auto_forwards"`
+	// every valset, batch and logic call checkpoint ever created, required to judge bad signature evidence
+	PastEthSignatureCheckpoints [][]byte                        `protobuf:"bytes,14,rep,name=past_eth_signature_checkpoints,json=pastEthSignatureCheckpoints,proto3" json:"past_eth_signature_checkpoints,omitempty"`
+	LastObservedEthereumHeight  LastObservedEthereumBlockHeight `protobuf:"bytes,15,opt,name=last_observed_ethereum_height,json=lastObservedEthereumHeight,proto3" json:"last_observed_ethereum_height"`
+	// the last valset observed on Ethereum, unset if no valset update has been observed yet
+	LastObservedValset *Valset `protobuf:"bytes,16,opt,name=last_observed_valset,json=lastObservedValset,proto3" json:"last_observed_valset,omitempty"`
+	// the last event nonce submitted by each validator
+	LastEventNonces []LastEventNonceByValidator `protobuf:"bytes,17,rep,name=last_event_nonces,json=lastEventNonces,proto3" json:"last_event_nonces"`
+	// the block time at which the latest valset request was created
+	LatestValsetTime time.Time `protobuf:"bytes,18,opt,name=latest_valset_time,json=latestValsetTime,proto3,stdtime" json:"latest_valset_time"`
+	// the delegate key nonce of each validator which has set its delegate keys with a message
+	DelegateKeyNonces []DelegateKeyNonce `protobuf:"bytes,19,rep,name=delegate_key_nonces,json=delegateKeyNonces,proto3" json:"delegate_key_nonces"`
+	// every delegate key rotation, ordered by validator and height
+	DelegateKeyRotations []DelegateKeyRotation `protobuf:"bytes,20,rep,name=delegate_key_rotations,json=delegateKeyRotations,proto3" json:"delegate_key_rotations"`
+	// the validator and validity height range of every eth address ever registered
+	EthAddressValidities []EthAddressValidity `protobuf:"bytes,21,rep,name=eth_address_validities,json=ethAddressValidities,proto3" json:"eth_address_validities"`
+	// the checkpoint archive with the creation height and type of every checkpoint, checkpoints in
+	// past_eth_signature_checkpoints are imported as records of an unspecified type which are never pruned
+	PastEthSignatureCheckpointRecords []PastEthSignatureCheckpoint `protobuf:"bytes,22,rep,name=past_eth_signature_checkpoint_records,json=pastEthSignatureCheckpointRecords,proto3" json:"past_eth_signature_checkpoint_records"`
+	// the highest nonce of each checkpoint type, per token for batches and per invalidation id for logic calls, pruned
+	// from the archive
+	PrunedCheckpointNonces []PrunedCheckpointNonce `protobuf:"bytes,23,rep,name=pruned_checkpoint_nonces,json=prunedCheckpointNonces,proto3" json:"pruned_checkpoint_nonces"`
+	// the lifetime bridge totals of every token which has crossed the bridge since they are counted
+	BridgeTotals []BridgeTotals `protobuf:"bytes,24,rep,name=bridge_totals,json=bridgeTotals,proto3" json:"bridge_totals"`
+	// the moving average of the Ethereum block time used to project Ethereum timeout heights
+	EthereumBlockTimeEstimate EthereumBlockTimeEstimate `protobuf:"bytes,25,opt,name=ethereum_block_time_estimate,json=ethereumBlockTimeEstimate,proto3" json:"ethereum_block_time_estimate"`
+	// the keys of the last batch and logic call checked for a timeout by the EndBlocker, the next block continues
+	// after them, empty when the next block starts over from the first one
+	BatchTimeoutCursor     []byte `protobuf:"bytes,26,opt,name=batch_timeout_cursor,json=batchTimeoutCursor,proto3" json:"batch_timeout_cursor,omitempty"`
+	LogicCallTimeoutCursor []byte `protobuf:"bytes,27,opt,name=logic_call_timeout_cursor,json=logicCallTimeoutCursor,proto3" json:"logic_call_timeout_cursor,omitempty"`
+	// the transactions cancel batch proposals remove from batches which have not been cancelled yet
+	BatchTxRemovals []BatchTxRemovals `protobuf:"bytes,28,rep,name=batch_tx_removals,json=batchTxRemovals,proto3" json:"batch_tx_removals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -343,6 +453,224 @@ func (m *GenesisState) GetUnbatchedTransfers() []OutgoingTransferTx {
 	return nil
 }
 
+func (m *GenesisState) GetPendingIbcAutoForwards() []PendingIbcAutoForward {
+	if m != nil {
+		return m.PendingIbcAutoForwards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPastEthSignatureCheckpoints() [][]byte {
+	if m != nil {
+		return m.PastEthSignatureCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastObservedEthereumHeight() LastObservedEthereumBlockHeight {
+	if m != nil {
+		return m.LastObservedEthereumHeight
+	}
+	return LastObservedEthereumBlockHeight{}
+}
+
+func (m *GenesisState) GetLastObservedValset() *Valset {
+	if m != nil {
+		return m.LastObservedValset
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastEventNonces() []LastEventNonceByValidator {
+	if m != nil {
+		return m.LastEventNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLatestValsetTime() time.Time {
+	if m != nil {
+		return m.LatestValsetTime
+	}
+	return time.Time{}
+}
+
+func (m *GenesisState) GetDelegateKeyNonces() []DelegateKeyNonce {
+	if m != nil {
+		return m.DelegateKeyNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegateKeyRotations() []DelegateKeyRotation {
+	if m != nil {
+		return m.DelegateKeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEthAddressValidities() []EthAddressValidity {
+	if m != nil {
+		return m.EthAddressValidities
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPastEthSignatureCheckpointRecords() []PastEthSignatureCheckpoint {
+	if m != nil {
+		return m.PastEthSignatureCheckpointRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPrunedCheckpointNonces() []PrunedCheckpointNonce {
+	if m != nil {
+		return m.PrunedCheckpointNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgeTotals() []BridgeTotals {
+	if m != nil {
+		return m.BridgeTotals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEthereumBlockTimeEstimate() EthereumBlockTimeEstimate {
+	if m != nil {
+		return m.EthereumBlockTimeEstimate
+	}
+	return EthereumBlockTimeEstimate{}
+}
+
+func (m *GenesisState) GetBatchTimeoutCursor() []byte {
+	if m != nil {
+		return m.BatchTimeoutCursor
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLogicCallTimeoutCursor() []byte {
+	if m != nil {
+		return m.LogicCallTimeoutCursor
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBatchTxRemovals() []BatchTxRemovals {
+	if m != nil {
+		return m.BatchTxRemovals
+	}
+	return nil
+}
+
+// LastEventNonceByValidator is the last event nonce a validator has submitted a claim for
+type LastEventNonceByValidator struct {
+	Validator  string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	EventNonce uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+}
+
+func (m *LastEventNonceByValidator) Reset()         { *m = LastEventNonceByValidator{} }
+func (m *LastEventNonceByValidator) String() string { return proto.CompactTextString(m) }
+func (*LastEventNonceByValidator) ProtoMessage()    {}
+func (*LastEventNonceByValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{2}
+}
+func (m *LastEventNonceByValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastEventNonceByValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastEventNonceByValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LastEventNonceByValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastEventNonceByValidator.Merge(m, src)
+}
+func (m *LastEventNonceByValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastEventNonceByValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastEventNonceByValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastEventNonceByValidator proto.InternalMessageInfo
+
+func (m *LastEventNonceByValidator) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *LastEventNonceByValidator) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+// DelegateKeyNonce is the number of times a validator has set its delegate keys with a message
+type DelegateKeyNonce struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Nonce     uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *DelegateKeyNonce) Reset()         { *m = DelegateKeyNonce{} }
+func (m *DelegateKeyNonce) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeyNonce) ProtoMessage()    {}
+func (*DelegateKeyNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{3}
+}
+func (m *DelegateKeyNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateKeyNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeyNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegateKeyNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeyNonce.Merge(m, src)
+}
+func (m *DelegateKeyNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateKeyNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeyNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateKeyNonce proto.InternalMessageInfo
+
+func (m *DelegateKeyNonce) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *DelegateKeyNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 // GravityCounters contains the many noces and counters required to maintain the bridge state in the genesis
 type GravityNonces struct {
 	// the nonce of the last generated validator set
@@ -363,13 +691,16 @@ type GravityNonces struct {
 	// the last batch id from the Gravity batch pool, this prevents ID duplication
 	// during chain upgrades
 	LastBatchId uint64 `protobuf:"varint,7,opt,name=last_batch_id,json=lastBatchId,proto3" json:"last_batch_id,omitempty"`
+	// the last Cosmos block at which a validator began unbonding, used for
+	// unbonding valset slashing
+	LastUnbondingBlockHeight uint64 `protobuf:"varint,8,opt,name=last_unbonding_block_height,json=lastUnbondingBlockHeight,proto3" json:"last_unbonding_block_height,omitempty"`
 }
 
 func (m *GravityNonces) Reset()         { *m = GravityNonces{} }
 func (m *GravityNonces) String() string { return proto.CompactTextString(m) }
 func (*GravityNonces) ProtoMessage()    {}
 func (*GravityNonces) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{2}
+	return fileDescriptor_387b0aba880adb60, []int{4}
 }
 func (m *GravityNonces) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,90 +778,142 @@ func (m *GravityNonces) GetLastBatchId() uint64 {
 	return 0
 }
 
+func (m *GravityNonces) GetLastUnbondingBlockHeight() uint64 {
+	if m != nil {
+		return m.LastUnbondingBlockHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
 	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
+	proto.RegisterType((*LastEventNonceByValidator)(nil), "gravity.v1.LastEventNonceByValidator")
+	proto.RegisterType((*DelegateKeyNonce)(nil), "gravity.v1.DelegateKeyNonce")
 	proto.RegisterType((*GravityNonces)(nil), "gravity.v1.GravityNonces")
 }
 
 func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
 
 var fileDescriptor_387b0aba880adb60 = []byte{
-	// 1169 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x4f, 0x1b, 0x47,
-	0x14, 0xc7, 0xe0, 0x18, 0x3c, 0xb6, 0x21, 0x0c, 0x7f, 0x32, 0xfc, 0x33, 0x16, 0x55, 0x22, 0xab,
-	0x2a, 0x36, 0xb8, 0x52, 0xab, 0xb4, 0xaa, 0x5a, 0x6c, 0x48, 0x82, 0xd2, 0x14, 0x64, 0xd3, 0x56,
-	0xed, 0x65, 0x3b, 0xde, 0x1d, 0xd6, 0x2b, 0xd6, 0x3b, 0x68, 0x67, 0x6c, 0xe0, 0xd6, 0x6b, 0x6f,
-	0xfd, 0x58, 0x39, 0xe6, 0x58, 0xb5, 0x55, 0x54, 0xc1, 0x17, 0xe8, 0x47, 0x88, 0xe6, 0xcd, 0xec,
-	0x7a, 0xec, 0x70, 0xe2, 0x84, 0x79, 0xbf, 0x3f, 0xef, 0xed, 0x9b, 0xb7, 0x6f, 0x07, 0x11, 0x3f,
-	0xa6, 0xc3, 0x40, 0xde, 0xd4, 0x87, 0xfb, 0x75, 0x9f, 0x45, 0x4c, 0x04, 0xa2, 0x76, 0x19, 0x73,
-	0xc9, 0x31, 0x32, 0x48, 0x6d, 0xb8, 0xbf, 0xbe, 0xec, 0x73, 0x9f, 0x43, 0xb8, 0xae, 0x7e, 0x69,
-	0xc6, 0xfa, 0xaa, 0xa5, 0x95, 0x37, 0x97, 0xcc, 0x28, 0xd7, 0x57, 0xac, 0x78, 0x5f, 0xf8, 0xe2,
-	0x1e, 0x7a, 0x97, 0x4a, 0xb7, 0x67, 0xe2, 0x9b, 0x56, 0x9c, 0x4a, 0xc9, 0x84, 0xa4, 0x32, 0xe0,
-	0x91, 0x41, 0xcb, 0x2e, 0x17, 0x7d, 0x2e, 0xea, 0x5d, 0x2a, 0x58, 0x7d, 0xb8, 0xdf, 0x65, 0x92,
-	0xee, 0xd7, 0x5d, 0x1e, 0x18, 0x7c, 0xe7, 0x8f, 0x3c, 0xca, 0x9d, 0xd2, 0x98, 0xf6, 0x05, 0xde,
-	0x42, 0x49, 0xcd, 0x4e, 0xe0, 0x91, 0x4c, 0x25, 0x53, 0xcd, 0xb7, 0xf3, 0x26, 0x72, 0xec, 0xe1,
-	0x3d, 0xb4, 0xec, 0xf2, 0x48, 0xc6, 0xd4, 0x95, 0x8e, 0xe0, 0x83, 0xd8, 0x65, 0x4e, 0x8f, 0x8a,
-	0x1e, 0x99, 0x06, 0x22, 0x4e, 0xb0, 0x0e, 0x40, 0xaf, 0xa8, 0xe8, 0xe1, 0x2f, 0xd0, 0x93, 0x6e,
-	0x1c, 0x78, 0x3e, 0x73, 0x98, 0xec, 0xb1, 0x98, 0x0d, 0xfa, 0x0e, 0xf5, 0xbc, 0x98, 0x09, 0x41,
-	0xb2, 0x20, 0x5a, 0xd1, 0xf0, 0x91, 0x41, 0x0f, 0x34, 0x88, 0x9f, 0xa1, 0x05, 0xa3, 0x73, 0x7b,
-	0x34, 0x88, 0x54, 0x35, 0x8f, 0x2a, 0x99, 0x6a, 0xb6, 0x5d, 0xd2, 0xe1, 0x96, 0x8a, 0x1e, 0x7b,
-	0xb8, 0x81, 0x56, 0x44, 0xe0, 0x47, 0xcc, 0x73, 0x86, 0x34, 0x14, 0x4c, 0x0a, 0xe7, 0x2a, 0x88,
-	0x3c, 0x7e, 0x45, 0x72, 0xc0, 0x5e, 0xd2, 0xe0, 0x4f, 0x1a, 0xfb, 0x19, 0x20, 0x4b, 0x03, 0x3d,
-	0x64, 0xa9, 0x66, 0xd6, 0xd6, 0x34, 0x35, 0x66, 0x34, 0xcf, 0xd1, 0x9a, 0xd1, 0x84, 0xdc, 0x0f,
-	0x5c, 0xc7, 0xa5, 0x61, 0x98, 0xea, 0xe6, 0x40, 0xb7, 0xaa, 0x09, 0xdf, 0x2b, 0xbc, 0xa5, 0x60,
-	0x23, 0xdd, 0x43, 0xcb, 0x92, 0xc6, 0x3e, 0x93, 0x3a, 0x9d, 0x23, 0x83, 0x3e, 0xe3, 0x03, 0x49,
-	0xf2, 0xa0, 0xc2, 0x1a, 0x83, 0x6c, 0x67, 0x1a, 0xc1, 0x9f, 0x21, 0x4c, 0x87, 0x2c, 0xa6, 0x3e,
-	0x73, 0xba, 0x21, 0x77, 0x2f, 0x40, 0x42, 0x10, 0xf0, 0x1f, 0x1b, 0xa4, 0xa9, 0x00, 0x25, 0xc0,
-	0xdf, 0xa0, 0x8d, 0x84, 0x9d, 0xf6, 0xd8, 0x92, 0x15, 0x40, 0x46, 0x0c, 0x25, 0xe9, 0xf3, 0x48,
-	0xde, 0x45, 0x2b, 0x22, 0xa4, 0xa2, 0xe7, 0x9c, 0xab, 0xa3, 0x0b, 0x78, 0x64, 0x3a, 0x49, 0x8a,
-	0x95, 0x4c, 0xb5, 0xd8, 0xac, 0xbd, 0x7d, 0xbf, 0x3d, 0xf5, 0xf7, 0xfb, 0xed, 0x67, 0x7e, 0x20,
-	0x7b, 0x83, 0x6e, 0xcd, 0xe5, 0xfd, 0xba, 0x99, 0x27, 0xfd, 0x67, 0x57, 0x78, 0x17, 0x66, 0x76,
-	0x0f, 0x99, 0xdb, 0x5e, 0x02, 0xb3, 0x17, 0xc6, 0x4b, 0x37, 0x1e, 0xff, 0x86, 0x96, 0x27, 0x72,
-	0x40, 0x2b, 0x48, 0xe9, 0x41, 0x29, 0xf0, 0x58, 0x0a, 0xe8, 0x1c, 0x0e, 0xd0, 0xda, 0x44, 0x86,
-	0xd1, 0x39, 0x91, 0xf9, 0x07, 0xa5, 0x59, 0x1d, 0x4b, 0x93, 0x1e, 0x2b, 0x6e, 0xa1, 0xf2, 0x20,
-	0xea, 0xf2, 0xc8, 0x73, 0x80, 0x10, 0x44, 0xfe, 0xe4, 0xec, 0x2d, 0x40, 0xcb, 0x37, 0x34, 0xab,
-	0x63, 0x48, 0xe3, 0x33, 0x38, 0x44, 0x95, 0x8f, 0x3a, 0xe2, 0xa9, 0xf3, 0x73, 0xd4, 0x14, 0x51,
-	0x39, 0x88, 0x19, 0x79, 0xfc, 0xa0, 0xb2, 0x37, 0x27, 0xba, 0xe3, 0x1d, 0xc9, 0x5e, 0x27, 0xf1,
-	0xc4, 0x87, 0xa8, 0xa4, 0x8b, 0x75, 0x62, 0x76, 0x45, 0x63, 0x8f, 0x2c, 0x56, 0x32, 0xd5, 0x42,
-	0x63, 0xad, 0xa6, 0xbd, 0x6a, 0x6a, 0x47, 0xd4, 0xcc, 0x8e, 0xa8, 0xb5, 0x78, 0x10, 0x35, 0xb3,
-	0x2a, 0x7f, 0xbb, 0xa8, 0x55, 0x6d, 0x10, 0xe1, 0x4f, 0x90, 0x79, 0x0d, 0x1d, 0x95, 0x65, 0xc8,
-	0x08, 0xae, 0x64, 0xaa, 0x73, 0xed, 0xa2, 0x0e, 0x1e, 0x40, 0x0c, 0xef, 0x22, 0x6c, 0xcd, 0x23,
-	0x75, 0x2f, 0xc2, 0x40, 0x48, 0xb2, 0x54, 0x99, 0xa9, 0xe6, 0xdb, 0x8b, 0x2c, 0x9d, 0x43, 0x03,
-	0x7c, 0x95, 0xfd, 0xfd, 0xdf, 0xca, 0xd4, 0xce, 0x3f, 0x39, 0x54, 0x7c, 0xa9, 0x97, 0x68, 0x47,
-	0x52, 0xc9, 0xf0, 0xa7, 0x28, 0x77, 0x09, 0xbb, 0x09, 0xb6, 0x51, 0xa1, 0x81, 0x6b, 0xa3, 0xa5,
-	0x5a, 0xd3, 0x5b, 0xab, 0x6d, 0x18, 0xf8, 0x05, 0x9a, 0x4f, 0xb6, 0x57, 0xc4, 0x23, 0x97, 0x09,
-	0x58, 0x4c, 0xea, 0xe9, 0x2c, 0xcd, 0x4b, 0xfd, 0xf3, 0x07, 0x20, 0x98, 0xa7, 0x2b, 0xf9, 0x76,
-	0x10, 0x37, 0xd0, 0xac, 0x39, 0x51, 0x32, 0x53, 0x99, 0x99, 0x4c, 0xaa, 0x0f, 0xd2, 0x28, 0x13,
-	0x22, 0x7e, 0x8d, 0x16, 0x4c, 0x63, 0x5d, 0x1e, 0x9d, 0x07, 0x71, 0x5f, 0x2d, 0x38, 0xa5, 0xdd,
-	0xb4, 0xb5, 0x6f, 0x84, 0x99, 0x83, 0x96, 0x26, 0x19, 0x97, 0xf9, 0xa1, 0x1d, 0x14, 0xf8, 0x6b,
-	0x34, 0x6b, 0x56, 0x13, 0x79, 0x04, 0x26, 0x1b, 0xb6, 0xc9, 0xc9, 0x40, 0xfa, 0x3c, 0x88, 0xfc,
-	0xb3, 0x6b, 0x98, 0xfd, 0xa4, 0x12, 0xa3, 0xc0, 0xaf, 0xd0, 0xbc, 0x5e, 0x34, 0x69, 0x21, 0xb9,
-	0x8f, 0x3d, 0xde, 0x08, 0x3f, 0x29, 0xc1, 0xf2, 0x28, 0x81, 0x30, 0x2d, 0xe3, 0x10, 0x15, 0xac,
-	0x6d, 0x47, 0x66, 0xc1, 0x66, 0xeb, 0xbe, 0x52, 0xd2, 0xb7, 0xc3, 0x18, 0xa1, 0x30, 0xdd, 0x82,
-	0xf8, 0x47, 0xb4, 0x34, 0x72, 0x19, 0x15, 0x35, 0x07, 0x6e, 0xdb, 0xf7, 0x17, 0x35, 0xe9, 0xb7,
-	0x98, 0xfa, 0xa5, 0xc5, 0x1d, 0xa0, 0xa2, 0xf5, 0xa9, 0x13, 0x24, 0x0f, 0x7e, 0x4f, 0x6c, 0xbf,
-	0x83, 0x11, 0x9e, 0x8c, 0xb1, 0x2d, 0xc1, 0xa7, 0xa8, 0xe4, 0xb1, 0x90, 0xf9, 0x54, 0x32, 0xe7,
-	0x82, 0xdd, 0x08, 0x82, 0xc0, 0xe3, 0xe9, 0x44, 0x4d, 0x1d, 0x26, 0x4f, 0x62, 0xd5, 0x5a, 0x19,
-	0x53, 0xc9, 0x63, 0xf3, 0x89, 0x4a, 0x1c, 0x13, 0x87, 0xd7, 0xec, 0x46, 0x4d, 0xe0, 0x02, 0x8b,
-	0xdd, 0xc6, 0x9e, 0x23, 0xb9, 0xe3, 0xb1, 0x88, 0xf7, 0x05, 0x29, 0x80, 0x27, 0xb1, 0x3d, 0x8f,
-	0xda, 0xad, 0xc6, 0xde, 0x19, 0x3f, 0x54, 0x84, 0xa4, 0xf3, 0x20, 0x33, 0x31, 0xe8, 0xd9, 0x20,
-	0xd2, 0x07, 0xea, 0x39, 0x32, 0xa6, 0x91, 0x38, 0x67, 0xb1, 0x20, 0x45, 0xf0, 0x2a, 0xdf, 0x3b,
-	0x0c, 0x86, 0x74, 0x76, 0x6d, 0x1c, 0x71, 0x6a, 0x90, 0x40, 0x62, 0xe7, 0xff, 0x69, 0x54, 0x1a,
-	0x9b, 0x7f, 0x5c, 0x43, 0x4b, 0x21, 0x55, 0x2d, 0x31, 0x3b, 0x4c, 0xbf, 0x38, 0xf0, 0xae, 0x65,
-	0xdb, 0x8b, 0x1a, 0xd2, 0x13, 0x0b, 0x02, 0xcd, 0x17, 0xd2, 0xe1, 0x5d, 0xc1, 0xe2, 0x21, 0xf3,
-	0x0c, 0x7f, 0x3a, 0xe1, 0x0b, 0x79, 0x62, 0x10, 0xcd, 0x7f, 0x8e, 0xd6, 0x80, 0x0f, 0x4b, 0x29,
-	0xfd, 0x4a, 0x1b, 0xd5, 0x8c, 0xfe, 0x6e, 0x2a, 0x42, 0x47, 0xe3, 0x76, 0xaa, 0x2f, 0x11, 0x19,
-	0x93, 0xea, 0xa1, 0x86, 0x2f, 0x1b, 0xdc, 0x1d, 0xb2, 0xed, 0x15, 0x4b, 0xa9, 0xc7, 0x58, 0x81,
-	0xf8, 0x3b, 0xb4, 0x35, 0x26, 0xb4, 0xa6, 0x4f, 0xab, 0xf5, 0x4d, 0x62, 0xcd, 0x52, 0x8f, 0xe6,
-	0x0d, 0x1c, 0x9e, 0xa2, 0x05, 0x70, 0x90, 0xd7, 0xce, 0x25, 0xe7, 0xa1, 0xba, 0x7d, 0xe8, 0xfb,
-	0x44, 0x51, 0x85, 0xcf, 0xae, 0x4f, 0x39, 0x0f, 0x8f, 0x3d, 0xbc, 0x83, 0x4a, 0x40, 0xd3, 0x95,
-	0x05, 0x9e, 0xb9, 0x40, 0x14, 0x54, 0x10, 0xea, 0x39, 0xf6, 0x9a, 0xbf, 0xbc, 0xbd, 0x2d, 0x67,
-	0xde, 0xdd, 0x96, 0x33, 0xff, 0xdd, 0x96, 0x33, 0x7f, 0xde, 0x95, 0xa7, 0xde, 0xdd, 0x95, 0xa7,
-	0xfe, 0xba, 0x2b, 0x4f, 0xfd, 0xfa, 0xad, 0xb5, 0xd0, 0xcd, 0xa1, 0xec, 0x36, 0x61, 0x81, 0x4e,
-	0xfe, 0xdb, 0xe7, 0xde, 0x20, 0x64, 0xf5, 0xeb, 0x7a, 0x72, 0xcd, 0x83, 0x6d, 0xdf, 0xcd, 0xc1,
-	0xf5, 0xed, 0xf3, 0x0f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x66, 0xf3, 0xce, 0x10, 0x81, 0x0a, 0x00,
-	0x00,
+	// 1859 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x6e, 0x1b, 0xb9,
+	0x15, 0xb6, 0xd6, 0x8e, 0x13, 0xd3, 0x92, 0x6d, 0xd1, 0xb2, 0x42, 0xd9, 0x8e, 0xac, 0x75, 0x91,
+	0xc0, 0x68, 0x1b, 0x29, 0x76, 0x81, 0x16, 0x6d, 0xd1, 0x1f, 0xcb, 0x76, 0x36, 0xc6, 0x6e, 0x9a,
+	0x60, 0xec, 0xcd, 0xa2, 0xe9, 0xc5, 0x94, 0x9a, 0xa1, 0x46, 0x03, 0xcf, 0x0c, 0x85, 0x21, 0xa5,
+	0xc8, 0x37, 0x45, 0x1f, 0x61, 0xfb, 0x24, 0x7d, 0x8d, 0xbd, 0xe8, 0xc5, 0x5e, 0x16, 0x45, 0x91,
+	0x16, 0xc9, 0x8b, 0x14, 0x3c, 0xe4, 0x8c, 0x38, 0x92, 0xd2, 0x05, 0x7c, 0x65, 0x89, 0xe7, 0xfb,
+	0xbe, 0x73, 0x44, 0x9e, 0x1f, 0xd2, 0x88, 0x04, 0x29, 0x1d, 0x87, 0xf2, 0xb6, 0x33, 0x3e, 0xee,
+	0x04, 0x2c, 0x61, 0x22, 0x14, 0xed, 0x61, 0xca, 0x25, 0xc7, 0xc8, 0x58, 0xda, 0xe3, 0xe3, 0xdd,
+	0x5a, 0xc0, 0x03, 0x0e, 0xcb, 0x1d, 0xf5, 0x49, 0x23, 0x76, 0xeb, 0x16, 0x57, 0xde, 0x0e, 0x99,
+	0x61, 0xee, 0xee, 0x58, 0xeb, 0xb1, 0x08, 0xc4, 0x02, 0x78, 0x8f, 0x4a, 0x6f, 0x60, 0xd6, 0xf7,
+	0xad, 0x75, 0x2a, 0x25, 0x13, 0x92, 0xca, 0x90, 0x27, 0xc6, 0xda, 0xf4, 0xb8, 0x88, 0xb9, 0xe8,
+	0xf4, 0xa8, 0x60, 0x9d, 0xf1, 0x71, 0x8f, 0x49, 0x7a, 0xdc, 0xf1, 0x78, 0x98, 0xd9, 0x0f, 0x02,
+	0xce, 0x83, 0x88, 0x75, 0xe0, 0x5b, 0x6f, 0xd4, 0xef, 0xc8, 0x30, 0x56, 0x12, 0xf1, 0x50, 0x03,
+	0x0e, 0xff, 0x56, 0x46, 0xab, 0xaf, 0x69, 0x4a, 0x63, 0x81, 0x1f, 0xa1, 0xec, 0x47, 0xb9, 0xa1,
+	0x4f, 0x4a, 0xad, 0xd2, 0xd1, 0x9a, 0xb3, 0x66, 0x56, 0x2e, 0x7d, 0xfc, 0x0c, 0xd5, 0x3c, 0x9e,
+	0xc8, 0x94, 0x7a, 0xd2, 0x15, 0x7c, 0x94, 0x7a, 0xcc, 0x1d, 0x50, 0x31, 0x20, 0x9f, 0x01, 0x10,
+	0x67, 0xb6, 0x2b, 0x30, 0xbd, 0xa0, 0x62, 0x80, 0x7f, 0x8e, 0x1e, 0xf6, 0xd2, 0xd0, 0x0f, 0x98,
+	0xcb, 0xe4, 0x80, 0xa5, 0x6c, 0x14, 0xbb, 0xd4, 0xf7, 0x53, 0x26, 0x04, 0x59, 0x01, 0xd2, 0x8e,
+	0x36, 0x5f, 0x18, 0xeb, 0xa9, 0x36, 0xe2, 0x27, 0x68, 0xd3, 0xf0, 0xbc, 0x01, 0x0d, 0x13, 0x15,
+	0xcd, 0xbd, 0x56, 0xe9, 0x68, 0xc5, 0xa9, 0xe8, 0xe5, 0x33, 0xb5, 0x7a, 0xe9, 0xe3, 0x13, 0xb4,
+	0x23, 0xc2, 0x20, 0x61, 0xbe, 0x3b, 0xa6, 0x91, 0x60, 0x52, 0xb8, 0xef, 0xc2, 0xc4, 0xe7, 0xef,
+	0xc8, 0x2a, 0xa0, 0xb7, 0xb5, 0xf1, 0x8d, 0xb6, 0x7d, 0x03, 0x26, 0x8b, 0x03, 0x9b, 0xcc, 0x72,
+	0xce, 0x7d, 0x9b, 0xd3, 0xd5, 0x36, 0xc3, 0xf9, 0x25, 0x6a, 0x18, 0x4e, 0xc4, 0x83, 0xd0, 0x73,
+	0x3d, 0x1a, 0x45, 0x39, 0xef, 0x01, 0xf0, 0xea, 0x1a, 0xf0, 0x95, 0xb2, 0x9f, 0x29, 0xb3, 0xa1,
+	0x3e, 0x43, 0x35, 0x49, 0xd3, 0x80, 0x49, 0xed, 0xce, 0x55, 0xdb, 0xcf, 0x47, 0x92, 0xac, 0x01,
+	0x0b, 0x6b, 0x1b, 0x78, 0xbb, 0xd6, 0x16, 0xfc, 0x53, 0x84, 0xe9, 0x98, 0xa5, 0x34, 0x60, 0x6e,
+	0x2f, 0xe2, 0xde, 0x0d, 0x50, 0x08, 0x02, 0xfc, 0x96, 0xb1, 0x74, 0x95, 0x41, 0x11, 0xf0, 0x6f,
+	0xd0, 0x5e, 0x86, 0xce, 0xf7, 0xd8, 0xa2, 0xad, 0x03, 0x8d, 0x18, 0x48, 0xb6, 0xcf, 0x53, 0x7a,
+	0x0f, 0xed, 0x88, 0x88, 0x8a, 0x81, 0xdb, 0x57, 0x47, 0x17, 0xf2, 0xc4, 0xec, 0x24, 0x29, 0xb7,
+	0x4a, 0x47, 0xe5, 0x6e, 0xfb, 0xbb, 0xf7, 0x07, 0x4b, 0xff, 0x7a, 0x7f, 0xf0, 0x24, 0x08, 0xe5,
+	0x60, 0xd4, 0x6b, 0x7b, 0x3c, 0xee, 0x98, 0x84, 0xd3, 0x7f, 0x9e, 0x0a, 0xff, 0xc6, 0x24, 0xf7,
+	0x39, 0xf3, 0x9c, 0x6d, 0x10, 0x7b, 0x6e, 0xb4, 0xf4, 0xc6, 0xe3, 0x3f, 0xa3, 0xda, 0x8c, 0x0f,
+	0xd8, 0x0a, 0x52, 0xb9, 0x93, 0x0b, 0x5c, 0x70, 0x01, 0x3b, 0x87, 0x43, 0xd4, 0x98, 0xf1, 0x30,
+	0x3d, 0x27, 0xb2, 0x71, 0x27, 0x37, 0xf5, 0x82, 0x9b, 0xfc, 0x58, 0xf1, 0x19, 0x6a, 0x8e, 0x92,
+	0x1e, 0x4f, 0x7c, 0x17, 0x00, 0x61, 0x12, 0xcc, 0xe6, 0xde, 0x26, 0x6c, 0xf9, 0x9e, 0x46, 0x5d,
+	0x19, 0x50, 0x31, 0x07, 0xc7, 0xa8, 0x35, 0xb7, 0x23, 0xbe, 0x3a, 0x3f, 0x57, 0x65, 0x11, 0x95,
+	0xa3, 0x94, 0x91, 0xad, 0x3b, 0x85, 0xbd, 0x3f, 0xb3, 0x3b, 0xfe, 0x85, 0x1c, 0x5c, 0x65, 0x9a,
+	0xf8, 0x1c, 0x55, 0x74, 0xb0, 0x6e, 0xca, 0xde, 0xd1, 0xd4, 0x27, 0xd5, 0x56, 0xe9, 0x68, 0xfd,
+	0xa4, 0xd1, 0xd6, 0x5a, 0x6d, 0xd5, 0x44, 0xda, 0xa6, 0x89, 0xb4, 0xcf, 0x78, 0x98, 0x74, 0x57,
+	0x94, 0x7f, 0xa7, 0xac, 0x59, 0x0e, 0x90, 0xf0, 0x8f, 0x90, 0x29, 0x43, 0x57, 0x79, 0x19, 0x33,
+	0x82, 0x5b, 0xa5, 0xa3, 0x07, 0x4e, 0x59, 0x2f, 0x9e, 0xc2, 0x1a, 0x7e, 0x8a, 0xb0, 0x95, 0x8f,
+	0xd4, 0xbb, 0x89, 0x42, 0x21, 0xc9, 0x76, 0x6b, 0xf9, 0x68, 0xcd, 0xa9, 0xb2, 0x3c, 0x0f, 0x8d,
+	0x01, 0xc7, 0x68, 0xcf, 0x44, 0x36, 0xe4, 0xef, 0x58, 0xea, 0xfa, 0x61, 0xbf, 0xef, 0xca, 0x41,
+	0xca, 0xc4, 0x80, 0x47, 0x3e, 0xa9, 0xdd, 0x69, 0x33, 0x88, 0x96, 0x7c, 0xad, 0x14, 0xcf, 0xc3,
+	0x7e, 0xff, 0x3a, 0xd3, 0xc3, 0xc7, 0x68, 0x27, 0xa6, 0x13, 0x73, 0x72, 0x6e, 0x5e, 0x6a, 0x82,
+	0xec, 0xe8, 0xb2, 0x8c, 0xe9, 0x44, 0x9f, 0xd8, 0xa9, 0xa9, 0x35, 0x81, 0x9f, 0xa2, 0xed, 0x19,
+	0x0a, 0x14, 0x58, 0x5d, 0xd7, 0xa5, 0x4d, 0x80, 0xc2, 0xea, 0xa2, 0xa6, 0x82, 0xab, 0x73, 0xcd,
+	0xcf, 0xd4, 0x65, 0xe3, 0xd0, 0x67, 0x89, 0xc7, 0x14, 0x9d, 0x3c, 0x04, 0xe6, 0x6e, 0x4c, 0x27,
+	0x5d, 0xea, 0xe7, 0x67, 0x74, 0x61, 0x20, 0xa7, 0x01, 0xc3, 0xbf, 0x45, 0x7b, 0xde, 0x80, 0x79,
+	0x37, 0x43, 0x1e, 0x26, 0xea, 0xc8, 0x24, 0x4b, 0x20, 0x59, 0x4c, 0xa2, 0x11, 0x10, 0x68, 0x4c,
+	0x21, 0x4e, 0x86, 0x30, 0x69, 0x66, 0x7e, 0x25, 0x4b, 0x7c, 0xfd, 0xf3, 0x58, 0xea, 0x86, 0x92,
+	0xc5, 0x82, 0x34, 0xf2, 0x5f, 0x79, 0x91, 0xf8, 0x5d, 0x6d, 0xba, 0x54, 0x96, 0x5f, 0xad, 0xfc,
+	0xf5, 0xdf, 0xad, 0xa5, 0xc3, 0x7f, 0x54, 0x51, 0xf9, 0x0b, 0x3d, 0xed, 0xae, 0x24, 0x95, 0x0c,
+	0xff, 0x18, 0xad, 0x0e, 0x61, 0x46, 0xc0, 0x54, 0x58, 0x3f, 0xc1, 0xed, 0xe9, 0xf4, 0x6b, 0xeb,
+	0xe9, 0xe1, 0x18, 0x04, 0x7e, 0x8e, 0x36, 0x8c, 0xd1, 0x4d, 0x78, 0xe2, 0x31, 0x41, 0x3e, 0x33,
+	0x59, 0x66, 0x71, 0xbe, 0xd0, 0x1f, 0xff, 0x00, 0x00, 0x93, 0x65, 0x95, 0xc0, 0x5e, 0xc4, 0x27,
+	0xe8, 0xbe, 0xa9, 0x2c, 0xb2, 0xdc, 0x5a, 0x9e, 0x75, 0xaa, 0x77, 0xdb, 0x30, 0x33, 0x20, 0xfe,
+	0x12, 0x6d, 0xea, 0x8f, 0xae, 0xc7, 0x93, 0x7e, 0x98, 0xc6, 0x6a, 0xd0, 0x28, 0xee, 0xbe, 0xcd,
+	0x7d, 0x29, 0x4c, 0x3d, 0x9e, 0x69, 0x90, 0x51, 0xd9, 0x18, 0xdb, 0x8b, 0x02, 0xff, 0x1a, 0xdd,
+	0x37, 0x23, 0x82, 0xdc, 0x03, 0x91, 0x3d, 0x5b, 0xe4, 0xd5, 0x48, 0x06, 0x3c, 0x4c, 0x82, 0xeb,
+	0x09, 0xf4, 0xa0, 0x2c, 0x12, 0xc3, 0xc0, 0x2f, 0xd0, 0x06, 0x7c, 0x9c, 0x06, 0xb2, 0x3a, 0xaf,
+	0xf1, 0x52, 0x04, 0x59, 0x08, 0x96, 0x46, 0x05, 0x88, 0x79, 0x18, 0xe7, 0x68, 0xdd, 0x9a, 0x3a,
+	0xe4, 0x3e, 0xc8, 0x3c, 0x5a, 0x14, 0x4a, 0xde, 0xa5, 0x8c, 0x10, 0x8a, 0xb2, 0x05, 0x81, 0xbf,
+	0x46, 0xdb, 0x53, 0x95, 0x69, 0x50, 0x0f, 0x40, 0xed, 0x60, 0x71, 0x50, 0xb3, 0x7a, 0xd5, 0x5c,
+	0x2f, 0x0f, 0xee, 0x14, 0x95, 0xad, 0x3b, 0x89, 0x20, 0x6b, 0xa0, 0xf7, 0xd0, 0xd6, 0x3b, 0x9d,
+	0xda, 0xb3, 0x76, 0x62, 0x53, 0xf0, 0x6b, 0x54, 0xf1, 0x59, 0xc4, 0x02, 0x2a, 0x99, 0x7b, 0xc3,
+	0x6e, 0x05, 0x41, 0xa0, 0xf1, 0x78, 0x26, 0xa6, 0x2b, 0x26, 0x5f, 0xa5, 0x6a, 0x6b, 0x65, 0x4a,
+	0x25, 0x4f, 0xcd, 0x55, 0x21, 0x53, 0xcc, 0x14, 0xbe, 0x64, 0xb7, 0x2a, 0x03, 0x37, 0x59, 0xea,
+	0x9d, 0x3c, 0x73, 0x25, 0x77, 0x7d, 0x96, 0xf0, 0x58, 0x90, 0x75, 0xd0, 0x24, 0xb6, 0xe6, 0x85,
+	0x73, 0x76, 0xf2, 0xec, 0x9a, 0x9f, 0x2b, 0x40, 0xb6, 0xf3, 0x40, 0x33, 0x6b, 0xb0, 0x67, 0xa3,
+	0x44, 0x1f, 0xa8, 0xef, 0xca, 0x94, 0x26, 0xa2, 0xcf, 0x52, 0x41, 0xca, 0xa0, 0xd5, 0x5c, 0x98,
+	0x0c, 0x06, 0x74, 0x3d, 0x31, 0x8a, 0x38, 0x17, 0xc8, 0x4c, 0x02, 0xf7, 0x50, 0x63, 0xc8, 0x12,
+	0x5f, 0x8d, 0x8e, 0xb0, 0xe7, 0xb9, 0x74, 0x24, 0xb9, 0xdb, 0xe7, 0xa9, 0xea, 0xad, 0x82, 0x54,
+	0x40, 0xfc, 0xf3, 0x42, 0x7d, 0x69, 0xf0, 0x65, 0xcf, 0x3b, 0x1d, 0x49, 0xfe, 0x5c, 0x23, 0x8d,
+	0x7e, 0x7d, 0xb8, 0xc8, 0x28, 0xd4, 0x98, 0x1a, 0x52, 0x21, 0x8b, 0x33, 0xc5, 0x9d, 0xb6, 0x0a,
+	0x41, 0x36, 0x5a, 0xcb, 0x47, 0x65, 0x67, 0x4f, 0xa1, 0xec, 0x19, 0x71, 0x36, 0x85, 0x60, 0x89,
+	0x1e, 0x45, 0x4a, 0x84, 0xf7, 0x04, 0x4b, 0xc7, 0xcc, 0x9f, 0xde, 0x30, 0x06, 0x2c, 0x0c, 0x06,
+	0x12, 0x46, 0xdd, 0xfa, 0xc9, 0x4f, 0xec, 0x60, 0xbf, 0xa2, 0x42, 0xbe, 0x32, 0xf8, 0xc2, 0x75,
+	0xe3, 0x05, 0x50, 0x4c, 0xd8, 0xbb, 0xd1, 0x02, 0x98, 0x46, 0xe0, 0x73, 0x54, 0x2b, 0x7a, 0x35,
+	0x37, 0x92, 0xad, 0xf9, 0xce, 0xa3, 0xab, 0xd8, 0xc1, 0xb6, 0x9a, 0x5e, 0xc3, 0xdf, 0xa0, 0x2a,
+	0xa8, 0xb0, 0x31, 0x4b, 0x64, 0xd6, 0x88, 0xaa, 0xf3, 0x99, 0xa5, 0xe2, 0xbd, 0x50, 0x18, 0xe8,
+	0x3a, 0xdd, 0xdb, 0x37, 0x34, 0x0a, 0x7d, 0x95, 0x60, 0x26, 0xd2, 0xcd, 0xa8, 0x00, 0x10, 0xd8,
+	0x41, 0x38, 0xa2, 0x2a, 0x7d, 0xb3, 0x51, 0x00, 0x63, 0x00, 0x43, 0x70, 0xbb, 0x6d, 0x7d, 0xdb,
+	0x6e, 0x67, 0xb7, 0xed, 0xf6, 0x75, 0x76, 0xdb, 0xee, 0x3e, 0x50, 0x72, 0xdf, 0xfe, 0xe7, 0xa0,
+	0xe4, 0x6c, 0x69, 0xbe, 0x0e, 0x14, 0x86, 0x85, 0x83, 0xb6, 0xed, 0x12, 0xc8, 0xc2, 0xdd, 0x9e,
+	0x6f, 0x5d, 0xe7, 0xd3, 0x3c, 0xd7, 0x01, 0x9b, 0xca, 0xf4, 0x67, 0xd6, 0x05, 0xfe, 0x13, 0xaa,
+	0x17, 0x34, 0x53, 0x9e, 0xd5, 0x68, 0x6d, 0xbe, 0xe6, 0x2d, 0x59, 0x87, 0x17, 0x6a, 0xb5, 0xe6,
+	0xcf, 0x9b, 0x04, 0x7e, 0x8b, 0xea, 0x2a, 0xb3, 0xcc, 0x65, 0x5e, 0xed, 0x44, 0xe8, 0x87, 0x32,
+	0x64, 0x6a, 0x80, 0xce, 0x15, 0xc7, 0x85, 0x1c, 0x98, 0x6a, 0x7d, 0xa3, 0x71, 0xb7, 0x99, 0x36,
+	0x9b, 0xb5, 0x84, 0x4c, 0xe0, 0xbf, 0xa0, 0xc7, 0xff, 0x37, 0x75, 0xdd, 0x94, 0x79, 0x5c, 0x95,
+	0x4a, 0x1d, 0x5c, 0x3d, 0x29, 0x8e, 0xa2, 0x4f, 0x65, 0xb3, 0x71, 0xf9, 0xf9, 0xa7, 0xf3, 0xdd,
+	0xd1, 0xb2, 0x98, 0x22, 0x32, 0x4c, 0x47, 0xea, 0xb2, 0x6f, 0xf9, 0x34, 0x27, 0xf2, 0x70, 0x41,
+	0x75, 0x02, 0x76, 0x2a, 0x63, 0x1f, 0x4b, 0x7d, 0xb8, 0xc8, 0xa8, 0xaa, 0x33, 0xbb, 0x41, 0x49,
+	0x2e, 0x69, 0x24, 0x08, 0x99, 0x6f, 0x4f, 0x5d, 0x00, 0x5c, 0x83, 0x3d, 0xeb, 0x72, 0x3d, 0x6b,
+	0x0d, 0x47, 0x68, 0x7f, 0xc1, 0x8d, 0xdf, 0x65, 0x42, 0x86, 0x31, 0x95, 0x0c, 0x86, 0xfc, 0x4c,
+	0xb2, 0xcf, 0xdd, 0xff, 0x2f, 0x0c, 0xd8, 0x38, 0x68, 0xb0, 0x4f, 0x01, 0xd4, 0x3b, 0xa6, 0xf0,
+	0x80, 0x71, 0xbd, 0x51, 0x2a, 0x78, 0x4a, 0x76, 0xd5, 0xcd, 0xcc, 0xc1, 0x3d, 0xeb, 0x05, 0x73,
+	0x06, 0x16, 0xf5, 0x68, 0xb2, 0x26, 0xce, 0x0c, 0x6d, 0x0f, 0x68, 0xf5, 0x7c, 0xa0, 0x14, 0xa9,
+	0x2f, 0x51, 0xd5, 0x38, 0x9b, 0xb8, 0x29, 0x8b, 0xb9, 0xaa, 0x34, 0xb2, 0x3f, 0x3f, 0x3f, 0xf5,
+	0xbb, 0x69, 0xe2, 0x18, 0x48, 0x56, 0xb2, 0xbd, 0xe2, 0xf2, 0xe1, 0x5b, 0xd4, 0xf8, 0x64, 0x99,
+	0xe3, 0x7d, 0xb4, 0x36, 0xce, 0xbe, 0x64, 0x6f, 0xde, 0x7c, 0x01, 0x1f, 0xa0, 0x75, 0xab, 0x83,
+	0xc0, 0x4d, 0x66, 0xc5, 0x41, 0x2c, 0x57, 0x3a, 0x7c, 0x8e, 0xb6, 0x66, 0x6b, 0xf2, 0x07, 0x24,
+	0x6b, 0xe8, 0x9e, 0x2d, 0xa6, 0xbf, 0x1c, 0xfe, 0x7d, 0x19, 0x55, 0x0a, 0x97, 0x22, 0xdc, 0x46,
+	0xdb, 0xc5, 0x46, 0xa3, 0x59, 0x25, 0x60, 0x55, 0xed, 0x1e, 0xa2, 0xbd, 0x02, 0xde, 0xee, 0x9b,
+	0xb6, 0x97, 0xaa, 0xdd, 0x22, 0x35, 0x5e, 0x9d, 0x8f, 0xc2, 0xc3, 0x8b, 0x21, 0x6f, 0xb3, 0x86,
+	0xb5, 0xac, 0x1f, 0xb5, 0x0a, 0x70, 0xa5, 0xed, 0xb6, 0xab, 0x5f, 0x20, 0x52, 0xa0, 0xea, 0xc3,
+	0x82, 0x24, 0x84, 0x87, 0xfd, 0x8a, 0xb3, 0x63, 0x31, 0xf5, 0xdd, 0x46, 0x19, 0xf1, 0xef, 0xd1,
+	0xa3, 0x02, 0xd1, 0x4a, 0x10, 0xcd, 0xd6, 0xcf, 0xfc, 0x86, 0xc5, 0x9e, 0x5e, 0x42, 0x40, 0xe1,
+	0x31, 0x82, 0x8e, 0xac, 0x32, 0x63, 0xc8, 0x79, 0xa4, 0xfe, 0x35, 0xa0, 0x1f, 0xfb, 0x65, 0xb5,
+	0x7c, 0x3d, 0x79, 0xcd, 0x79, 0x74, 0xe9, 0xe3, 0x43, 0x54, 0x01, 0x98, 0x8e, 0x2c, 0xf4, 0xcd,
+	0xeb, 0x7e, 0x5d, 0x2d, 0x42, 0x3c, 0x97, 0xbe, 0x7a, 0x3a, 0x03, 0x46, 0xbf, 0xd4, 0xd4, 0x38,
+	0xd6, 0x65, 0x64, 0x86, 0x9b, 0x7e, 0xd7, 0xc3, 0x0f, 0xfd, 0x3a, 0x43, 0xd8, 0x93, 0xec, 0x8f,
+	0xdf, 0x7d, 0x68, 0x96, 0xbe, 0xff, 0xd0, 0x2c, 0xfd, 0xf7, 0x43, 0xb3, 0xf4, 0xed, 0xc7, 0xe6,
+	0xd2, 0xf7, 0x1f, 0x9b, 0x4b, 0xff, 0xfc, 0xd8, 0x5c, 0x7a, 0xfb, 0x3b, 0xeb, 0x7d, 0x62, 0xce,
+	0xf4, 0xa9, 0x2e, 0xe7, 0xd9, 0xaf, 0x31, 0xf7, 0x47, 0x11, 0xeb, 0x4c, 0x3a, 0xd9, 0xff, 0x78,
+	0xe0, 0xf1, 0xd2, 0x5b, 0x85, 0xf9, 0xf1, 0xb3, 0xff, 0x0d, 0x00, 0x90, 0x7d, 0xe4, 0xd2, 0x7e,
+	0x12, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -553,6 +936,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxEndBlockerItems != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxEndBlockerItems))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.CheckpointRetentionWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CheckpointRetentionWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.MaxBadSignatureEvidenceAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBadSignatureEvidenceAge))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.MaxValsetAgeTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxValsetAgeTime))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.MaxValsetAgeBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxValsetAgeBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	{
+		size := m.ValsetPowerDiffThreshold.Size()
+		i -= size
+		if _, err := m.ValsetPowerDiffThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
 	if len(m.EthereumBlacklist) > 0 {
 		for iNdEx := len(m.EthereumBlacklist) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EthereumBlacklist[iNdEx])
@@ -714,10 +1144,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.UnbatchedTransfers) > 0 {
-		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BatchTxRemovals) > 0 {
+		for iNdEx := len(m.BatchTxRemovals) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UnbatchedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BatchTxRemovals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -725,13 +1155,45 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x62
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LogicCallTimeoutCursor) > 0 {
+		i -= len(m.LogicCallTimeoutCursor)
+		copy(dAtA[i:], m.LogicCallTimeoutCursor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LogicCallTimeoutCursor)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.BatchTimeoutCursor) > 0 {
+		i -= len(m.BatchTimeoutCursor)
+		copy(dAtA[i:], m.BatchTimeoutCursor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BatchTimeoutCursor)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	{
+		size, err := m.EthereumBlockTimeEstimate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	if len(m.BridgeTotals) > 0 {
+		for iNdEx := len(m.BridgeTotals) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BridgeTotals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -739,13 +1201,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.DelegateKeys) > 0 {
-		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PrunedCheckpointNonces) > 0 {
+		for iNdEx := len(m.PrunedCheckpointNonces) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PrunedCheckpointNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -753,13 +1217,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
 		}
 	}
-	if len(m.Attestations) > 0 {
-		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PastEthSignatureCheckpointRecords) > 0 {
+		for iNdEx := len(m.PastEthSignatureCheckpointRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PastEthSignatureCheckpointRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -767,13 +1233,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.LogicCallConfirms) > 0 {
-		for iNdEx := len(m.LogicCallConfirms) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.EthAddressValidities) > 0 {
+		for iNdEx := len(m.EthAddressValidities) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LogicCallConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.EthAddressValidities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -781,7 +1249,184 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.DelegateKeyRotations) > 0 {
+		for iNdEx := len(m.DelegateKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.DelegateKeyNonces) > 0 {
+		for iNdEx := len(m.DelegateKeyNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeyNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LatestValsetTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LatestValsetTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.LastEventNonces) > 0 {
+		for iNdEx := len(m.LastEventNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastEventNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.LastObservedValset != nil {
+		{
+			size, err := m.LastObservedValset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	{
+		size, err := m.LastObservedEthereumHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.PastEthSignatureCheckpoints) > 0 {
+		for iNdEx := len(m.PastEthSignatureCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PastEthSignatureCheckpoints[iNdEx])
+			copy(dAtA[i:], m.PastEthSignatureCheckpoints[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PastEthSignatureCheckpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PendingIbcAutoForwards) > 0 {
+		for iNdEx := len(m.PendingIbcAutoForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingIbcAutoForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.UnbatchedTransfers) > 0 {
+		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbatchedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for iNdEx := len(m.LogicCallConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCallConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
 		}
 	}
 	if len(m.LogicCalls) > 0 {
@@ -879,6 +1524,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LastEventNonceByValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastEventNonceByValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastEventNonceByValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeyNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeyNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeyNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GravityNonces) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -899,6 +1614,11 @@ func (m *GravityNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastUnbondingBlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastUnbondingBlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.LastBatchId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBatchId))
 		i--
@@ -1009,6 +1729,23 @@ func (m *Params) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.ValsetPowerDiffThreshold.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.MaxValsetAgeBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxValsetAgeBlocks))
+	}
+	if m.MaxValsetAgeTime != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxValsetAgeTime))
+	}
+	if m.MaxBadSignatureEvidenceAge != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxBadSignatureEvidenceAge))
+	}
+	if m.CheckpointRetentionWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.CheckpointRetentionWindow))
+	}
+	if m.MaxEndBlockerItems != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxEndBlockerItems))
+	}
 	return n
 }
 
@@ -1084,42 +1821,155 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *GravityNonces) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PendingIbcAutoForwards) > 0 {
+		for _, e := range m.PendingIbcAutoForwards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.LatestValsetNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LatestValsetNonce))
+	if len(m.PastEthSignatureCheckpoints) > 0 {
+		for _, b := range m.PastEthSignatureCheckpoints {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.LastObservedNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LastObservedNonce))
+	l = m.LastObservedEthereumHeight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.LastObservedValset != nil {
+		l = m.LastObservedValset.Size()
+		n += 2 + l + sovGenesis(uint64(l))
 	}
-	if m.LastSlashedValsetNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LastSlashedValsetNonce))
+	if len(m.LastEventNonces) > 0 {
+		for _, e := range m.LastEventNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.LastSlashedBatchBlock != 0 {
-		n += 1 + sovGenesis(uint64(m.LastSlashedBatchBlock))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LatestValsetTime)
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.DelegateKeyNonces) > 0 {
+		for _, e := range m.DelegateKeyNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.LastSlashedLogicCallBlock != 0 {
-		n += 1 + sovGenesis(uint64(m.LastSlashedLogicCallBlock))
+	if len(m.DelegateKeyRotations) > 0 {
+		for _, e := range m.DelegateKeyRotations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.LastTxPoolId != 0 {
-		n += 1 + sovGenesis(uint64(m.LastTxPoolId))
+	if len(m.EthAddressValidities) > 0 {
+		for _, e := range m.EthAddressValidities {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.LastBatchId != 0 {
-		n += 1 + sovGenesis(uint64(m.LastBatchId))
+	if len(m.PastEthSignatureCheckpointRecords) > 0 {
+		for _, e := range m.PastEthSignatureCheckpointRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+	if len(m.PrunedCheckpointNonces) > 0 {
+		for _, e := range m.PrunedCheckpointNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgeTotals) > 0 {
+		for _, e := range m.BridgeTotals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.EthereumBlockTimeEstimate.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = len(m.BatchTimeoutCursor)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.LogicCallTimeoutCursor)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.BatchTxRemovals) > 0 {
+		for _, e := range m.BatchTxRemovals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LastEventNonceByValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func (m *DelegateKeyNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *GravityNonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LatestValsetNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LatestValsetNonce))
+	}
+	if m.LastObservedNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastObservedNonce))
+	}
+	if m.LastSlashedValsetNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSlashedValsetNonce))
+	}
+	if m.LastSlashedBatchBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSlashedBatchBlock))
+	}
+	if m.LastSlashedLogicCallBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSlashedLogicCallBlock))
+	}
+	if m.LastTxPoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTxPoolId))
+	}
+	if m.LastBatchId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastBatchId))
+	}
+	if m.LastUnbondingBlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastUnbondingBlockHeight))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozGenesis(x uint64) (n int) {
 	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -1617,6 +2467,134 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.EthereumBlacklist = append(m.EthereumBlacklist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetPowerDiffThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValsetPowerDiffThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValsetAgeBlocks", wireType)
+			}
+			m.MaxValsetAgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValsetAgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValsetAgeTime", wireType)
+			}
+			m.MaxValsetAgeTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValsetAgeTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBadSignatureEvidenceAge", wireType)
+			}
+			m.MaxBadSignatureEvidenceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBadSignatureEvidenceAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointRetentionWindow", wireType)
+			}
+			m.CheckpointRetentionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointRetentionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEndBlockerItems", wireType)
+			}
+			m.MaxEndBlockerItems = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEndBlockerItems |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1669,7 +2647,585 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GravityNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valsets = append(m.Valsets, Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetConfirms = append(m.ValsetConfirms, MsgValsetConfirm{})
+			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, OutgoingTxBatch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
+			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCalls = append(m.LogicCalls, OutgoingLogicCall{})
+			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
+			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeys = append(m.DelegateKeys, MsgSetOrchestratorAddress{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbatchedTransfers = append(m.UnbatchedTransfers, OutgoingTransferTx{})
+			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingIbcAutoForwards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingIbcAutoForwards = append(m.PendingIbcAutoForwards, PendingIbcAutoForward{})
+			if err := m.PendingIbcAutoForwards[len(m.PendingIbcAutoForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PastEthSignatureCheckpoints", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PastEthSignatureCheckpoints = append(m.PastEthSignatureCheckpoints, make([]byte, postIndex-iNdEx))
+			copy(m.PastEthSignatureCheckpoints[len(m.PastEthSignatureCheckpoints)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastObservedEthereumHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedValset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastObservedValset == nil {
+				m.LastObservedValset = &Valset{}
+			}
+			if err := m.LastObservedValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastEventNonces = append(m.LastEventNonces, LastEventNonceByValidator{})
+			if err := m.LastEventNonces[len(m.LastEventNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestValsetTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1696,16 +3252,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LatestValsetTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GravityNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeyNonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1732,13 +3285,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GravityNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegateKeyNonces = append(m.DelegateKeyNonces, DelegateKeyNonce{})
+			if err := m.DelegateKeyNonces[len(m.DelegateKeyNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeyRotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1765,14 +3319,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valsets = append(m.Valsets, Valset{})
-			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegateKeyRotations = append(m.DelegateKeyRotations, DelegateKeyRotation{})
+			if err := m.DelegateKeyRotations[len(m.DelegateKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddressValidities", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1799,14 +3353,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValsetConfirms = append(m.ValsetConfirms, MsgValsetConfirm{})
-			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EthAddressValidities = append(m.EthAddressValidities, EthAddressValidity{})
+			if err := m.EthAddressValidities[len(m.EthAddressValidities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PastEthSignatureCheckpointRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1833,14 +3387,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Batches = append(m.Batches, OutgoingTxBatch{})
-			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PastEthSignatureCheckpointRecords = append(m.PastEthSignatureCheckpointRecords, PastEthSignatureCheckpoint{})
+			if err := m.PastEthSignatureCheckpointRecords[len(m.PastEthSignatureCheckpointRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedCheckpointNonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1867,14 +3421,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
-			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PrunedCheckpointNonces = append(m.PrunedCheckpointNonces, PrunedCheckpointNonce{})
+			if err := m.PrunedCheckpointNonces[len(m.PrunedCheckpointNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeTotals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1901,14 +3455,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCalls = append(m.LogicCalls, OutgoingLogicCall{})
-			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BridgeTotals = append(m.BridgeTotals, BridgeTotals{})
+			if err := m.BridgeTotals[len(m.BridgeTotals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockTimeEstimate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1935,16 +3489,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
-			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EthereumBlockTimeEstimate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTimeoutCursor", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1954,31 +3507,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BatchTimeoutCursor = append(m.BatchTimeoutCursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.BatchTimeoutCursor == nil {
+				m.BatchTimeoutCursor = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallTimeoutCursor", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1988,29 +3541,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, MsgSetOrchestratorAddress{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LogicCallTimeoutCursor = append(m.LogicCallTimeoutCursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.LogicCallTimeoutCursor == nil {
+				m.LogicCallTimeoutCursor = []byte{}
 			}
 			iNdEx = postIndex
-		case 11:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTxRemovals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2037,16 +3590,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20ToDenoms = append(m.Erc20ToDenoms, ERC20ToDenom{})
-			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BatchTxRemovals = append(m.BatchTxRemovals, BatchTxRemovals{})
+			if err := m.BatchTxRemovals[len(m.BatchTxRemovals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastEventNonceByValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastEventNonceByValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastEventNonceByValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2056,26 +3659,144 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbatchedTransfers = append(m.UnbatchedTransfers, OutgoingTransferTx{})
-			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeyNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeyNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeyNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2259,6 +3980,25 @@ func (m *GravityNonces) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUnbondingBlockHeight", wireType)
+			}
+			m.LastUnbondingBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUnbondingBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,8 +1,11 @@
 package types
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
@@ -13,6 +16,34 @@ func TestGenesisStateValidate(t *testing.T) {
 		expErr bool
 	}{
 		"default params": {src: DefaultGenesisState(), expErr: false},
+		"zero valset power diff threshold": {src: func() *GenesisState {
+			g := DefaultGenesisState()
+			g.Params.ValsetPowerDiffThreshold = types.ZeroDec()
+			return g
+		}(), expErr: true},
+		"valset power diff threshold above one": {src: func() *GenesisState {
+			g := DefaultGenesisState()
+			g.Params.ValsetPowerDiffThreshold = types.NewDecWithPrec(11, 1)
+			return g
+		}(), expErr: true},
+		"checkpoint retention window of the longest signed window": {src: func() *GenesisState {
+			g := DefaultGenesisState()
+			g.Params.SignedBatchesWindow = 20000
+			g.Params.CheckpointRetentionWindow = 20000
+			return g
+		}(), expErr: false},
+		"checkpoint retention window shorter than a signed window": {src: func() *GenesisState {
+			g := DefaultGenesisState()
+			g.Params.SignedLogicCallsWindow = 20000
+			g.Params.CheckpointRetentionWindow = 19999
+			return g
+		}(), expErr: true},
+		"checkpoint retention window shorter than the max bad signature evidence age": {src: func() *GenesisState {
+			g := DefaultGenesisState()
+			g.Params.MaxBadSignatureEvidenceAge = 100000
+			g.Params.CheckpointRetentionWindow = 50000
+			return g
+		}(), expErr: true},
 		"empty params": {src: &GenesisState{
 			Params: &Params{
 				GravityId:                    "",
@@ -115,3 +146,164 @@ func TestStringToByteArray(t *testing.T) {
 		})
 	}
 }
+
+// nolint: exhaustivestruct
+func TestGenesisStateCrossValidation(t *testing.T) {
+	var (
+		valAddr      = types.ValAddress(bytes.Repeat([]byte{1}, 20))
+		otherValAddr = types.ValAddress(bytes.Repeat([]byte{2}, 20))
+		orchAddr     = types.AccAddress(bytes.Repeat([]byte{3}, 20))
+		ethAddr      = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		tokenAddr    = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		tx           = func(id uint64) OutgoingTransferTx {
+			return OutgoingTransferTx{Id: id, Sender: orchAddr.String(), DestAddress: ethAddr}
+		}
+		attestation = func(nonce uint64, observed bool, votes ...string) Attestation {
+			claim, err := codectypes.NewAnyWithValue(&MsgSendToCosmosClaim{EventNonce: nonce, Amount: types.OneInt()})
+			require.NoError(t, err)
+			return Attestation{Observed: observed, Votes: votes, Claim: claim}
+		}
+	)
+	// valid returns a consistent genesis state referencing every cross checked item
+	valid := func() *GenesisState {
+		g := DefaultGenesisState()
+		g.GravityNonces = GravityNonces{LastObservedNonce: 2, LastTxPoolId: 3, LastBatchId: 1}
+		g.DelegateKeys = []MsgSetOrchestratorAddress{
+			{Validator: valAddr.String(), Orchestrator: orchAddr.String(), EthAddress: ethAddr},
+		}
+		g.Erc20ToDenoms = []ERC20ToDenom{{Erc20: tokenAddr, Denom: "stake"}}
+		g.Valsets = []Valset{{Nonce: 1}}
+		g.ValsetConfirms = []MsgValsetConfirm{{Nonce: 1, Orchestrator: orchAddr.String()}}
+		g.Batches = []OutgoingTxBatch{{BatchNonce: 1, TokenContract: tokenAddr, Transactions: []OutgoingTransferTx{tx(1), tx(2)}}}
+		g.BatchConfirms = []MsgConfirmBatch{{Nonce: 1, TokenContract: strings.ToLower(tokenAddr), Orchestrator: orchAddr.String()}}
+		g.LogicCalls = []OutgoingLogicCall{{InvalidationId: []byte{0xab, 0xcd}, InvalidationNonce: 1}}
+		g.LogicCallConfirms = []MsgConfirmLogicCall{{InvalidationId: "abcd", InvalidationNonce: 1, Orchestrator: orchAddr.String()}}
+		g.UnbatchedTransfers = []OutgoingTransferTx{tx(3)}
+		g.Attestations = []Attestation{attestation(2, true, valAddr.String()), attestation(3, false, valAddr.String())}
+		g.PastEthSignatureCheckpoints = [][]byte{{0x1}}
+		g.PastEthSignatureCheckpointRecords = []PastEthSignatureCheckpoint{{Checkpoint: []byte{0x2}, Height: 5, Type: CHECKPOINT_TYPE_VALSET, Nonce: 1}}
+		g.PrunedCheckpointNonces = []PrunedCheckpointNonce{{Type: CHECKPOINT_TYPE_BATCH, Nonce: 1, TokenContract: tokenAddr}}
+		g.BridgeTotals = []BridgeTotals{{TokenContract: tokenAddr, Deposited: types.NewInt(2), Withdrawn: types.NewInt(1), CommunityPool: types.ZeroInt(), OpeningOutstanding: types.NewInt(3)}}
+		g.BatchTxRemovals = []BatchTxRemovals{{TokenContract: tokenAddr, BatchNonce: 1, TxIds: []uint64{2}}}
+		g.EthereumBlockTimeEstimate = EthereumBlockTimeEstimate{EthereumBlockHeight: 100, CosmosBlockTime: 1600000000000, AverageEthereumBlockTime: 13000, Samples: 3}
+		return g
+	}
+
+	specs := map[string]struct {
+		mutate func(g *GenesisState)
+		expErr bool
+	}{
+		"consistent state": {mutate: func(g *GenesisState) {}, expErr: false},
+		"valset confirm without valset": {mutate: func(g *GenesisState) {
+			g.ValsetConfirms[0].Nonce = 2
+		}, expErr: true},
+		"batch confirm without batch": {mutate: func(g *GenesisState) {
+			g.BatchConfirms[0].Nonce = 2
+		}, expErr: true},
+		"batch confirm for another token": {mutate: func(g *GenesisState) {
+			g.BatchConfirms[0].TokenContract = ethAddr
+		}, expErr: true},
+		"logic call confirm without logic call": {mutate: func(g *GenesisState) {
+			g.LogicCallConfirms[0].InvalidationId = "abce"
+		}, expErr: true},
+		"unbatched tx also in a batch": {mutate: func(g *GenesisState) {
+			g.UnbatchedTransfers = append(g.UnbatchedTransfers, tx(2))
+		}, expErr: true},
+		"tx in two batches": {mutate: func(g *GenesisState) {
+			g.GravityNonces.LastBatchId = 2
+			g.Batches = append(g.Batches, OutgoingTxBatch{BatchNonce: 2, TokenContract: tokenAddr, Transactions: []OutgoingTransferTx{tx(1)}})
+		}, expErr: true},
+		"tx id above counter": {mutate: func(g *GenesisState) {
+			g.UnbatchedTransfers = append(g.UnbatchedTransfers, tx(4))
+		}, expErr: true},
+		"batch nonce above counter": {mutate: func(g *GenesisState) {
+			g.GravityNonces.LastBatchId = 0
+		}, expErr: true},
+		"duplicate validator": {mutate: func(g *GenesisState) {
+			g.DelegateKeys = append(g.DelegateKeys, MsgSetOrchestratorAddress{
+				Validator: valAddr.String(), Orchestrator: types.AccAddress(otherValAddr).String(), EthAddress: tokenAddr,
+			})
+		}, expErr: true},
+		"duplicate orchestrator": {mutate: func(g *GenesisState) {
+			g.DelegateKeys = append(g.DelegateKeys, MsgSetOrchestratorAddress{
+				Validator: otherValAddr.String(), Orchestrator: orchAddr.String(), EthAddress: tokenAddr,
+			})
+		}, expErr: true},
+		"duplicate eth address with different case": {mutate: func(g *GenesisState) {
+			g.DelegateKeys = append(g.DelegateKeys, MsgSetOrchestratorAddress{
+				Validator: otherValAddr.String(), Orchestrator: types.AccAddress(otherValAddr).String(), EthAddress: strings.ToLower(ethAddr),
+			})
+		}, expErr: true},
+		"erc20 mapped twice": {mutate: func(g *GenesisState) {
+			g.Erc20ToDenoms = append(g.Erc20ToDenoms, ERC20ToDenom{Erc20: tokenAddr, Denom: "other"})
+		}, expErr: true},
+		"denom mapped twice": {mutate: func(g *GenesisState) {
+			g.Erc20ToDenoms = append(g.Erc20ToDenoms, ERC20ToDenom{Erc20: ethAddr, Denom: "stake"})
+		}, expErr: true},
+		"vote from unknown validator": {mutate: func(g *GenesisState) {
+			g.Attestations[1].Votes = append(g.Attestations[1].Votes, otherValAddr.String())
+		}, expErr: true},
+		"observed beyond last observed nonce": {mutate: func(g *GenesisState) {
+			g.Attestations[1].Observed = true
+		}, expErr: true},
+		"checkpoint archived twice": {mutate: func(g *GenesisState) {
+			g.PastEthSignatureCheckpoints = append(g.PastEthSignatureCheckpoints, []byte{0x2})
+		}, expErr: true},
+		"archived checkpoint without nonce": {mutate: func(g *GenesisState) {
+			g.PastEthSignatureCheckpointRecords[0].Nonce = 0
+		}, expErr: true},
+		"pruned nonce of unspecified type": {mutate: func(g *GenesisState) {
+			g.PrunedCheckpointNonces[0].Type = CHECKPOINT_TYPE_UNSPECIFIED
+		}, expErr: true},
+		"pruned batch nonce without token": {mutate: func(g *GenesisState) {
+			g.PrunedCheckpointNonces[0].TokenContract = ""
+		}, expErr: true},
+		"archived batch checkpoint without token": {mutate: func(g *GenesisState) {
+			g.PastEthSignatureCheckpointRecords[0].Type = CHECKPOINT_TYPE_BATCH
+		}, expErr: true},
+		"removal of a tx not in the batch": {mutate: func(g *GenesisState) {
+			g.BatchTxRemovals[0].TxIds = []uint64{3}
+		}, expErr: true},
+		"removal from an unknown batch": {mutate: func(g *GenesisState) {
+			g.BatchTxRemovals[0].BatchNonce = 2
+		}, expErr: true},
+		"removals of a batch twice": {mutate: func(g *GenesisState) {
+			g.BatchTxRemovals = append(g.BatchTxRemovals, BatchTxRemovals{TokenContract: tokenAddr, BatchNonce: 1, TxIds: []uint64{1}})
+		}, expErr: true},
+		"bridge totals of a token twice": {mutate: func(g *GenesisState) {
+			g.BridgeTotals = append(g.BridgeTotals, BridgeTotals{
+				TokenContract: strings.ToLower(tokenAddr), Deposited: types.ZeroInt(), Withdrawn: types.ZeroInt(), CommunityPool: types.ZeroInt(), OpeningOutstanding: types.ZeroInt(),
+			})
+		}, expErr: true},
+		"negative bridge total": {mutate: func(g *GenesisState) {
+			g.BridgeTotals[0].Withdrawn = types.NewInt(-1)
+		}, expErr: true},
+		"missing bridge total": {mutate: func(g *GenesisState) {
+			g.BridgeTotals[0].CommunityPool = types.Int{}
+		}, expErr: true},
+		"negative opening outstanding balance": {mutate: func(g *GenesisState) {
+			g.BridgeTotals[0].OpeningOutstanding = types.NewInt(-1)
+		}, expErr: true},
+		"ethereum block time estimate without samples": {mutate: func(g *GenesisState) {
+			g.EthereumBlockTimeEstimate.Samples = 0
+		}, expErr: true},
+		"ethereum block time estimate without last sample": {mutate: func(g *GenesisState) {
+			g.EthereumBlockTimeEstimate.CosmosBlockTime = 0
+		}, expErr: true},
+		"claim not unpacked": {mutate: func(g *GenesisState) {
+			g.Attestations[0].Claim = &codectypes.Any{TypeUrl: g.Attestations[0].Claim.TypeUrl, Value: g.Attestations[0].Claim.Value}
+		}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			g := valid()
+			spec.mutate(g)
+			err := g.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
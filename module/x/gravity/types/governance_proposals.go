@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -12,6 +13,9 @@ const (
 	ProposalTypeUnhaltBridge = "UnhaltBridge"
 	ProposalTypeAirdrop      = "Airdrop"
 	ProposalTypeIBCMetadata  = "IBCMetadata"
+	ProposalTypeCancelBatch  = "CancelBatch"
+
+	ProposalTypeRemovePendingTransfers = "RemovePendingTransfers"
 )
 
 func (p *UnhaltBridgeProposal) GetTitle() string { return p.Title }
@@ -126,3 +130,93 @@ func (p IBCMetadataProposal) String() string {
 `, p.Title, p.Description, p.Metadata.Name, p.Metadata.Symbol, p.Metadata.Display, decimals, p.Metadata.Description))
 	return b.String()
 }
+
+func (p *CancelBatchProposal) GetTitle() string { return p.Title }
+
+func (p *CancelBatchProposal) GetDescription() string { return p.Description }
+
+func (p *CancelBatchProposal) ProposalRoute() string { return RouterKey }
+
+func (p *CancelBatchProposal) ProposalType() string {
+	return ProposalTypeCancelBatch
+}
+
+func (p *CancelBatchProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if err := ValidateEthAddress(p.TokenContract); err != nil {
+		return sdkerrors.Wrap(err, "token contract")
+	}
+	if p.BatchNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "batch nonce")
+	}
+	ids := make(map[uint64]struct{}, len(p.RemoveTxIds))
+	for _, id := range p.RemoveTxIds {
+		if _, ok := ids[id]; ok || id == 0 {
+			return sdkerrors.Wrapf(ErrInvalid, "remove tx id %d", id)
+		}
+		ids[id] = struct{}{}
+	}
+	return nil
+}
+
+func (p CancelBatchProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Cancel Batch Proposal:
+  Title:          %s
+  Description:    %s
+  Token Contract: %s
+  Batch Nonce:    %d
+  Remove Tx Ids:  %v
+`, p.Title, p.Description, p.TokenContract, p.BatchNonce, p.RemoveTxIds))
+	return b.String()
+}
+
+func (p *RemovePendingTransfersProposal) GetTitle() string { return p.Title }
+
+func (p *RemovePendingTransfersProposal) GetDescription() string { return p.Description }
+
+func (p *RemovePendingTransfersProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemovePendingTransfersProposal) ProposalType() string {
+	return ProposalTypeRemovePendingTransfers
+}
+
+func (p *RemovePendingTransfersProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.Senders) == 0 && len(p.Destinations) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "senders and destinations")
+	}
+	for _, sender := range p.Senders {
+		if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+			return sdkerrors.Wrapf(err, "sender %s", sender)
+		}
+	}
+	for _, destination := range p.Destinations {
+		if err := ValidateEthAddress(destination); err != nil {
+			return sdkerrors.Wrapf(err, "destination %s", destination)
+		}
+	}
+	return nil
+}
+
+func (p RemovePendingTransfersProposal) String() string {
+	recipient := "Community Pool"
+	if p.RefundSenders {
+		recipient = "Senders"
+	}
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Remove Pending Transfers Proposal:
+  Title:          %s
+  Description:    %s
+  Senders:        %s
+  Destinations:   %s
+  Funds Sent To:  %s
+`, p.Title, p.Description, strings.Join(p.Senders, ", "), strings.Join(p.Destinations, ", "), recipient))
+	return b.String()
+}
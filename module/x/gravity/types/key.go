@@ -4,7 +4,9 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 const (
@@ -19,6 +21,9 @@ const (
 
 	// QuerierRoute to be used for querierer msgs
 	QuerierRoute = ModuleName
+
+	// TStoreKey to be used when creating the transient store, which only caches checkpoints for the current block
+	TStoreKey = "transient_" + ModuleName + "_checkpoints"
 )
 
 var (
@@ -149,6 +154,83 @@ var (
 	// PendingIBCAutoForwards indexes pending SendToCosmos sends via IBC, queued by event nonce
 	// [0x5b89a7c5dc9abd2a7abc2560d6eb42ea]
 	PendingIbcAutoForwards = HashString("IbcAutoForwardQueue")
+
+	// LatestValsetTime indexes the block time at which the latest valset request was created
+	// [0xa4a0eec14adede95be0104be80a61aa6]
+	LatestValsetTime = HashString("LatestValsetTime")
+
+	// DelegateKeyNonceKey indexes the number of times each validator has set its delegate keys
+	// [0x99fa847b83ed01bba5f1eb98658f9a48]
+	DelegateKeyNonceKey = HashString("DelegateKeyNonceKey")
+
+	// RetiredOrchestratorAddressKey indexes the validator of orchestrator keys replaced by a delegate key rotation
+	// [0x306bcdd68d8d23912cf6b190674418db]
+	RetiredOrchestratorAddressKey = HashString("RetiredOrchestratorAddressKey")
+
+	// DelegateKeyRotationKey indexes the delegate key rotations of each validator by height
+	// [0xf8a716b4084556e2dc5fb308c15145a3]
+	DelegateKeyRotationKey = HashString("DelegateKeyRotationKey")
+
+	// EthAddressValidityKey indexes the validator and validity height range of every ethereum address ever registered
+	// [0xa4efbe48ce9cf2943587a533b34cb110]
+	EthAddressValidityKey = HashString("EthAddressValidityKey")
+
+	// PastEthSignatureCheckpointHeightKey indexes the past eth signature checkpoints by creation height for pruning
+	// [0x74235a9774eca40bc14cdc5ea78f713b]
+	PastEthSignatureCheckpointHeightKey = HashString("PastEthSignatureCheckpointHeightKey")
+
+	// PrunedCheckpointNonceKey indexes the highest nonce pruned from the checkpoint archive by checkpoint type
+	// [0x9e1acef327bd00e4fe3fce38d76f0f3a]
+	PrunedCheckpointNonceKey = HashString("PrunedCheckpointNonceKey")
+
+	// BridgeTotalsKey indexes the lifetime bridge totals by token contract
+	// [0xeea2796aaa314fc43c3290a521ea7c8f]
+	BridgeTotalsKey = HashString("BridgeTotalsKey")
+
+	// EthereumBlockTimeEstimateKey indexes the moving average of the Ethereum block time
+	// [0xc1686cc01c3728cf5c51dd467b43f987]
+	EthereumBlockTimeEstimateKey = HashString("EthereumBlockTimeEstimateKey")
+
+	// BatchTimeoutCursorKey indexes the key of the last batch checked for a timeout by the EndBlocker
+	// [0x91fe5d3ec602c40722b4aebd1d1bf1c7]
+	BatchTimeoutCursorKey = HashString("BatchTimeoutCursorKey")
+
+	// LogicCallTimeoutCursorKey indexes the key of the last logic call checked for a timeout by the EndBlocker
+	// [0x67dbd658d58b69798cc28542a39dcff5]
+	LogicCallTimeoutCursorKey = HashString("LogicCallTimeoutCursorKey")
+
+	// OutgoingTXBatchBlockKey indexes the keys of the outgoing tx batches by the block they were created in
+	// [0xbf552a0a76370ff01990c93ffa4d4dc7]
+	OutgoingTXBatchBlockKey = HashString("OutgoingTXBatchBlockKey")
+
+	// OutgoingLogicCallBlockKey indexes the keys of the outgoing logic calls by the block they were created in
+	// [0x81ee76ce0fcadfba697f4bf3c5712763]
+	OutgoingLogicCallBlockKey = HashString("OutgoingLogicCallBlockKey")
+
+	// ValsetCheckpointCacheKey indexes the valset checkpoints computed in the current block, transient store only
+	// [0xe5091ded777b90cef5adb9e998a55d19]
+	ValsetCheckpointCacheKey = HashString("ValsetCheckpointCacheKey")
+
+	// BatchCheckpointCacheKey indexes the batch checkpoints computed in the current block, transient store only
+	// [0x4ea78afd6eea09a93710230ee6ebfb49]
+	BatchCheckpointCacheKey = HashString("BatchCheckpointCacheKey")
+
+	// LogicCallCheckpointCacheKey indexes the logic call checkpoints computed in the current block, transient
+	// store only
+	// [0xd97ec0b6c637fae9839843b09570a33d]
+	LogicCallCheckpointCacheKey = HashString("LogicCallCheckpointCacheKey")
+
+	// BatchTxRemovalsKey indexes the transactions a cancel batch proposal removes from a batch by the batch
+	// [0x4217f7aebd245f30ed36df902f8dc2fe]
+	BatchTxRemovalsKey = HashString("BatchTxRemovalsKey")
+
+	// PoolStatsKey indexes the number of transactions and the total fees in the pool by token contract
+	// [0x8decf16bb26fc46c27bd3c0a99b415e9]
+	PoolStatsKey = HashString("PoolStatsKey")
+
+	// PendingAttestationsKey indexes the number of attestations which have not been observed yet by claim type
+	// [0x494a0bf99fce5de4fd6cb4370c2da44b]
+	PendingAttestationsKey = HashString("PendingAttestationsKey")
 )
 
 // GetOrchestratorAddressKey returns the following key format
@@ -171,6 +253,43 @@ func GetEthAddressByValidatorKey(validator sdk.ValAddress) []byte {
 	return AppendBytes(EthAddressByValidatorKey, validator.Bytes())
 }
 
+// GetDelegateKeyNonceKey returns the following key format
+// prefix              cosmos-validator
+// [0x0][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetDelegateKeyNonceKey(validator sdk.ValAddress) []byte {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return AppendBytes(DelegateKeyNonceKey, validator.Bytes())
+}
+
+// GetRetiredOrchestratorAddressKey returns the following key format
+// prefix 				orchestrator address
+// [0x0][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetRetiredOrchestratorAddressKey(orc sdk.AccAddress) []byte {
+	if err := sdk.VerifyAddressFormat(orc); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid orchestrator address"))
+	}
+	return AppendBytes(RetiredOrchestratorAddressKey, orc.Bytes())
+}
+
+// GetDelegateKeyRotationValidatorPrefix returns the following key format
+// prefix              length prefixed cosmos-validator
+// [0x0][20][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetDelegateKeyRotationValidatorPrefix(validator sdk.ValAddress) []byte {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return AppendBytes(DelegateKeyRotationKey, address.MustLengthPrefix(validator.Bytes()))
+}
+
+// GetDelegateKeyRotationKey returns the following key format
+// prefix              length prefixed cosmos-validator                         height
+// [0x0][20][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm][0 0 0 0 0 0 0 1]
+func GetDelegateKeyRotationKey(validator sdk.ValAddress, height uint64) []byte {
+	return AppendBytes(GetDelegateKeyRotationValidatorPrefix(validator), UInt64Bytes(height))
+}
+
 // GetValidatorByEthAddressKey returns the following key format
 // prefix              cosmos-validator
 // [0x0][0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B]
@@ -178,6 +297,13 @@ func GetValidatorByEthAddressKey(ethAddress EthAddress) []byte {
 	return AppendBytes(ValidatorByEthAddressKey, ethAddress.GetAddress().Bytes())
 }
 
+// GetEthAddressValidityKey returns the following key format
+// prefix              ethereum address
+// [0x0][0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B]
+func GetEthAddressValidityKey(ethAddress EthAddress) []byte {
+	return AppendBytes(EthAddressValidityKey, ethAddress.GetAddress().Bytes())
+}
+
 // GetValsetKey returns the following key format
 // prefix    nonce
 // [0x0][0 0 0 0 0 0 0 1]
@@ -245,6 +371,13 @@ func GetOutgoingTxBatchKey(tokenContract EthAddress, nonce uint64) []byte {
 	return AppendBytes(GetOutgoingTxBatchContractPrefix(tokenContract), UInt64Bytes(nonce))
 }
 
+// GetOutgoingTxBatchBlockKey returns the following key format
+// prefix     block                eth-contract-address                     nonce
+// [0x0][0 0 0 0 0 0 0 1][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetOutgoingTxBatchBlockKey(block uint64, tokenContract EthAddress, nonce uint64) []byte {
+	return AppendBytes(OutgoingTXBatchBlockKey, UInt64Bytes(block), tokenContract.GetAddress().Bytes(), UInt64Bytes(nonce))
+}
+
 // GetBatchConfirmNonceContractPrefix returns
 // prefix           eth-contract-address                BatchNonce
 // [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
@@ -286,6 +419,13 @@ func GetOutgoingLogicCallKey(invalidationId []byte, invalidationNonce uint64) []
 	return AppendBytes(KeyOutgoingLogicCall, invalidationId, UInt64Bytes(invalidationNonce))
 }
 
+// GetOutgoingLogicCallBlockKey returns the following key format
+// prefix     block                invalidation-id      nonce
+// [0x0][0 0 0 0 0 0 0 1][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetOutgoingLogicCallBlockKey(block uint64, invalidationId []byte, invalidationNonce uint64) []byte {
+	return AppendBytes(OutgoingLogicCallBlockKey, UInt64Bytes(block), invalidationId, UInt64Bytes(invalidationNonce))
+}
+
 func GetLogicConfirmNonceInvalidationIdPrefix(invalidationId []byte, invalidationNonce uint64) []byte {
 	return AppendBytes(KeyOutgoingLogicConfirm, invalidationId, UInt64Bytes(invalidationNonce))
 }
@@ -313,6 +453,99 @@ func convertByteArrToString(value []byte) string {
 	return ret.String()
 }
 
+// GetCheckpointFromPastEthSignatureCheckpointKey recovers the checkpoint from a key built by
+// GetPastEthSignatureCheckpointKey, the prefix must already be stripped. Every checkpoint byte was
+// written as the UTF-8 encoding of a single rune, so decoding the runes reverses convertByteArrToString
+func GetCheckpointFromPastEthSignatureCheckpointKey(key []byte) []byte {
+	runes := []rune(string(key))
+	checkpoint := make([]byte, len(runes))
+	for i, r := range runes {
+		checkpoint[i] = byte(r)
+	}
+	return checkpoint
+}
+
+// GetPastEthSignatureCheckpointHeightKey returns the following key format
+// prefix    height             checkpoint
+// [0x0][0 0 0 0 0 0 0 1][ checkpoint bytes ]
+func GetPastEthSignatureCheckpointHeightKey(height uint64, checkpoint []byte) []byte {
+	return AppendBytes(PastEthSignatureCheckpointHeightKey, UInt64Bytes(height), checkpoint)
+}
+
+// GetCheckpointNonceScope returns the scope within which the nonces of a checkpoint type are ordered: the token
+// contract of a batch, the invalidation id of a logic call and nothing for a valset
+func GetCheckpointNonceScope(checkpointType CheckpointType, tokenContract string, invalidationID []byte) []byte {
+	switch checkpointType {
+	case CHECKPOINT_TYPE_BATCH:
+		return gethcommon.HexToAddress(tokenContract).Bytes()
+	case CHECKPOINT_TYPE_LOGIC_CALL:
+		return invalidationID
+	default:
+		return nil
+	}
+}
+
+// GetPrunedCheckpointNonceKey returns the following key format, the scope is the one of GetCheckpointNonceScope
+// prefix    type  scope
+// [0x0][0x2][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetPrunedCheckpointNonceKey(checkpointType CheckpointType, scope []byte) []byte {
+	return AppendBytes(PrunedCheckpointNonceKey, []byte{byte(checkpointType)}, scope)
+}
+
+// GetBridgeTotalsKey returns the following key format
+// prefix    token contract
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetBridgeTotalsKey(tokenContract EthAddress) []byte {
+	return AppendBytes(BridgeTotalsKey, tokenContract.GetAddress().Bytes())
+}
+
+// GetBatchTxRemovalsKey returns the following key format
+// prefix     eth-contract-address                     nonce
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetBatchTxRemovalsKey(tokenContract EthAddress, nonce uint64) []byte {
+	return AppendBytes(BatchTxRemovalsKey, tokenContract.GetAddress().Bytes(), UInt64Bytes(nonce))
+}
+
+// GetPoolStatsKey returns the following key format
+// prefix    token contract
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetPoolStatsKey(tokenContract EthAddress) []byte {
+	return AppendBytes(PoolStatsKey, tokenContract.GetAddress().Bytes())
+}
+
+// GetPendingAttestationsKey returns the following key format
+// prefix    claim type
+// [0x0][0x1]
+func GetPendingAttestationsKey(claimType ClaimType) []byte {
+	return AppendBytes(PendingAttestationsKey, []byte{byte(claimType)})
+}
+
+// GetValsetCheckpointCacheKey returns the following key format, the gravity id is part of the key since the
+// checkpoint depends on it
+// prefix       nonce           gravity id
+// [0x0][0 0 0 0 0 0 0 1][ gravity id bytes ]
+func GetValsetCheckpointCacheKey(gravityID string, nonce uint64) []byte {
+	return AppendBytes(ValsetCheckpointCacheKey, UInt64Bytes(nonce), []byte(gravityID))
+}
+
+// GetBatchCheckpointCacheKey returns the following key format
+// prefix           eth-contract-address                BatchNonce        gravity id
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1][ gravity id bytes ]
+func GetBatchCheckpointCacheKey(gravityID string, tokenContract EthAddress, nonce uint64) []byte {
+	return AppendBytes(BatchCheckpointCacheKey, tokenContract.GetAddress().Bytes(), UInt64Bytes(nonce), []byte(gravityID))
+}
+
+// GetLogicCallCheckpointCacheKey returns the following key format, the invalidation id is length prefixed so
+// that the variable length gravity id can follow it
+// prefix   invalidation id length  invalidation id  invalidation nonce   gravity id
+// [0x0][0 0 0 0 0 0 0 32][ invalidation id bytes ][0 0 0 0 0 0 0 1][ gravity id bytes ]
+func GetLogicCallCheckpointCacheKey(gravityID string, invalidationID []byte, invalidationNonce uint64) []byte {
+	return AppendBytes(
+		LogicCallCheckpointCacheKey, UInt64Bytes(uint64(len(invalidationID))), invalidationID,
+		UInt64Bytes(invalidationNonce), []byte(gravityID),
+	)
+}
+
 // GetPendingIbcAutoForwardKey returns the following key format
 // prefix		EventNonce
 // [0x0][0 0 0 0 0 0 0 1]
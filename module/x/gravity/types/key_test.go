@@ -9,7 +9,7 @@ import (
 
 func TestPrefixKeysSameLength(t *testing.T) {
 	allKeys := getAllKeys()
-	prefixKeys := allKeys[0:27]
+	prefixKeys := allKeys[0:38]
 	length := len(HashString("All keys should be same length when hashed"))
 
 	for _, key := range prefixKeys {
@@ -30,7 +30,7 @@ func getAllKeys() [][]byte {
 	i := 0
 	inc := func(i *int) *int { *i += 1; return i }
 
-	keys := make([][]byte, 47)
+	keys := make([][]byte, 81)
 
 	keys[i] = EthAddressByValidatorKey
 	keys[*inc(&i)] = ValidatorByEthAddressKey
@@ -59,6 +59,24 @@ func getAllKeys() [][]byte {
 	keys[*inc(&i)] = LastUnBondingBlockHeight
 	keys[*inc(&i)] = LastObservedValsetKey
 	keys[*inc(&i)] = PastEthSignatureCheckpointKey
+	keys[*inc(&i)] = DelegateKeyNonceKey
+	keys[*inc(&i)] = RetiredOrchestratorAddressKey
+	keys[*inc(&i)] = DelegateKeyRotationKey
+	keys[*inc(&i)] = EthAddressValidityKey
+	keys[*inc(&i)] = PastEthSignatureCheckpointHeightKey
+	keys[*inc(&i)] = PrunedCheckpointNonceKey
+	keys[*inc(&i)] = BridgeTotalsKey
+	keys[*inc(&i)] = EthereumBlockTimeEstimateKey
+	keys[*inc(&i)] = BatchTimeoutCursorKey
+	keys[*inc(&i)] = LogicCallTimeoutCursorKey
+	keys[*inc(&i)] = OutgoingTXBatchBlockKey
+	keys[*inc(&i)] = OutgoingLogicCallBlockKey
+	keys[*inc(&i)] = ValsetCheckpointCacheKey
+	keys[*inc(&i)] = BatchCheckpointCacheKey
+	keys[*inc(&i)] = LogicCallCheckpointCacheKey
+	keys[*inc(&i)] = BatchTxRemovalsKey
+	keys[*inc(&i)] = PoolStatsKey
+	keys[*inc(&i)] = PendingAttestationsKey
 
 	// sdk.AccAddress, sdk.ValAddress
 	dummyAddr := []byte("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
@@ -85,15 +103,31 @@ func getAllKeys() [][]byte {
 	keys[*inc(&i)] = GetOutgoingTxPoolKey(dummyErc, dummyNonce)
 	keys[*inc(&i)] = GetOutgoingTxBatchContractPrefix(dummyEthAddr)
 	keys[*inc(&i)] = GetOutgoingTxBatchKey(dummyEthAddr, dummyNonce)
+	keys[*inc(&i)] = GetOutgoingTxBatchBlockKey(dummyNonce, dummyEthAddr, dummyNonce)
 	keys[*inc(&i)] = GetBatchConfirmNonceContractPrefix(dummyEthAddr, dummyNonce)
 	keys[*inc(&i)] = GetBatchConfirmKey(dummyEthAddr, dummyNonce, dummyAddr)
 	keys[*inc(&i)] = GetLastEventNonceByValidatorKey(dummyAddr)
 	keys[*inc(&i)] = GetDenomToERC20Key(dummyDenom)
 	keys[*inc(&i)] = GetERC20ToDenomKey(dummyEthAddr)
 	keys[*inc(&i)] = GetOutgoingLogicCallKey(dummyBytes, dummyNonce)
+	keys[*inc(&i)] = GetOutgoingLogicCallBlockKey(dummyNonce, dummyBytes, dummyNonce)
 	keys[*inc(&i)] = GetLogicConfirmNonceInvalidationIdPrefix(dummyBytes, dummyNonce)
 	keys[*inc(&i)] = GetLogicConfirmKey(dummyBytes, dummyNonce, dummyAddr)
 	keys[*inc(&i)] = GetPastEthSignatureCheckpointKey(dummyBytes)
+	keys[*inc(&i)] = GetDelegateKeyNonceKey(dummyAddr)
+	keys[*inc(&i)] = GetRetiredOrchestratorAddressKey(dummyAddr)
+	keys[*inc(&i)] = GetDelegateKeyRotationValidatorPrefix(dummyAddr)
+	keys[*inc(&i)] = GetDelegateKeyRotationKey(dummyAddr, dummyNonce)
+	keys[*inc(&i)] = GetEthAddressValidityKey(dummyEthAddr)
+	keys[*inc(&i)] = GetPastEthSignatureCheckpointHeightKey(dummyNonce, dummyBytes)
+	keys[*inc(&i)] = GetPrunedCheckpointNonceKey(CHECKPOINT_TYPE_LOGIC_CALL, dummyBytes)
+	keys[*inc(&i)] = GetBridgeTotalsKey(dummyEthAddr)
+	keys[*inc(&i)] = GetBatchTxRemovalsKey(dummyEthAddr, dummyNonce)
+	keys[*inc(&i)] = GetPoolStatsKey(dummyEthAddr)
+	keys[*inc(&i)] = GetPendingAttestationsKey(CLAIM_TYPE_SEND_TO_COSMOS)
+	keys[*inc(&i)] = GetValsetCheckpointCacheKey(dummyDenom, dummyNonce)
+	keys[*inc(&i)] = GetBatchCheckpointCacheKey(dummyDenom, dummyEthAddr, dummyNonce)
+	keys[*inc(&i)] = GetLogicCallCheckpointCacheKey(dummyDenom, dummyBytes, dummyNonce)
 
 	return keys
 }
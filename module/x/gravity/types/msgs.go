@@ -6,12 +6,14 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
 //nolint: exhaustivestruct
 var (
 	_ sdk.Msg = &MsgSetOrchestratorAddress{}
+	_ sdk.Msg = &MsgRotateDelegateKeys{}
 	_ sdk.Msg = &MsgValsetConfirm{}
 	_ sdk.Msg = &MsgSendToEth{}
 	_ sdk.Msg = &MsgCancelSendToEth{}
@@ -27,12 +29,14 @@ var (
 	_ sdk.Msg = &MsgSubmitBadSignatureEvidence{}
 )
 
-// NewMsgSetOrchestratorAddress returns a new msgSetOrchestratorAddress
-func NewMsgSetOrchestratorAddress(val sdk.ValAddress, oper sdk.AccAddress, eth EthAddress) *MsgSetOrchestratorAddress {
+// NewMsgSetOrchestratorAddress returns a new msgSetOrchestratorAddress, ethSignature is the
+// signature of the eth key over the hash returned by GetDelegateKeysSignHash
+func NewMsgSetOrchestratorAddress(val sdk.ValAddress, oper sdk.AccAddress, eth EthAddress, ethSignature []byte) *MsgSetOrchestratorAddress {
 	return &MsgSetOrchestratorAddress{
 		Validator:    val.String(),
 		Orchestrator: oper.String(),
 		EthAddress:   eth.GetAddress().Hex(),
+		EthSignature: hex.EncodeToString(ethSignature),
 	}
 }
 
@@ -44,6 +48,21 @@ func (msg *MsgSetOrchestratorAddress) Type() string { return "set_operator_addre
 
 // ValidateBasic performs stateless checks
 func (msg *MsgSetOrchestratorAddress) ValidateBasic() (err error) {
+	if err := msg.ValidateAddresses(); err != nil {
+		return err
+	}
+	if msg.EthSignature == "" {
+		return sdkerrors.Wrap(ErrEmpty, "ethereum signature")
+	}
+	if _, err := hex.DecodeString(msg.EthSignature); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Could not decode hex string %s", msg.EthSignature)
+	}
+	return nil
+}
+
+// ValidateAddresses checks the delegate keys without the proof of possession of the eth key, which
+// is only present on messages and not on the delegate keys stored in state or genesis
+func (msg *MsgSetOrchestratorAddress) ValidateAddresses() (err error) {
 	if _, err = sdk.ValAddressFromBech32(msg.Validator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Validator)
 	}
@@ -70,6 +89,72 @@ func (msg *MsgSetOrchestratorAddress) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(acc)}
 }
 
+// NewMsgRotateDelegateKeys returns a new msgRotateDelegateKeys, ethSignature is the signature of
+// the new eth key over the hash returned by GetDelegateKeysSignHash
+func NewMsgRotateDelegateKeys(val sdk.ValAddress, oper sdk.AccAddress, eth EthAddress, ethSignature []byte) *MsgRotateDelegateKeys {
+	return &MsgRotateDelegateKeys{
+		Validator:    val.String(),
+		Orchestrator: oper.String(),
+		EthAddress:   eth.GetAddress().Hex(),
+		EthSignature: hex.EncodeToString(ethSignature),
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgRotateDelegateKeys) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgRotateDelegateKeys) Type() string { return "rotate_delegate_keys" }
+
+// ValidateBasic performs stateless checks, which are the same as for MsgSetOrchestratorAddress
+func (msg *MsgRotateDelegateKeys) ValidateBasic() (err error) {
+	return msg.DelegateKeys().ValidateBasic()
+}
+
+// DelegateKeys returns the new delegate keys of the validator in the format they are stored in
+func (msg *MsgRotateDelegateKeys) DelegateKeys() *MsgSetOrchestratorAddress {
+	return &MsgSetOrchestratorAddress{
+		Validator:    msg.Validator,
+		Orchestrator: msg.Orchestrator,
+		EthAddress:   msg.EthAddress,
+		EthSignature: msg.EthSignature,
+	}
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRotateDelegateKeys) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgRotateDelegateKeys) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(acc)}
+}
+
+// delegateKeysSignDomain separates the hashes signed to prove possession of an eth delegate key
+// from every other hash an eth key could be asked to sign
+const delegateKeysSignDomain = "gravity-delegate-keys"
+
+// GetDelegateKeysSignHash returns the hash the eth key of a validator must sign to be registered as
+// its delegate eth key, nonce is the delegate key nonce of the validator
+func GetDelegateKeysSignHash(gravityID string, val sdk.ValAddress, orch sdk.AccAddress, nonce uint64) []byte {
+	signMsg := DelegateKeysSignMsg{
+		GravityId:    gravityID,
+		Validator:    val.String(),
+		Orchestrator: orch.String(),
+		Nonce:        nonce,
+	}
+	bz, err := signMsg.Marshal()
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "unable to marshal delegate keys sign msg"))
+	}
+	return crypto.Keccak256(append([]byte(delegateKeysSignDomain), bz...))
+}
+
 // NewMsgValsetConfirm returns a new msgValsetConfirm
 func NewMsgValsetConfirm(
 	nonce uint64,
@@ -46,10 +46,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // ETH_ADDRESS
 // This is a hex encoded 0x Ethereum public key that will be used by this validator
 // on Ethereum
+// ETH_SIGNATURE
+// This is a hex encoded Ethereum signature by the ETH_ADDRESS key over the hash of
+// a DelegateKeysSignMsg, proving that the validator controls the key
 type MsgSetOrchestratorAddress struct {
 	Validator    string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 	Orchestrator string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
 	EthAddress   string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	EthSignature string `protobuf:"bytes,4,opt,name=eth_signature,json=ethSignature,proto3" json:"eth_signature,omitempty"`
 }
 
 func (m *MsgSetOrchestratorAddress) Reset()         { *m = MsgSetOrchestratorAddress{} }
@@ -106,6 +110,88 @@ func (m *MsgSetOrchestratorAddress) GetEthAddress() string {
 	return ""
 }
 
+func (m *MsgSetOrchestratorAddress) GetEthSignature() string {
+	if m != nil {
+		return m.EthSignature
+	}
+	return ""
+}
+
+// DelegateKeysSignMsg
+// this is the payload an Ethereum delegate key signs to prove that the validator
+// registering it controls the key. The signed hash is the keccak256 of the
+// domain separator "gravity-delegate-keys" followed by the protobuf encoding of
+// this message. The nonce is the delegate key nonce of the validator, which is
+// incremented every time the validator's delegate keys are set, so that a
+// signature can not be replayed
+type DelegateKeysSignMsg struct {
+	GravityId    string `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+	Validator    string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Orchestrator string `protobuf:"bytes,3,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	Nonce        uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *DelegateKeysSignMsg) Reset()         { *m = DelegateKeysSignMsg{} }
+func (m *DelegateKeysSignMsg) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysSignMsg) ProtoMessage()    {}
+func (*DelegateKeysSignMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{1}
+}
+func (m *DelegateKeysSignMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateKeysSignMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeysSignMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegateKeysSignMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysSignMsg.Merge(m, src)
+}
+func (m *DelegateKeysSignMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateKeysSignMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysSignMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateKeysSignMsg proto.InternalMessageInfo
+
+func (m *DelegateKeysSignMsg) GetGravityId() string {
+	if m != nil {
+		return m.GravityId
+	}
+	return ""
+}
+
+func (m *DelegateKeysSignMsg) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *DelegateKeysSignMsg) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
+}
+
+func (m *DelegateKeysSignMsg) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 type MsgSetOrchestratorAddressResponse struct {
 }
 
@@ -113,7 +199,7 @@ func (m *MsgSetOrchestratorAddressResponse) Reset()         { *m = MsgSetOrchest
 func (m *MsgSetOrchestratorAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetOrchestratorAddressResponse) ProtoMessage()    {}
 func (*MsgSetOrchestratorAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{1}
+	return fileDescriptor_2f8523f2f6feb451, []int{2}
 }
 func (m *MsgSetOrchestratorAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -142,6 +228,119 @@ func (m *MsgSetOrchestratorAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetOrchestratorAddressResponse proto.InternalMessageInfo
 
+// MsgRotateDelegateKeys
+// this message allows a validator which has already set its delegate keys to
+// replace its orchestrator and/or Ethereum key, for example because one of them
+// was lost or compromised. The fields are the same as in MsgSetOrchestratorAddress,
+// ETH_SIGNATURE is made by the new Ethereum key over a DelegateKeysSignMsg with the
+// validator's current delegate key nonce.
+// Confirms made with the previous keys stay valid for the valsets, batches and
+// logic calls created before the rotation and a new valset is requested so that
+// the new Ethereum key is sent to the Gravity contract
+type MsgRotateDelegateKeys struct {
+	Validator    string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Orchestrator string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	EthAddress   string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	EthSignature string `protobuf:"bytes,4,opt,name=eth_signature,json=ethSignature,proto3" json:"eth_signature,omitempty"`
+}
+
+func (m *MsgRotateDelegateKeys) Reset()         { *m = MsgRotateDelegateKeys{} }
+func (m *MsgRotateDelegateKeys) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateDelegateKeys) ProtoMessage()    {}
+func (*MsgRotateDelegateKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{3}
+}
+func (m *MsgRotateDelegateKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateDelegateKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateDelegateKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateDelegateKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateDelegateKeys.Merge(m, src)
+}
+func (m *MsgRotateDelegateKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateDelegateKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateDelegateKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateDelegateKeys proto.InternalMessageInfo
+
+func (m *MsgRotateDelegateKeys) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *MsgRotateDelegateKeys) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
+}
+
+func (m *MsgRotateDelegateKeys) GetEthAddress() string {
+	if m != nil {
+		return m.EthAddress
+	}
+	return ""
+}
+
+func (m *MsgRotateDelegateKeys) GetEthSignature() string {
+	if m != nil {
+		return m.EthSignature
+	}
+	return ""
+}
+
+type MsgRotateDelegateKeysResponse struct {
+}
+
+func (m *MsgRotateDelegateKeysResponse) Reset()         { *m = MsgRotateDelegateKeysResponse{} }
+func (m *MsgRotateDelegateKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateDelegateKeysResponse) ProtoMessage()    {}
+func (*MsgRotateDelegateKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{4}
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateDelegateKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateDelegateKeysResponse.Merge(m, src)
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateDelegateKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateDelegateKeysResponse proto.InternalMessageInfo
+
 // MsgValsetConfirm
 // this is the message sent by the validators when they wish to submit their
 // signatures over the validator set at a given block height. A validator must
@@ -168,7 +367,7 @@ func (m *MsgValsetConfirm) Reset()         { *m = MsgValsetConfirm{} }
 func (m *MsgValsetConfirm) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetConfirm) ProtoMessage()    {}
 func (*MsgValsetConfirm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{2}
+	return fileDescriptor_2f8523f2f6feb451, []int{5}
 }
 func (m *MsgValsetConfirm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,7 +431,7 @@ func (m *MsgValsetConfirmResponse) Reset()         { *m = MsgValsetConfirmRespon
 func (m *MsgValsetConfirmResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetConfirmResponse) ProtoMessage()    {}
 func (*MsgValsetConfirmResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{3}
+	return fileDescriptor_2f8523f2f6feb451, []int{6}
 }
 func (m *MsgValsetConfirmResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,7 +483,7 @@ func (m *MsgSendToEth) Reset()         { *m = MsgSendToEth{} }
 func (m *MsgSendToEth) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToEth) ProtoMessage()    {}
 func (*MsgSendToEth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{4}
+	return fileDescriptor_2f8523f2f6feb451, []int{7}
 }
 func (m *MsgSendToEth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,7 +547,7 @@ func (m *MsgSendToEthResponse) Reset()         { *m = MsgSendToEthResponse{} }
 func (m *MsgSendToEthResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToEthResponse) ProtoMessage()    {}
 func (*MsgSendToEthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{5}
+	return fileDescriptor_2f8523f2f6feb451, []int{8}
 }
 func (m *MsgSendToEthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,7 +594,7 @@ func (m *MsgRequestBatch) Reset()         { *m = MsgRequestBatch{} }
 func (m *MsgRequestBatch) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatch) ProtoMessage()    {}
 func (*MsgRequestBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{6}
+	return fileDescriptor_2f8523f2f6feb451, []int{9}
 }
 func (m *MsgRequestBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -445,7 +644,7 @@ func (m *MsgRequestBatchResponse) Reset()         { *m = MsgRequestBatchResponse
 func (m *MsgRequestBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatchResponse) ProtoMessage()    {}
 func (*MsgRequestBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{7}
+	return fileDescriptor_2f8523f2f6feb451, []int{10}
 }
 func (m *MsgRequestBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -494,7 +693,7 @@ func (m *MsgConfirmBatch) Reset()         { *m = MsgConfirmBatch{} }
 func (m *MsgConfirmBatch) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmBatch) ProtoMessage()    {}
 func (*MsgConfirmBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{8}
+	return fileDescriptor_2f8523f2f6feb451, []int{11}
 }
 func (m *MsgConfirmBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -565,7 +764,7 @@ func (m *MsgConfirmBatchResponse) Reset()         { *m = MsgConfirmBatchResponse
 func (m *MsgConfirmBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmBatchResponse) ProtoMessage()    {}
 func (*MsgConfirmBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{9}
+	return fileDescriptor_2f8523f2f6feb451, []int{12}
 }
 func (m *MsgConfirmBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -614,7 +813,7 @@ func (m *MsgConfirmLogicCall) Reset()         { *m = MsgConfirmLogicCall{} }
 func (m *MsgConfirmLogicCall) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmLogicCall) ProtoMessage()    {}
 func (*MsgConfirmLogicCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{10}
+	return fileDescriptor_2f8523f2f6feb451, []int{13}
 }
 func (m *MsgConfirmLogicCall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,7 +884,7 @@ func (m *MsgConfirmLogicCallResponse) Reset()         { *m = MsgConfirmLogicCall
 func (m *MsgConfirmLogicCallResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmLogicCallResponse) ProtoMessage()    {}
 func (*MsgConfirmLogicCallResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{11}
+	return fileDescriptor_2f8523f2f6feb451, []int{14}
 }
 func (m *MsgConfirmLogicCallResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +932,7 @@ func (m *MsgSendToCosmosClaim) Reset()         { *m = MsgSendToCosmosClaim{} }
 func (m *MsgSendToCosmosClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToCosmosClaim) ProtoMessage()    {}
 func (*MsgSendToCosmosClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{12}
+	return fileDescriptor_2f8523f2f6feb451, []int{15}
 }
 func (m *MsgSendToCosmosClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +1010,7 @@ func (m *MsgSendToCosmosClaimResponse) Reset()         { *m = MsgSendToCosmosCla
 func (m *MsgSendToCosmosClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToCosmosClaimResponse) ProtoMessage()    {}
 func (*MsgSendToCosmosClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{13}
+	return fileDescriptor_2f8523f2f6feb451, []int{16}
 }
 func (m *MsgSendToCosmosClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -853,7 +1052,7 @@ func (m *MsgExecuteIbcAutoForwards) Reset()         { *m = MsgExecuteIbcAutoForw
 func (m *MsgExecuteIbcAutoForwards) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteIbcAutoForwards) ProtoMessage()    {}
 func (*MsgExecuteIbcAutoForwards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{14}
+	return fileDescriptor_2f8523f2f6feb451, []int{17}
 }
 func (m *MsgExecuteIbcAutoForwards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -903,7 +1102,7 @@ func (m *MsgExecuteIbcAutoForwardsResponse) Reset()         { *m = MsgExecuteIbc
 func (m *MsgExecuteIbcAutoForwardsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteIbcAutoForwardsResponse) ProtoMessage()    {}
 func (*MsgExecuteIbcAutoForwardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{15}
+	return fileDescriptor_2f8523f2f6feb451, []int{18}
 }
 func (m *MsgExecuteIbcAutoForwardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -946,7 +1145,7 @@ func (m *MsgBatchSendToEthClaim) Reset()         { *m = MsgBatchSendToEthClaim{}
 func (m *MsgBatchSendToEthClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgBatchSendToEthClaim) ProtoMessage()    {}
 func (*MsgBatchSendToEthClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{16}
+	return fileDescriptor_2f8523f2f6feb451, []int{19}
 }
 func (m *MsgBatchSendToEthClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1017,7 +1216,7 @@ func (m *MsgBatchSendToEthClaimResponse) Reset()         { *m = MsgBatchSendToEt
 func (m *MsgBatchSendToEthClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBatchSendToEthClaimResponse) ProtoMessage()    {}
 func (*MsgBatchSendToEthClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{17}
+	return fileDescriptor_2f8523f2f6feb451, []int{20}
 }
 func (m *MsgBatchSendToEthClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1064,7 +1263,7 @@ func (m *MsgERC20DeployedClaim) Reset()         { *m = MsgERC20DeployedClaim{} }
 func (m *MsgERC20DeployedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgERC20DeployedClaim) ProtoMessage()    {}
 func (*MsgERC20DeployedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{18}
+	return fileDescriptor_2f8523f2f6feb451, []int{21}
 }
 func (m *MsgERC20DeployedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1156,7 +1355,7 @@ func (m *MsgERC20DeployedClaimResponse) Reset()         { *m = MsgERC20DeployedC
 func (m *MsgERC20DeployedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgERC20DeployedClaimResponse) ProtoMessage()    {}
 func (*MsgERC20DeployedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{19}
+	return fileDescriptor_2f8523f2f6feb451, []int{22}
 }
 func (m *MsgERC20DeployedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1199,7 +1398,7 @@ func (m *MsgLogicCallExecutedClaim) Reset()         { *m = MsgLogicCallExecutedC
 func (m *MsgLogicCallExecutedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgLogicCallExecutedClaim) ProtoMessage()    {}
 func (*MsgLogicCallExecutedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{20}
+	return fileDescriptor_2f8523f2f6feb451, []int{23}
 }
 func (m *MsgLogicCallExecutedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1270,7 +1469,7 @@ func (m *MsgLogicCallExecutedClaimResponse) Reset()         { *m = MsgLogicCallE
 func (m *MsgLogicCallExecutedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLogicCallExecutedClaimResponse) ProtoMessage()    {}
 func (*MsgLogicCallExecutedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{21}
+	return fileDescriptor_2f8523f2f6feb451, []int{24}
 }
 func (m *MsgLogicCallExecutedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1315,7 +1514,7 @@ func (m *MsgValsetUpdatedClaim) Reset()         { *m = MsgValsetUpdatedClaim{} }
 func (m *MsgValsetUpdatedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetUpdatedClaim) ProtoMessage()    {}
 func (*MsgValsetUpdatedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{22}
+	return fileDescriptor_2f8523f2f6feb451, []int{25}
 }
 func (m *MsgValsetUpdatedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1393,7 +1592,7 @@ func (m *MsgValsetUpdatedClaimResponse) Reset()         { *m = MsgValsetUpdatedC
 func (m *MsgValsetUpdatedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetUpdatedClaimResponse) ProtoMessage()    {}
 func (*MsgValsetUpdatedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{23}
+	return fileDescriptor_2f8523f2f6feb451, []int{26}
 }
 func (m *MsgValsetUpdatedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1434,7 +1633,7 @@ func (m *MsgCancelSendToEth) Reset()         { *m = MsgCancelSendToEth{} }
 func (m *MsgCancelSendToEth) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSendToEth) ProtoMessage()    {}
 func (*MsgCancelSendToEth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{24}
+	return fileDescriptor_2f8523f2f6feb451, []int{27}
 }
 func (m *MsgCancelSendToEth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1484,7 +1683,7 @@ func (m *MsgCancelSendToEthResponse) Reset()         { *m = MsgCancelSendToEthRe
 func (m *MsgCancelSendToEthResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSendToEthResponse) ProtoMessage()    {}
 func (*MsgCancelSendToEthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{25}
+	return fileDescriptor_2f8523f2f6feb451, []int{28}
 }
 func (m *MsgCancelSendToEthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1527,7 +1726,7 @@ func (m *MsgSubmitBadSignatureEvidence) Reset()         { *m = MsgSubmitBadSigna
 func (m *MsgSubmitBadSignatureEvidence) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBadSignatureEvidence) ProtoMessage()    {}
 func (*MsgSubmitBadSignatureEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{26}
+	return fileDescriptor_2f8523f2f6feb451, []int{29}
 }
 func (m *MsgSubmitBadSignatureEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,7 +1783,7 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Reset()         { *m = MsgSubmit
 func (m *MsgSubmitBadSignatureEvidenceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBadSignatureEvidenceResponse) ProtoMessage()    {}
 func (*MsgSubmitBadSignatureEvidenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{27}
+	return fileDescriptor_2f8523f2f6feb451, []int{30}
 }
 func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1622,7 +1821,7 @@ func (m *EventSetOperatorAddress) Reset()         { *m = EventSetOperatorAddress
 func (m *EventSetOperatorAddress) String() string { return proto.CompactTextString(m) }
 func (*EventSetOperatorAddress) ProtoMessage()    {}
 func (*EventSetOperatorAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{28}
+	return fileDescriptor_2f8523f2f6feb451, []int{31}
 }
 func (m *EventSetOperatorAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1665,6 +1864,74 @@ func (m *EventSetOperatorAddress) GetAddress() string {
 	return ""
 }
 
+type EventRotateDelegateKeys struct {
+	Message      string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Validator    string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Orchestrator string `protobuf:"bytes,3,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	EthAddress   string `protobuf:"bytes,4,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+}
+
+func (m *EventRotateDelegateKeys) Reset()         { *m = EventRotateDelegateKeys{} }
+func (m *EventRotateDelegateKeys) String() string { return proto.CompactTextString(m) }
+func (*EventRotateDelegateKeys) ProtoMessage()    {}
+func (*EventRotateDelegateKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{32}
+}
+func (m *EventRotateDelegateKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRotateDelegateKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRotateDelegateKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRotateDelegateKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRotateDelegateKeys.Merge(m, src)
+}
+func (m *EventRotateDelegateKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRotateDelegateKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRotateDelegateKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRotateDelegateKeys proto.InternalMessageInfo
+
+func (m *EventRotateDelegateKeys) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *EventRotateDelegateKeys) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *EventRotateDelegateKeys) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
+}
+
+func (m *EventRotateDelegateKeys) GetEthAddress() string {
+	if m != nil {
+		return m.EthAddress
+	}
+	return ""
+}
+
 type EventValsetConfirmKey struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	Key     string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
@@ -1674,7 +1941,7 @@ func (m *EventValsetConfirmKey) Reset()         { *m = EventValsetConfirmKey{} }
 func (m *EventValsetConfirmKey) String() string { return proto.CompactTextString(m) }
 func (*EventValsetConfirmKey) ProtoMessage()    {}
 func (*EventValsetConfirmKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{29}
+	return fileDescriptor_2f8523f2f6feb451, []int{33}
 }
 func (m *EventValsetConfirmKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1726,7 +1993,7 @@ func (m *EventBatchCreated) Reset()         { *m = EventBatchCreated{} }
 func (m *EventBatchCreated) String() string { return proto.CompactTextString(m) }
 func (*EventBatchCreated) ProtoMessage()    {}
 func (*EventBatchCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{30}
+	return fileDescriptor_2f8523f2f6feb451, []int{34}
 }
 func (m *EventBatchCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1778,7 +2045,7 @@ func (m *EventBatchConfirmKey) Reset()         { *m = EventBatchConfirmKey{} }
 func (m *EventBatchConfirmKey) String() string { return proto.CompactTextString(m) }
 func (*EventBatchConfirmKey) ProtoMessage()    {}
 func (*EventBatchConfirmKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{31}
+	return fileDescriptor_2f8523f2f6feb451, []int{35}
 }
 func (m *EventBatchConfirmKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1829,7 +2096,7 @@ func (m *EventBatchSendToEthClaim) Reset()         { *m = EventBatchSendToEthCla
 func (m *EventBatchSendToEthClaim) String() string { return proto.CompactTextString(m) }
 func (*EventBatchSendToEthClaim) ProtoMessage()    {}
 func (*EventBatchSendToEthClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{32}
+	return fileDescriptor_2f8523f2f6feb451, []int{36}
 }
 func (m *EventBatchSendToEthClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1875,7 +2142,7 @@ func (m *EventClaim) Reset()         { *m = EventClaim{} }
 func (m *EventClaim) String() string { return proto.CompactTextString(m) }
 func (*EventClaim) ProtoMessage()    {}
 func (*EventClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{33}
+	return fileDescriptor_2f8523f2f6feb451, []int{37}
 }
 func (m *EventClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1935,7 +2202,7 @@ func (m *EventBadSignatureEvidence) Reset()         { *m = EventBadSignatureEvid
 func (m *EventBadSignatureEvidence) String() string { return proto.CompactTextString(m) }
 func (*EventBadSignatureEvidence) ProtoMessage()    {}
 func (*EventBadSignatureEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{34}
+	return fileDescriptor_2f8523f2f6feb451, []int{38}
 }
 func (m *EventBadSignatureEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1994,7 +2261,7 @@ func (m *EventERC20DeployedClaim) Reset()         { *m = EventERC20DeployedClaim
 func (m *EventERC20DeployedClaim) String() string { return proto.CompactTextString(m) }
 func (*EventERC20DeployedClaim) ProtoMessage()    {}
 func (*EventERC20DeployedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{35}
+	return fileDescriptor_2f8523f2f6feb451, []int{39}
 }
 func (m *EventERC20DeployedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2045,7 +2312,7 @@ func (m *EventValsetUpdatedClaim) Reset()         { *m = EventValsetUpdatedClaim
 func (m *EventValsetUpdatedClaim) String() string { return proto.CompactTextString(m) }
 func (*EventValsetUpdatedClaim) ProtoMessage()    {}
 func (*EventValsetUpdatedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{36}
+	return fileDescriptor_2f8523f2f6feb451, []int{40}
 }
 func (m *EventValsetUpdatedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2092,7 +2359,7 @@ func (m *EventMultisigUpdateRequest) Reset()         { *m = EventMultisigUpdateR
 func (m *EventMultisigUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*EventMultisigUpdateRequest) ProtoMessage()    {}
 func (*EventMultisigUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{37}
+	return fileDescriptor_2f8523f2f6feb451, []int{41}
 }
 func (m *EventMultisigUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2158,7 +2425,7 @@ func (m *EventOutgoingLogicCallCanceled) Reset()         { *m = EventOutgoingLog
 func (m *EventOutgoingLogicCallCanceled) String() string { return proto.CompactTextString(m) }
 func (*EventOutgoingLogicCallCanceled) ProtoMessage()    {}
 func (*EventOutgoingLogicCallCanceled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{38}
+	return fileDescriptor_2f8523f2f6feb451, []int{42}
 }
 func (m *EventOutgoingLogicCallCanceled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2210,7 +2477,7 @@ func (m *EventSignatureSlashing) Reset()         { *m = EventSignatureSlashing{}
 func (m *EventSignatureSlashing) String() string { return proto.CompactTextString(m) }
 func (*EventSignatureSlashing) ProtoMessage()    {}
 func (*EventSignatureSlashing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{39}
+	return fileDescriptor_2f8523f2f6feb451, []int{43}
 }
 func (m *EventSignatureSlashing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2262,7 +2529,7 @@ func (m *EventOutgoingTxId) Reset()         { *m = EventOutgoingTxId{} }
 func (m *EventOutgoingTxId) String() string { return proto.CompactTextString(m) }
 func (*EventOutgoingTxId) ProtoMessage()    {}
 func (*EventOutgoingTxId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{40}
+	return fileDescriptor_2f8523f2f6feb451, []int{44}
 }
 func (m *EventOutgoingTxId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2307,7 +2574,10 @@ func (m *EventOutgoingTxId) GetTxId() string {
 
 func init() {
 	proto.RegisterType((*MsgSetOrchestratorAddress)(nil), "gravity.v1.MsgSetOrchestratorAddress")
+	proto.RegisterType((*DelegateKeysSignMsg)(nil), "gravity.v1.DelegateKeysSignMsg")
 	proto.RegisterType((*MsgSetOrchestratorAddressResponse)(nil), "gravity.v1.MsgSetOrchestratorAddressResponse")
+	proto.RegisterType((*MsgRotateDelegateKeys)(nil), "gravity.v1.MsgRotateDelegateKeys")
+	proto.RegisterType((*MsgRotateDelegateKeysResponse)(nil), "gravity.v1.MsgRotateDelegateKeysResponse")
 	proto.RegisterType((*MsgValsetConfirm)(nil), "gravity.v1.MsgValsetConfirm")
 	proto.RegisterType((*MsgValsetConfirmResponse)(nil), "gravity.v1.MsgValsetConfirmResponse")
 	proto.RegisterType((*MsgSendToEth)(nil), "gravity.v1.MsgSendToEth")
@@ -2335,6 +2605,7 @@ func init() {
 	proto.RegisterType((*MsgSubmitBadSignatureEvidence)(nil), "gravity.v1.MsgSubmitBadSignatureEvidence")
 	proto.RegisterType((*MsgSubmitBadSignatureEvidenceResponse)(nil), "gravity.v1.MsgSubmitBadSignatureEvidenceResponse")
 	proto.RegisterType((*EventSetOperatorAddress)(nil), "gravity.v1.EventSetOperatorAddress")
+	proto.RegisterType((*EventRotateDelegateKeys)(nil), "gravity.v1.EventRotateDelegateKeys")
 	proto.RegisterType((*EventValsetConfirmKey)(nil), "gravity.v1.EventValsetConfirmKey")
 	proto.RegisterType((*EventBatchCreated)(nil), "gravity.v1.EventBatchCreated")
 	proto.RegisterType((*EventBatchConfirmKey)(nil), "gravity.v1.EventBatchConfirmKey")
@@ -2352,134 +2623,141 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/msgs.proto", fileDescriptor_2f8523f2f6feb451) }
 
 var fileDescriptor_2f8523f2f6feb451 = []byte{
-	// 2025 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0xdb, 0xc8,
-	0xf5, 0x0f, 0x6d, 0x39, 0x89, 0x9f, 0xec, 0x28, 0x66, 0x1c, 0x47, 0x62, 0x6c, 0xd9, 0x66, 0xd6,
-	0x76, 0x36, 0xfb, 0xb5, 0x14, 0xfb, 0x7b, 0x28, 0x8a, 0x05, 0xba, 0xb0, 0x14, 0xa7, 0x2b, 0xb4,
-	0xce, 0x02, 0x72, 0xba, 0x40, 0x8b, 0x02, 0x04, 0x45, 0x4e, 0x28, 0x36, 0x14, 0xe9, 0x72, 0x46,
-	0x5a, 0xfb, 0xb2, 0x40, 0x7b, 0x2b, 0xb6, 0x87, 0xfe, 0x38, 0x15, 0xd8, 0x02, 0x05, 0xda, 0x6b,
-	0xd1, 0x4b, 0x4f, 0xbd, 0xf4, 0x1a, 0xf4, 0x50, 0x2c, 0xd0, 0x43, 0x8b, 0x16, 0x58, 0x14, 0x49,
-	0xff, 0x90, 0x62, 0x7e, 0x70, 0x34, 0xa4, 0x46, 0xb2, 0x5b, 0xa4, 0x27, 0x71, 0xde, 0xbc, 0x79,
-	0xef, 0x33, 0xef, 0xbd, 0x79, 0x3f, 0x04, 0x77, 0x83, 0xd4, 0x1d, 0x85, 0xe4, 0xa2, 0x39, 0x3a,
-	0x68, 0x0e, 0x70, 0x80, 0x1b, 0x67, 0x69, 0x42, 0x12, 0x13, 0x04, 0xb9, 0x31, 0x3a, 0xb0, 0xea,
-	0x5e, 0x82, 0x07, 0x09, 0x6e, 0xf6, 0x5c, 0x8c, 0x9a, 0xa3, 0x83, 0x1e, 0x22, 0xee, 0x41, 0xd3,
-	0x4b, 0xc2, 0x98, 0xf3, 0x5a, 0xab, 0x41, 0x12, 0x24, 0xec, 0xb3, 0x49, 0xbf, 0x04, 0x75, 0x3d,
-	0x48, 0x92, 0x20, 0x42, 0x4d, 0xf7, 0x2c, 0x6c, 0xba, 0x71, 0x9c, 0x10, 0x97, 0x84, 0x49, 0x2c,
-	0xe4, 0x5b, 0x6b, 0x8a, 0x5a, 0x72, 0x71, 0x86, 0x32, 0x7a, 0x4d, 0x9c, 0x62, 0xab, 0xde, 0xf0,
-	0x45, 0xd3, 0x8d, 0x2f, 0xb2, 0x2d, 0x0e, 0xc3, 0xe1, 0x9a, 0xf8, 0x82, 0x6f, 0xd9, 0x9f, 0x42,
-	0xed, 0x04, 0x07, 0xa7, 0x88, 0x7c, 0x94, 0x7a, 0x7d, 0x84, 0x49, 0xea, 0x92, 0x24, 0x3d, 0xf2,
-	0xfd, 0x14, 0x61, 0x6c, 0xae, 0xc3, 0xe2, 0xc8, 0x8d, 0x42, 0x9f, 0xd2, 0xaa, 0xc6, 0x96, 0xf1,
-	0x70, 0xb1, 0x3b, 0x26, 0x98, 0x36, 0x2c, 0x25, 0xca, 0xa1, 0xea, 0x1c, 0x63, 0xc8, 0xd1, 0xcc,
-	0x4d, 0x28, 0x23, 0xd2, 0x77, 0x5c, 0x2e, 0xb0, 0x3a, 0xcf, 0x58, 0x00, 0x91, 0xbe, 0x50, 0x61,
-	0x3f, 0x80, 0xed, 0xa9, 0xfa, 0xbb, 0x08, 0x9f, 0x25, 0x31, 0x46, 0xf6, 0x67, 0x06, 0xdc, 0x3e,
-	0xc1, 0xc1, 0xc7, 0x6e, 0x84, 0x11, 0x69, 0x27, 0xf1, 0x8b, 0x30, 0x1d, 0x98, 0xab, 0xb0, 0x10,
-	0x27, 0xb1, 0x87, 0x18, 0xb0, 0x52, 0x97, 0x2f, 0xde, 0x0a, 0x28, 0x7a, 0x6f, 0x1c, 0x06, 0xb1,
-	0x4b, 0x86, 0x29, 0xaa, 0x96, 0xf8, 0xbd, 0x25, 0xc1, 0xb6, 0xa0, 0x5a, 0x04, 0x23, 0x91, 0xfe,
-	0xc1, 0x80, 0x25, 0x76, 0x9f, 0xd8, 0x7f, 0x9e, 0x1c, 0x93, 0xbe, 0xb9, 0x06, 0xd7, 0x31, 0x8a,
-	0x7d, 0x94, 0xd9, 0x4f, 0xac, 0xcc, 0x1a, 0xdc, 0xa4, 0x18, 0x7c, 0x84, 0x89, 0xc0, 0x78, 0x03,
-	0x91, 0xfe, 0x13, 0x84, 0x89, 0xf9, 0x15, 0xb8, 0xee, 0x0e, 0x92, 0x61, 0x4c, 0x18, 0xb2, 0xf2,
-	0x61, 0xad, 0x21, 0x3c, 0x46, 0xa3, 0xa8, 0x21, 0xa2, 0xa8, 0xd1, 0x4e, 0xc2, 0xb8, 0x55, 0x7a,
-	0xf5, 0xe5, 0xe6, 0xb5, 0xae, 0x60, 0x37, 0xbf, 0x06, 0xd0, 0x4b, 0x43, 0x3f, 0x40, 0xce, 0x0b,
-	0xc4, 0x71, 0x5f, 0xe1, 0xf0, 0x22, 0x3f, 0xf2, 0x14, 0x21, 0x7b, 0x0d, 0x56, 0x55, 0xec, 0xf2,
-	0x52, 0x1f, 0x40, 0xe5, 0x04, 0x07, 0x5d, 0xf4, 0xfd, 0x21, 0xc2, 0xa4, 0xe5, 0x12, 0x6f, 0xfa,
-	0xb5, 0x56, 0x61, 0xc1, 0x47, 0x71, 0x32, 0x10, 0x77, 0xe2, 0x0b, 0xbb, 0x06, 0xf7, 0x0a, 0x02,
-	0xa4, 0xec, 0xdf, 0x19, 0x4c, 0xb8, 0xb0, 0x23, 0x17, 0xae, 0xf7, 0xec, 0x0e, 0xdc, 0x22, 0xc9,
-	0x4b, 0x14, 0x3b, 0x5e, 0x12, 0x93, 0xd4, 0xf5, 0x32, 0xbb, 0x2d, 0x33, 0x6a, 0x5b, 0x10, 0xcd,
-	0x0d, 0xa0, 0x9e, 0x74, 0xa8, 0xbb, 0x50, 0x2a, 0x7c, 0xbb, 0x88, 0x48, 0xff, 0x94, 0x11, 0x26,
-	0xe2, 0xa3, 0xa4, 0x89, 0x8f, 0x9c, 0xfb, 0x17, 0x8a, 0xee, 0xe7, 0x97, 0x51, 0x01, 0xcb, 0xcb,
-	0xfc, 0xd9, 0x80, 0x3b, 0xe3, 0xbd, 0x6f, 0x26, 0x41, 0xe8, 0xb5, 0xdd, 0x28, 0x32, 0xf7, 0xa0,
-	0x12, 0xc6, 0xe2, 0xe1, 0x84, 0x49, 0xec, 0x84, 0xbe, 0x30, 0xdb, 0x2d, 0x95, 0xdc, 0xf1, 0xcd,
-	0x7d, 0x30, 0x73, 0x8c, 0xdc, 0x0c, 0x73, 0xcc, 0x0c, 0x2b, 0xea, 0xce, 0x33, 0x66, 0x92, 0xff,
-	0xf9, 0x5d, 0x37, 0xe0, 0xbe, 0xe6, 0x3e, 0xf2, 0xbe, 0x7f, 0x9c, 0x53, 0x22, 0xa6, 0xcd, 0xe2,
-	0xac, 0x1d, 0xb9, 0xe1, 0x80, 0xbd, 0xb0, 0x11, 0x8a, 0x89, 0xa3, 0xfa, 0x11, 0x18, 0x89, 0x23,
-	0xdf, 0x86, 0xa5, 0x5e, 0x94, 0x78, 0x2f, 0x9d, 0x3e, 0x0a, 0x83, 0x3e, 0x11, 0x57, 0x2c, 0x33,
-	0xda, 0x87, 0x8c, 0xa4, 0xf1, 0xf7, 0xbc, 0xce, 0xdf, 0x4f, 0xe5, 0x6b, 0x61, 0xd7, 0x6b, 0x35,
-	0x68, 0x54, 0xff, 0xfd, 0xcb, 0xcd, 0xdd, 0x20, 0x24, 0xfd, 0x61, 0xaf, 0xe1, 0x25, 0x03, 0x91,
-	0xf1, 0xc4, 0xcf, 0x3e, 0xf6, 0x5f, 0x8a, 0xc4, 0xd9, 0x89, 0x89, 0x7c, 0x3c, 0x7b, 0x50, 0x41,
-	0xa4, 0x8f, 0x52, 0x34, 0x1c, 0x38, 0x22, 0xb4, 0xb9, 0x39, 0x6e, 0x65, 0xe4, 0x53, 0x1e, 0xe2,
-	0x7b, 0x50, 0x11, 0xe9, 0x34, 0x45, 0x1e, 0x0a, 0x47, 0x28, 0xad, 0x5e, 0xe7, 0x8c, 0x9c, 0xdc,
-	0x15, 0xd4, 0x09, 0xf3, 0xdf, 0x98, 0x34, 0xbf, 0x5d, 0x87, 0x75, 0x9d, 0x01, 0xa5, 0x85, 0x3d,
-	0x96, 0x9e, 0x8f, 0xcf, 0x91, 0x37, 0x24, 0xa8, 0xd3, 0xf3, 0x8e, 0x86, 0x24, 0x79, 0x9a, 0xa4,
-	0x9f, 0xb8, 0xa9, 0x8f, 0xcd, 0x47, 0xb0, 0xf2, 0x42, 0x7c, 0x3b, 0x24, 0x71, 0xbc, 0x08, 0xb9,
-	0xa9, 0xb0, 0x75, 0x25, 0xdb, 0x78, 0x9e, 0xb4, 0x29, 0xd9, 0xb4, 0xe0, 0x26, 0x62, 0x52, 0x64,
-	0x4e, 0x94, 0x6b, 0x91, 0x83, 0xf5, 0x4a, 0x24, 0x92, 0x57, 0x06, 0xac, 0x9d, 0xe0, 0x80, 0x05,
-	0xbc, 0x4c, 0x11, 0x6f, 0xcf, 0xdb, 0x9b, 0x50, 0xee, 0x51, 0xd1, 0x42, 0xc6, 0x3c, 0x97, 0xc1,
-	0x48, 0xcf, 0xa6, 0x3c, 0xff, 0x92, 0x2e, 0x1c, 0x8a, 0x46, 0x5f, 0xd0, 0x18, 0x7d, 0x0b, 0xea,
-	0xfa, 0x9b, 0xc8, 0xcb, 0xfe, 0x74, 0x0e, 0xee, 0x52, 0x93, 0x74, 0xdb, 0x87, 0x8f, 0x9f, 0xa0,
-	0xb3, 0x28, 0xb9, 0x40, 0xfe, 0xdb, 0xbb, 0xeb, 0x36, 0x2c, 0x89, 0x08, 0xe2, 0xb9, 0x92, 0xc7,
-	0x75, 0x99, 0xd3, 0x9e, 0x50, 0xd2, 0x55, 0x6f, 0x6b, 0x42, 0x29, 0x76, 0x07, 0xd9, 0xc3, 0x65,
-	0xdf, 0x2c, 0x35, 0x5f, 0x0c, 0x7a, 0x49, 0x24, 0xc2, 0x52, 0xac, 0x68, 0x04, 0xf8, 0xc8, 0x0b,
-	0x07, 0x6e, 0x84, 0x59, 0x28, 0x96, 0xba, 0x72, 0x3d, 0x61, 0xb5, 0x9b, 0x1a, 0xab, 0x6d, 0xc2,
-	0x86, 0xd6, 0x24, 0xd2, 0x68, 0xff, 0x30, 0x58, 0xb0, 0xca, 0x34, 0x21, 0x02, 0xea, 0x2d, 0x1a,
-	0x4e, 0x93, 0x47, 0xa9, 0xed, 0x96, 0xae, 0x98, 0x47, 0x4b, 0xd3, 0xf2, 0xe8, 0x55, 0x82, 0x86,
-	0x3f, 0x12, 0xfd, 0xe5, 0xa4, 0x09, 0xfe, 0xca, 0xe3, 0x86, 0xf7, 0x06, 0xdf, 0x3a, 0xf3, 0xdd,
-	0xff, 0xe8, 0xfa, 0x23, 0x76, 0x2c, 0x97, 0xf4, 0xcb, 0x9c, 0xa6, 0xb7, 0xd0, 0xfc, 0xa4, 0x85,
-	0xde, 0x87, 0x1b, 0x03, 0x34, 0xe8, 0xa1, 0x14, 0x57, 0x4b, 0x5b, 0xf3, 0x0f, 0xcb, 0x87, 0xf7,
-	0x1b, 0xe3, 0x76, 0xb4, 0xd1, 0x62, 0xa5, 0xfe, 0xe3, 0xac, 0x83, 0x13, 0x1d, 0x40, 0x76, 0xc2,
-	0x3c, 0x85, 0xe5, 0x14, 0xd1, 0x57, 0xef, 0x88, 0x8c, 0xba, 0xf0, 0x5f, 0x65, 0xd4, 0x25, 0x2e,
-	0xe4, 0x88, 0xe7, 0xd5, 0x6d, 0x10, 0x6b, 0x87, 0x85, 0xae, 0x08, 0xca, 0x32, 0xa7, 0x3d, 0xa7,
-	0xa4, 0x2b, 0x25, 0x4a, 0x1e, 0x7d, 0x93, 0x86, 0x95, 0xa6, 0x3f, 0x05, 0x93, 0x96, 0x2a, 0x37,
-	0xf6, 0x50, 0x34, 0x6e, 0xbf, 0xe8, 0x3b, 0x4a, 0xdd, 0x18, 0xbb, 0x9e, 0x5a, 0x78, 0x4b, 0xdd,
-	0x65, 0x85, 0xda, 0xf1, 0x95, 0x76, 0x66, 0x4e, 0x6d, 0x67, 0xec, 0x75, 0xb0, 0x26, 0x85, 0x4a,
-	0x95, 0xbf, 0x30, 0x18, 0xa8, 0xd3, 0x61, 0x6f, 0x10, 0x92, 0x96, 0xeb, 0x9f, 0x66, 0x75, 0xf3,
-	0x78, 0x14, 0xfa, 0x88, 0x7a, 0xac, 0x05, 0x37, 0xf0, 0xb0, 0xf7, 0x3d, 0xe4, 0x11, 0xa6, 0xb7,
-	0x7c, 0xb8, 0xda, 0xe0, 0x5d, 0x7a, 0x23, 0xeb, 0xd2, 0x1b, 0x47, 0xf1, 0x45, 0xcb, 0xfc, 0xd3,
-	0xef, 0xf7, 0x6f, 0x1d, 0x67, 0x65, 0x86, 0x16, 0x6f, 0xbf, 0x9b, 0x1d, 0xcc, 0x57, 0xe8, 0xb9,
-	0x42, 0x85, 0x56, 0x90, 0xcf, 0xe7, 0x90, 0xef, 0xc1, 0xce, 0x4c, 0x68, 0xf2, 0x12, 0x27, 0x70,
-	0xef, 0x98, 0x46, 0x21, 0x6d, 0xc1, 0xcf, 0x50, 0xae, 0xfd, 0xaf, 0xd2, 0x60, 0xc2, 0xd8, 0x0d,
-	0x90, 0x68, 0x57, 0xb2, 0x25, 0xdd, 0xc9, 0xba, 0x67, 0xd1, 0xbc, 0x8a, 0xa5, 0xdd, 0x86, 0xbb,
-	0x4c, 0x5c, 0xae, 0x3d, 0xfe, 0x06, 0xba, 0x98, 0x21, 0xec, 0x36, 0xcc, 0xbf, 0x44, 0x17, 0x42,
-	0x10, 0xfd, 0xb4, 0x9f, 0xc1, 0x0a, 0x13, 0xc2, 0x52, 0x74, 0x3b, 0x45, 0xd4, 0xdb, 0x33, 0x04,
-	0x14, 0x6a, 0x07, 0x17, 0xa4, 0xd4, 0x0e, 0xfb, 0xbb, 0xb0, 0xaa, 0xc8, 0xbb, 0x0a, 0xa6, 0x47,
-	0xb0, 0xc2, 0x45, 0x7a, 0x9c, 0xdb, 0x19, 0x23, 0xac, 0xf4, 0xf2, 0x52, 0xec, 0xc7, 0x50, 0x1d,
-	0x4b, 0x2f, 0x94, 0xc6, 0x5c, 0x2b, 0xbb, 0x28, 0x5a, 0x59, 0x3b, 0x02, 0x60, 0x27, 0x38, 0xcf,
-	0x74, 0x14, 0x1b, 0x00, 0x1e, 0x65, 0x71, 0xfa, 0x2e, 0xee, 0x67, 0xbe, 0x67, 0x94, 0x0f, 0x5d,
-	0xcc, 0x82, 0xdb, 0x25, 0x04, 0x61, 0x92, 0xcb, 0x86, 0x8b, 0xdd, 0x65, 0x85, 0xda, 0xf1, 0xed,
-	0xcf, 0x0d, 0xa8, 0x09, 0x80, 0x9a, 0x10, 0xbd, 0xc4, 0x06, 0xbe, 0x93, 0x75, 0x98, 0x6a, 0x00,
-	0x56, 0x7a, 0xae, 0x7f, 0xcc, 0xfb, 0x4c, 0x1e, 0x86, 0x5f, 0x85, 0xda, 0x04, 0xaf, 0x93, 0x85,
-	0x3e, 0x47, 0xb5, 0x56, 0x38, 0x73, 0xca, 0x77, 0xed, 0x63, 0x11, 0x80, 0x9a, 0x62, 0xbb, 0x0a,
-	0x0b, 0x3c, 0x69, 0x08, 0xeb, 0xb1, 0xc5, 0xd8, 0xa6, 0x73, 0xaa, 0x4d, 0x9b, 0x42, 0x8c, 0x26,
-	0xf7, 0xea, 0x9d, 0xf0, 0x1b, 0x03, 0x2c, 0x76, 0xe2, 0x64, 0x18, 0x91, 0x10, 0x87, 0x01, 0x3f,
-	0x23, 0xa6, 0x14, 0x5a, 0x6b, 0xc4, 0x30, 0x25, 0x4b, 0xb0, 0xe8, 0xd9, 0x39, 0x59, 0xd6, 0xe0,
-	0xdd, 0x31, 0x63, 0xdf, 0x0d, 0x99, 0x1b, 0xc4, 0x60, 0x22, 0x18, 0x29, 0xb5, 0xe3, 0xd3, 0x28,
-	0x1d, 0x08, 0x4d, 0x63, 0x57, 0x41, 0x46, 0xea, 0xf8, 0x63, 0x98, 0x25, 0x15, 0xe6, 0xaf, 0x0c,
-	0xa8, 0x33, 0x98, 0x1f, 0x0d, 0x49, 0x90, 0x84, 0xf1, 0xb8, 0x04, 0xf1, 0xb4, 0x84, 0x7c, 0xf3,
-	0x7d, 0xb0, 0x22, 0x4a, 0x74, 0x3c, 0x37, 0x8a, 0x1c, 0xfd, 0xa4, 0x71, 0x2f, 0xca, 0x8e, 0x75,
-	0xf2, 0xa5, 0xf2, 0x08, 0x36, 0xa6, 0x1d, 0x56, 0xad, 0x6c, 0x69, 0xcf, 0xf3, 0xe7, 0xf5, 0x14,
-	0xd6, 0x78, 0x0a, 0x91, 0xae, 0x8d, 0x5c, 0xdc, 0x0f, 0xe3, 0x80, 0xf6, 0x27, 0xb4, 0x2e, 0x08,
-	0x0c, 0xec, 0x7b, 0x46, 0xee, 0x68, 0x89, 0x67, 0x9f, 0xdd, 0xf4, 0xf9, 0x79, 0x67, 0xd6, 0xb3,
-	0xbf, 0x03, 0x0b, 0xe4, 0x7c, 0x6c, 0xee, 0x12, 0x39, 0xef, 0xf8, 0x87, 0xbf, 0xae, 0xc0, 0xfc,
-	0x09, 0x0e, 0xcc, 0x4f, 0x60, 0x39, 0xff, 0x77, 0xc1, 0xba, 0x5a, 0x08, 0x8b, 0xf3, 0xbb, 0xf5,
-	0xce, 0xac, 0x5d, 0x99, 0x2b, 0xed, 0x1f, 0xfe, 0xe5, 0x5f, 0x3f, 0x9f, 0x5b, 0xb7, 0xad, 0xa6,
-	0xf2, 0x1f, 0x8c, 0xa8, 0xda, 0x22, 0x51, 0x98, 0x7d, 0x58, 0x1c, 0x97, 0x9f, 0x6a, 0x41, 0xac,
-	0xdc, 0xb1, 0xb6, 0xa6, 0xed, 0x48, 0x65, 0x9b, 0x4c, 0x59, 0xcd, 0xbe, 0xa7, 0x2a, 0xa3, 0xd9,
-	0x9d, 0xf6, 0xfa, 0x88, 0xf4, 0x4d, 0x0c, 0x4b, 0xb9, 0x99, 0xfc, 0x7e, 0x41, 0xa4, 0xba, 0x69,
-	0x3d, 0x98, 0xb1, 0x29, 0x55, 0x6e, 0x33, 0x95, 0xf7, 0xed, 0x9a, 0xaa, 0x32, 0xe5, 0x9c, 0x0e,
-	0x4b, 0x7b, 0x54, 0x69, 0x6e, 0x56, 0x2f, 0x2a, 0x55, 0x37, 0x27, 0x94, 0x6a, 0x87, 0x66, 0xad,
-	0xd2, 0x2c, 0xed, 0x72, 0xa5, 0x9f, 0xc2, 0xed, 0x89, 0x99, 0x7a, 0x53, 0x2f, 0x5b, 0x32, 0x58,
-	0x7b, 0x97, 0x30, 0x48, 0x00, 0x5b, 0x0c, 0x80, 0x65, 0x57, 0x27, 0x00, 0x0c, 0x1c, 0x16, 0xf5,
-	0xe6, 0x8f, 0x0c, 0x58, 0x99, 0x1c, 0x72, 0xf5, 0x2e, 0x54, 0x38, 0xac, 0x87, 0x97, 0x71, 0x48,
-	0x0c, 0x0f, 0x19, 0x06, 0xdb, 0xde, 0xd2, 0x39, 0x5b, 0x0c, 0x0b, 0x2c, 0xf1, 0x9b, 0xbf, 0x34,
-	0x60, 0x6d, 0xca, 0x3c, 0xb8, 0x53, 0x50, 0xa7, 0x67, 0xb3, 0xf6, 0xaf, 0xc4, 0x26, 0xa1, 0xed,
-	0x33, 0x68, 0x7b, 0xf6, 0x8e, 0x0a, 0x8d, 0xcf, 0x8e, 0xc8, 0x09, 0x7b, 0x9e, 0xe3, 0x0e, 0x49,
-	0xe2, 0x64, 0xf3, 0xa6, 0xf9, 0x33, 0x03, 0xee, 0xe8, 0x2a, 0xa1, 0x5d, 0xd0, 0xaa, 0xe1, 0xb1,
-	0x1e, 0x5d, 0xce, 0x23, 0x61, 0xbd, 0xc7, 0x60, 0xed, 0xd8, 0x0f, 0x54, 0x58, 0xbc, 0x66, 0x2b,
-	0x8f, 0x44, 0x18, 0xed, 0x33, 0x03, 0x56, 0xd4, 0xc2, 0xc0, 0x21, 0x6d, 0x6b, 0x1f, 0xbd, 0x5a,
-	0x3a, 0xac, 0x77, 0x2f, 0x65, 0x99, 0xed, 0x42, 0x91, 0x1c, 0x86, 0xfc, 0x80, 0x40, 0xf3, 0x63,
-	0x03, 0x4c, 0x4d, 0xb5, 0x2b, 0xc2, 0x99, 0x64, 0x99, 0x80, 0x33, 0x63, 0x1a, 0xd3, 0xc2, 0x41,
-	0xa9, 0x77, 0xf8, 0xd8, 0xf1, 0xc5, 0x01, 0x25, 0xa2, 0xa6, 0x0c, 0x6d, 0xc5, 0x88, 0xd2, 0xb3,
-	0x4d, 0x44, 0xd4, 0x25, 0x53, 0x92, 0x36, 0xa2, 0x94, 0x22, 0x24, 0x82, 0x2b, 0xc3, 0xf7, 0xb9,
-	0x01, 0x6b, 0x53, 0xfe, 0xa0, 0xde, 0x99, 0x78, 0x60, 0x3a, 0xb6, 0x09, 0x7c, 0x97, 0xfc, 0xdd,
-	0xfc, 0x7f, 0x0c, 0xdf, 0xae, 0xfd, 0x4e, 0xfe, 0x31, 0x12, 0x47, 0x9d, 0x48, 0xb2, 0xbf, 0x8f,
-	0xcd, 0x1f, 0x18, 0x50, 0x29, 0x8e, 0x1d, 0xf5, 0x62, 0xee, 0xc9, 0xef, 0x5b, 0xbb, 0xb3, 0xf7,
-	0x25, 0x92, 0x5d, 0x86, 0x64, 0xcb, 0xae, 0xe7, 0x52, 0x13, 0x63, 0x56, 0xa3, 0xdc, 0xfc, 0xad,
-	0x01, 0xd6, 0x8c, 0x31, 0xa4, 0x18, 0x36, 0xd3, 0x59, 0xad, 0x83, 0x2b, 0xb3, 0x4a, 0x90, 0x07,
-	0x0c, 0xe4, 0x7b, 0xf6, 0xbb, 0x39, 0x73, 0xb1, 0x73, 0x0e, 0x6d, 0x0a, 0xc7, 0x0d, 0x21, 0x12,
-	0x47, 0x5b, 0xdf, 0x7e, 0xf5, 0xba, 0x6e, 0x7c, 0xf1, 0xba, 0x6e, 0xfc, 0xf3, 0x75, 0xdd, 0xf8,
-	0xc9, 0x9b, 0xfa, 0xb5, 0x2f, 0xde, 0xd4, 0xaf, 0xfd, 0xed, 0x4d, 0xfd, 0xda, 0x77, 0x3e, 0x50,
-	0x86, 0xcc, 0xaf, 0x73, 0x71, 0xfb, 0x7c, 0x6c, 0x2d, 0x2e, 0x07, 0x89, 0x3f, 0x8c, 0x50, 0xf3,
-	0x5c, 0x6a, 0x65, 0x13, 0x68, 0xef, 0x3a, 0x9b, 0xac, 0xfe, 0xff, 0xdf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xc8, 0xc6, 0x70, 0xc5, 0x9e, 0x19, 0x00, 0x00,
+	// 2129 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xdf, 0x6f, 0x1b, 0x59,
+	0xf5, 0xef, 0xc4, 0x4e, 0xdb, 0x1c, 0x27, 0x4d, 0x33, 0x4d, 0x13, 0x7b, 0x9a, 0x38, 0xc9, 0x64,
+	0x93, 0xb4, 0xdd, 0x6f, 0xec, 0x26, 0xdf, 0x07, 0x84, 0x56, 0x62, 0x15, 0xbb, 0x29, 0x6b, 0x2d,
+	0xe9, 0x4a, 0x4e, 0x59, 0x09, 0x84, 0x34, 0x1a, 0xcf, 0xdc, 0x8e, 0x87, 0x8e, 0x67, 0xc2, 0xdc,
+	0x6b, 0x6f, 0xfc, 0x82, 0x04, 0x6f, 0x68, 0xf7, 0x81, 0x1f, 0x12, 0x12, 0xd2, 0x22, 0x90, 0xe0,
+	0x15, 0x21, 0x21, 0x9e, 0x78, 0xe1, 0xb5, 0xe2, 0x01, 0xad, 0xc4, 0x03, 0x08, 0xa4, 0x15, 0x6a,
+	0xf9, 0x43, 0xd0, 0xfd, 0x31, 0xd7, 0x77, 0xc6, 0x63, 0xc7, 0x40, 0x91, 0x78, 0x8a, 0xef, 0xb9,
+	0xe7, 0x9e, 0xfb, 0xb9, 0xe7, 0xf7, 0x99, 0xc0, 0x5d, 0x2f, 0xb6, 0x07, 0x3e, 0x19, 0xd6, 0x07,
+	0x47, 0xf5, 0x1e, 0xf6, 0x70, 0xed, 0x22, 0x8e, 0x48, 0xa4, 0x83, 0x20, 0xd7, 0x06, 0x47, 0x46,
+	0xd5, 0x89, 0x70, 0x2f, 0xc2, 0xf5, 0x8e, 0x8d, 0x51, 0x7d, 0x70, 0xd4, 0x41, 0xc4, 0x3e, 0xaa,
+	0x3b, 0x91, 0x1f, 0x72, 0x5e, 0x63, 0xd5, 0x8b, 0xbc, 0x88, 0xfd, 0xac, 0xd3, 0x5f, 0x82, 0xba,
+	0xe1, 0x45, 0x91, 0x17, 0xa0, 0xba, 0x7d, 0xe1, 0xd7, 0xed, 0x30, 0x8c, 0x88, 0x4d, 0xfc, 0x28,
+	0x14, 0xf2, 0x8d, 0x35, 0xe5, 0x5a, 0x32, 0xbc, 0x40, 0x09, 0xbd, 0x22, 0x4e, 0xb1, 0x55, 0xa7,
+	0xff, 0xbc, 0x6e, 0x87, 0xc3, 0x64, 0x8b, 0xc3, 0xb0, 0xf8, 0x4d, 0x7c, 0xc1, 0xb7, 0xcc, 0x5f,
+	0x68, 0x50, 0x39, 0xc3, 0xde, 0x39, 0x22, 0x1f, 0xc4, 0x4e, 0x17, 0x61, 0x12, 0xdb, 0x24, 0x8a,
+	0x4f, 0x5c, 0x37, 0x46, 0x18, 0xeb, 0x1b, 0xb0, 0x30, 0xb0, 0x03, 0xdf, 0xa5, 0xb4, 0xb2, 0xb6,
+	0xad, 0xdd, 0x5f, 0x68, 0x8f, 0x08, 0xba, 0x09, 0x8b, 0x91, 0x72, 0xa8, 0x3c, 0xc7, 0x18, 0x52,
+	0x34, 0x7d, 0x0b, 0x4a, 0x88, 0x74, 0x2d, 0x9b, 0x0b, 0x2c, 0x17, 0x18, 0x0b, 0x20, 0xd2, 0x4d,
+	0xae, 0xd8, 0x85, 0x25, 0xca, 0x80, 0x7d, 0x2f, 0xb4, 0x49, 0x3f, 0x46, 0xe5, 0x22, 0x97, 0x82,
+	0x48, 0xf7, 0x3c, 0xa1, 0x99, 0x9f, 0x68, 0x70, 0xe7, 0x31, 0x0a, 0x90, 0x67, 0x13, 0xf4, 0x3e,
+	0x1a, 0x62, 0xba, 0x73, 0x86, 0x3d, 0x7d, 0x13, 0x12, 0x6d, 0x5b, 0xbe, 0x9b, 0x00, 0x14, 0x94,
+	0x96, 0x9b, 0x86, 0x3f, 0x77, 0x15, 0xfc, 0x42, 0x0e, 0xfc, 0x55, 0x98, 0x0f, 0xa3, 0xd0, 0xe1,
+	0xa8, 0x8a, 0x6d, 0xbe, 0x30, 0x77, 0x61, 0x67, 0xa2, 0xce, 0xda, 0x08, 0x5f, 0x44, 0x21, 0x46,
+	0xe6, 0xcf, 0x34, 0xb8, 0x7b, 0x86, 0xbd, 0x36, 0x35, 0x1f, 0x52, 0xc1, 0xff, 0xcf, 0x68, 0x75,
+	0x0b, 0x36, 0x73, 0x01, 0xca, 0x27, 0x7c, 0xac, 0xc1, 0xed, 0x33, 0xec, 0x7d, 0x68, 0x07, 0x18,
+	0x91, 0x66, 0x14, 0x3e, 0xf7, 0xe3, 0xde, 0x48, 0x25, 0x9a, 0xa2, 0x92, 0x37, 0x83, 0x7a, 0x03,
+	0x16, 0xb2, 0x88, 0x47, 0x04, 0xd3, 0x80, 0x72, 0x16, 0x8c, 0x44, 0xfa, 0x3b, 0x0d, 0x16, 0x99,
+	0x49, 0x42, 0xf7, 0x59, 0x74, 0x4a, 0xba, 0xfa, 0x1a, 0x5c, 0xc7, 0x28, 0x74, 0x51, 0xa2, 0x60,
+	0xb1, 0xd2, 0x2b, 0x70, 0x93, 0x62, 0x70, 0x11, 0x26, 0x02, 0xe3, 0x0d, 0x44, 0xba, 0x8f, 0x11,
+	0x26, 0xfa, 0x17, 0xe0, 0xba, 0xdd, 0x8b, 0xfa, 0x21, 0x61, 0xc8, 0x4a, 0xc7, 0x95, 0x9a, 0x88,
+	0x14, 0x1a, 0xbd, 0x35, 0x11, 0xbd, 0xb5, 0x66, 0xe4, 0x87, 0x8d, 0xe2, 0xcb, 0xcf, 0xb7, 0xae,
+	0xb5, 0x05, 0xbb, 0xfe, 0x25, 0x80, 0x4e, 0xec, 0xbb, 0x1e, 0xb2, 0x9e, 0x23, 0x8e, 0x7b, 0x86,
+	0xc3, 0x0b, 0xfc, 0xc8, 0x13, 0x84, 0xcc, 0x35, 0x58, 0x55, 0xb1, 0xcb, 0x47, 0xbd, 0x0b, 0xcb,
+	0xd4, 0x3e, 0xe8, 0x5b, 0x7d, 0x84, 0x49, 0xc3, 0x26, 0xce, 0xe4, 0x67, 0xad, 0xc2, 0xbc, 0x8b,
+	0xc2, 0xa8, 0x27, 0xde, 0xc4, 0x17, 0x66, 0x05, 0xd6, 0x33, 0x02, 0xa4, 0xec, 0x5f, 0x6b, 0x4c,
+	0xb8, 0xd0, 0x23, 0x17, 0x9e, 0x6f, 0xd9, 0x3d, 0xb8, 0x45, 0xa2, 0x17, 0x28, 0xb4, 0x9c, 0x28,
+	0x24, 0xb1, 0xed, 0x24, 0x7a, 0x5b, 0x62, 0xd4, 0xa6, 0x20, 0xd2, 0x50, 0x4c, 0x3c, 0x0e, 0x25,
+	0xb1, 0xb4, 0x20, 0xdc, 0x0d, 0x8d, 0x7b, 0x75, 0x31, 0xc7, 0x3f, 0x52, 0xe6, 0x9f, 0xcf, 0x9a,
+	0x9f, 0x3f, 0x46, 0x05, 0x2c, 0x1f, 0xf3, 0x47, 0x0d, 0xee, 0x8c, 0xf6, 0xbe, 0x12, 0x79, 0xbe,
+	0xd3, 0xb4, 0x83, 0x40, 0x3f, 0x80, 0x65, 0x3f, 0x14, 0x91, 0xe5, 0x47, 0xe1, 0x28, 0x47, 0xdc,
+	0x52, 0xc9, 0x2d, 0x57, 0x3f, 0x04, 0x3d, 0xc5, 0xc8, 0xd5, 0x30, 0xc7, 0xd4, 0xb0, 0xa2, 0xee,
+	0x3c, 0x65, 0x2a, 0xf9, 0xaf, 0xbf, 0x75, 0x13, 0xee, 0xe5, 0xbc, 0x47, 0xbe, 0xf7, 0xf7, 0x73,
+	0x8a, 0xc7, 0x34, 0x99, 0x9f, 0x35, 0x03, 0xdb, 0xef, 0xb1, 0x08, 0x1b, 0xa0, 0x90, 0x58, 0xaa,
+	0x1d, 0x81, 0x91, 0x38, 0xf2, 0x1d, 0x58, 0xec, 0x04, 0x91, 0xf3, 0xc2, 0xea, 0x22, 0xdf, 0xeb,
+	0x12, 0xf1, 0xc4, 0x12, 0xa3, 0xbd, 0xc7, 0x48, 0x39, 0xf6, 0x2e, 0xe4, 0xd9, 0xfb, 0x89, 0x8c,
+	0x16, 0xf6, 0xbc, 0x46, 0x8d, 0x7a, 0xf5, 0x5f, 0x3f, 0xdf, 0xda, 0xf7, 0x7c, 0xd2, 0xed, 0x77,
+	0x6a, 0x4e, 0xd4, 0x13, 0x95, 0x46, 0xfc, 0x39, 0xc4, 0xee, 0x0b, 0x51, 0xb0, 0x5a, 0x21, 0x91,
+	0xc1, 0x73, 0x00, 0xcb, 0x88, 0x74, 0x51, 0x8c, 0xfa, 0x3d, 0x4b, 0xb8, 0x36, 0x57, 0xc7, 0xad,
+	0x84, 0x7c, 0xce, 0x5d, 0xfc, 0x00, 0x96, 0x45, 0x19, 0x8b, 0x91, 0x83, 0xfc, 0x01, 0x8a, 0xcb,
+	0xd7, 0x39, 0x23, 0x27, 0xb7, 0x05, 0x75, 0x4c, 0xfd, 0x37, 0xc6, 0xd5, 0x6f, 0x56, 0x61, 0x23,
+	0x4f, 0x81, 0x52, 0xc3, 0x0e, 0xab, 0x8a, 0xa7, 0x97, 0xc8, 0xe9, 0x13, 0xd4, 0xea, 0x38, 0x27,
+	0x7d, 0x12, 0x3d, 0x89, 0xe2, 0x8f, 0xec, 0xd8, 0xc5, 0xfa, 0x43, 0x58, 0x79, 0x2e, 0x7e, 0x5b,
+	0x24, 0xb2, 0x9c, 0x00, 0xd9, 0xb1, 0xd0, 0xf5, 0x72, 0xb2, 0xf1, 0x2c, 0x6a, 0x52, 0xb2, 0x6e,
+	0xc0, 0x4d, 0xc4, 0xa4, 0xc8, 0x9c, 0x28, 0xd7, 0xa2, 0x8c, 0xe4, 0x5f, 0x22, 0x91, 0xbc, 0xd4,
+	0x60, 0xed, 0x0c, 0x7b, 0xcc, 0xe1, 0x65, 0x8a, 0x78, 0x73, 0xd6, 0xde, 0x82, 0x52, 0x87, 0x8a,
+	0x16, 0x32, 0x0a, 0x5c, 0x06, 0x23, 0x3d, 0x9d, 0x10, 0xfe, 0xc5, 0x3c, 0x77, 0xc8, 0x2a, 0x7d,
+	0x3e, 0x47, 0xe9, 0xdb, 0x50, 0xcd, 0x7f, 0x89, 0x7c, 0xec, 0x0f, 0xe6, 0x58, 0xcd, 0x3c, 0x6d,
+	0x37, 0x8f, 0x1f, 0x3d, 0x46, 0x17, 0x41, 0x34, 0x44, 0xee, 0x9b, 0x7b, 0xeb, 0x0e, 0x2c, 0x0a,
+	0x0f, 0xe2, 0xb9, 0x92, 0xfb, 0x75, 0x89, 0xd3, 0x1e, 0x53, 0xd2, 0xac, 0xaf, 0xd5, 0xa1, 0x18,
+	0xda, 0xbd, 0x24, 0x70, 0xd9, 0x6f, 0x96, 0x9a, 0x87, 0xbd, 0x4e, 0x14, 0x08, 0xb7, 0x14, 0x2b,
+	0xea, 0x01, 0x2e, 0x72, 0xfc, 0x9e, 0x1d, 0x60, 0xe6, 0x8a, 0xc5, 0xb6, 0x5c, 0x8f, 0x69, 0xed,
+	0x66, 0x8e, 0xd6, 0x78, 0x95, 0x1e, 0x57, 0x89, 0x54, 0xda, 0xdf, 0x78, 0x0b, 0x27, 0xd3, 0x84,
+	0x70, 0xa8, 0x37, 0xa8, 0xb8, 0x9c, 0x3c, 0x4a, 0x75, 0xb7, 0x38, 0x63, 0x1e, 0x2d, 0x4e, 0xca,
+	0xa3, 0xb3, 0x38, 0x0d, 0x0f, 0x92, 0xfc, 0xc7, 0x49, 0x15, 0xfc, 0x99, 0xfb, 0x0d, 0xef, 0x0d,
+	0xbe, 0x7a, 0xe1, 0xda, 0xff, 0xd2, 0xf3, 0x07, 0xec, 0x58, 0x2a, 0xe9, 0x97, 0x38, 0x2d, 0x5f,
+	0x43, 0x85, 0x71, 0x0d, 0xbd, 0x03, 0x37, 0x7a, 0xa8, 0xd7, 0x41, 0x31, 0x2e, 0x17, 0xb7, 0x0b,
+	0xf7, 0x4b, 0xc7, 0xf7, 0x6a, 0xa3, 0x31, 0xa0, 0xd6, 0x60, 0xa5, 0xfe, 0xc3, 0xa4, 0xc5, 0x13,
+	0x1d, 0x40, 0x72, 0x42, 0x3f, 0x87, 0xa5, 0x18, 0xd1, 0xa8, 0xb7, 0x44, 0x46, 0x9d, 0xff, 0xb7,
+	0x32, 0xea, 0x22, 0x17, 0x72, 0xc2, 0xf3, 0xea, 0x0e, 0x88, 0xb5, 0xc5, 0x5c, 0x57, 0x38, 0x65,
+	0x89, 0xd3, 0x9e, 0x51, 0xd2, 0x4c, 0x89, 0x92, 0x7b, 0xdf, 0xb8, 0x62, 0xa5, 0xea, 0xcf, 0x41,
+	0xa7, 0xa5, 0xca, 0x0e, 0x1d, 0x14, 0x8c, 0xda, 0x2f, 0x1a, 0x47, 0xb1, 0x1d, 0x62, 0xdb, 0x51,
+	0x0b, 0x6f, 0xb1, 0xbd, 0xa4, 0x50, 0x5b, 0xae, 0xd2, 0xce, 0xcc, 0xa9, 0xed, 0x8c, 0xb9, 0x01,
+	0xc6, 0xb8, 0x50, 0x79, 0xe5, 0x4f, 0x34, 0x06, 0xea, 0xbc, 0xdf, 0xe9, 0xf9, 0xa4, 0x61, 0xbb,
+	0xb2, 0xa3, 0x3d, 0x1d, 0xf8, 0x2e, 0xa2, 0x16, 0x6b, 0xc0, 0x0d, 0xdc, 0xef, 0x7c, 0x13, 0x39,
+	0x84, 0xdd, 0x5b, 0x3a, 0x5e, 0xad, 0xf1, 0xe9, 0xa8, 0x96, 0x4c, 0x47, 0xb5, 0x93, 0x70, 0xd8,
+	0xd0, 0xff, 0xf0, 0xdb, 0xc3, 0x5b, 0xa7, 0x49, 0x99, 0xa1, 0xc5, 0xdb, 0x6d, 0x27, 0x07, 0xd3,
+	0x15, 0x7a, 0x2e, 0x53, 0xa1, 0x15, 0xe4, 0x85, 0x14, 0xf2, 0x03, 0xd8, 0x9b, 0x0a, 0x4d, 0x3e,
+	0xe2, 0x0c, 0xd6, 0x4f, 0xa9, 0x17, 0xd2, 0x29, 0xe2, 0x02, 0xa5, 0xa6, 0xae, 0x32, 0x75, 0x26,
+	0x8c, 0x6d, 0x0f, 0x89, 0x76, 0x25, 0x59, 0xd2, 0x9d, 0xa4, 0x7b, 0x16, 0xcd, 0xab, 0x58, 0x9a,
+	0x3f, 0xd6, 0x84, 0xbc, 0x9c, 0x79, 0x63, 0xb2, 0xbc, 0xff, 0x7c, 0x40, 0xca, 0xf4, 0xf4, 0xc5,
+	0x6c, 0x4f, 0x6f, 0x36, 0xe1, 0x2e, 0xc3, 0x95, 0xea, 0xdb, 0xdf, 0x47, 0xc3, 0x29, 0xa8, 0x6e,
+	0x43, 0xe1, 0x05, 0x1a, 0x0a, 0x3c, 0xf4, 0xa7, 0xf9, 0x14, 0x56, 0x98, 0x10, 0x56, 0x3b, 0x9a,
+	0x31, 0xa2, 0x6e, 0x38, 0x45, 0x40, 0xa6, 0xa8, 0x71, 0x41, 0x4a, 0x51, 0x33, 0xbf, 0x01, 0xab,
+	0x8a, 0xbc, 0x59, 0x30, 0x3d, 0x84, 0x15, 0x2e, 0xd2, 0xe1, 0xdc, 0xd6, 0x08, 0xe1, 0x72, 0x27,
+	0x2d, 0xc5, 0x7c, 0x04, 0xe5, 0x91, 0xf4, 0x4c, 0xcd, 0x4e, 0xf5, 0xd8, 0x0b, 0xc9, 0x40, 0x19,
+	0x00, 0xb0, 0x13, 0x9c, 0x67, 0x32, 0x8a, 0x4d, 0x00, 0x87, 0xb2, 0x58, 0x5d, 0x1b, 0x77, 0x13,
+	0x83, 0x31, 0xca, 0x7b, 0x36, 0x66, 0x51, 0x67, 0x13, 0x82, 0x30, 0x49, 0xa5, 0xe9, 0x85, 0xf6,
+	0x92, 0x42, 0x6d, 0xb9, 0xe6, 0xa7, 0x1a, 0x54, 0x04, 0xc0, 0x9c, 0xd8, 0xb9, 0x42, 0x07, 0xae,
+	0x95, 0x1e, 0x2c, 0xa5, 0x0e, 0xdc, 0x53, 0x65, 0xb6, 0xd4, 0xbf, 0x08, 0x95, 0x31, 0x5e, 0x2b,
+	0x89, 0x49, 0x8e, 0x6a, 0x2d, 0x73, 0xe6, 0x9c, 0xef, 0x9a, 0xa7, 0xc2, 0x93, 0x73, 0xba, 0x80,
+	0x55, 0x98, 0xe7, 0xd9, 0x4c, 0x68, 0x8f, 0x2d, 0x46, 0x3a, 0x9d, 0x53, 0x75, 0x5a, 0x87, 0x75,
+	0xc5, 0xf1, 0x52, 0x45, 0x21, 0xdf, 0x08, 0xbf, 0xd4, 0xc0, 0x60, 0x27, 0xce, 0xfa, 0x01, 0xf1,
+	0xb1, 0xef, 0xf1, 0x33, 0x62, 0x7c, 0xa2, 0x45, 0x50, 0x4c, 0x79, 0xb2, 0x37, 0x10, 0xc3, 0x04,
+	0x27, 0xcb, 0xe6, 0x60, 0x7f, 0xc4, 0xd8, 0xb5, 0x7d, 0x66, 0x06, 0x31, 0x31, 0x09, 0x46, 0x4a,
+	0x6d, 0xb9, 0xd4, 0x4b, 0x7b, 0xe2, 0xa6, 0x91, 0xa9, 0x20, 0x21, 0xb5, 0xdc, 0xf4, 0xc7, 0x07,
+	0x09, 0xf3, 0xe7, 0x1a, 0x54, 0x19, 0xcc, 0x0f, 0xfa, 0xc4, 0x8b, 0xfc, 0x70, 0x54, 0x1b, 0x79,
+	0xbe, 0x44, 0xae, 0xfe, 0x0e, 0x18, 0x01, 0x25, 0x5a, 0x8e, 0x1d, 0x04, 0x56, 0xfe, 0x08, 0xb4,
+	0x1e, 0x24, 0xc7, 0x5a, 0xe9, 0x1a, 0x7e, 0x02, 0x9b, 0x93, 0x0e, 0xab, 0x5a, 0x36, 0x72, 0xcf,
+	0xf3, 0xf0, 0x7a, 0x02, 0x6b, 0x3c, 0xb7, 0x49, 0xd3, 0x06, 0x36, 0xee, 0xfa, 0xa1, 0x47, 0x1b,
+	0x27, 0x5a, 0xb0, 0x04, 0x06, 0xf6, 0x7b, 0x4a, 0x52, 0x6b, 0xc0, 0x4a, 0xea, 0xa5, 0xcf, 0x2e,
+	0x5b, 0xd3, 0xc2, 0xfe, 0x0e, 0xcc, 0x93, 0xcb, 0x91, 0xba, 0x8b, 0xe4, 0xb2, 0xe5, 0x1e, 0xff,
+	0xe6, 0x36, 0x14, 0xe8, 0xa7, 0xa2, 0x8f, 0x60, 0x29, 0xfd, 0x1d, 0x63, 0x43, 0xad, 0xd0, 0xd9,
+	0x0f, 0x0b, 0xc6, 0x5b, 0xd3, 0x76, 0x65, 0x12, 0x37, 0xbf, 0xfb, 0xa7, 0x7f, 0xfc, 0x68, 0x6e,
+	0xc3, 0x34, 0xea, 0xca, 0x47, 0x39, 0xd1, 0x4e, 0x88, 0x44, 0xa1, 0x77, 0x61, 0x61, 0x54, 0x17,
+	0xcb, 0x19, 0xb1, 0x72, 0xc7, 0xd8, 0x9e, 0xb4, 0x23, 0x2f, 0xdb, 0x62, 0x97, 0x55, 0xcc, 0x75,
+	0xf5, 0x32, 0x5a, 0x76, 0xe8, 0x10, 0x82, 0x48, 0x57, 0xc7, 0xb0, 0x98, 0xfa, 0x58, 0x70, 0x2f,
+	0x23, 0x52, 0xdd, 0x34, 0x76, 0xa7, 0x6c, 0xca, 0x2b, 0x77, 0xd8, 0x95, 0xf7, 0xcc, 0x8a, 0x7a,
+	0x65, 0xcc, 0x39, 0x2d, 0x96, 0xf6, 0xe8, 0xa5, 0xa9, 0x8f, 0x08, 0xd9, 0x4b, 0xd5, 0x4d, 0x63,
+	0x77, 0xca, 0xe6, 0xf4, 0x4b, 0x93, 0xb4, 0xcb, 0x2f, 0xfd, 0x36, 0xdc, 0x1e, 0x1b, 0xf6, 0xb7,
+	0xf2, 0x65, 0x4b, 0x06, 0xe3, 0xe0, 0x0a, 0x06, 0x09, 0x60, 0x9b, 0x01, 0x30, 0xcc, 0xf2, 0x18,
+	0x80, 0x9e, 0xc5, 0xbc, 0x5e, 0xff, 0x9e, 0x06, 0x2b, 0xe3, 0xd3, 0x77, 0xbe, 0x09, 0x15, 0x0e,
+	0xe3, 0xfe, 0x55, 0x1c, 0x12, 0xc3, 0x7d, 0x86, 0xc1, 0x34, 0xb7, 0xf3, 0x8c, 0x2d, 0xa6, 0x18,
+	0x96, 0xf8, 0xf5, 0x9f, 0x6a, 0xb0, 0x36, 0x61, 0x50, 0xdd, 0xcb, 0x5c, 0x97, 0xcf, 0x66, 0x1c,
+	0xce, 0xc4, 0x26, 0xa1, 0x1d, 0x32, 0x68, 0x07, 0xe6, 0x9e, 0x0a, 0x8d, 0x0f, 0xb5, 0xc8, 0xf2,
+	0x3b, 0x8e, 0x65, 0xf7, 0x49, 0x64, 0x25, 0x83, 0xb0, 0xfe, 0x43, 0x0d, 0xee, 0xe4, 0x55, 0x42,
+	0x33, 0x73, 0x6b, 0x0e, 0x8f, 0xf1, 0xf0, 0x6a, 0x1e, 0x09, 0xeb, 0x6d, 0x06, 0x6b, 0xcf, 0xdc,
+	0x55, 0x61, 0xf1, 0x9a, 0xad, 0x04, 0x89, 0x50, 0xda, 0xc7, 0x1a, 0xac, 0xa8, 0x85, 0x81, 0x43,
+	0xda, 0xc9, 0x0d, 0x7a, 0xb5, 0x74, 0x18, 0x0f, 0xae, 0x64, 0x99, 0x6e, 0x42, 0x91, 0x1c, 0xfa,
+	0xfc, 0x80, 0x40, 0xf3, 0x89, 0x06, 0x7a, 0x4e, 0xb5, 0xcb, 0xc2, 0x19, 0x67, 0x31, 0x1e, 0x5c,
+	0xc9, 0x32, 0x1d, 0x0e, 0x8a, 0x9d, 0xe3, 0x47, 0x96, 0x2b, 0x0e, 0x28, 0x1e, 0x35, 0x61, 0x9a,
+	0xcc, 0x7a, 0x54, 0x3e, 0x9b, 0x71, 0x38, 0x13, 0xdb, 0x74, 0x8f, 0x52, 0x8a, 0x90, 0x70, 0xae,
+	0x04, 0xdf, 0xa7, 0x1a, 0xac, 0x4d, 0xf8, 0x87, 0xc5, 0xde, 0x58, 0x80, 0xe5, 0xb1, 0x19, 0x87,
+	0x33, 0xb1, 0x49, 0x7c, 0xff, 0xc7, 0xf0, 0xed, 0x9b, 0x6f, 0xa5, 0x83, 0x91, 0x58, 0x6a, 0x2b,
+	0x9c, 0xf4, 0xc0, 0xcc, 0x9a, 0x39, 0x5d, 0x78, 0xd6, 0x9a, 0xe3, 0x2c, 0xc6, 0x83, 0x2b, 0x59,
+	0xa6, 0x5b, 0x33, 0x66, 0xfc, 0x96, 0x2b, 0x0e, 0xd0, 0x1e, 0x15, 0xeb, 0xdf, 0xd1, 0x60, 0x39,
+	0x3b, 0x9e, 0x55, 0xb3, 0xa9, 0x30, 0xbd, 0x6f, 0xec, 0x4f, 0xdf, 0x97, 0x28, 0xf6, 0x19, 0x8a,
+	0x6d, 0xb3, 0x9a, 0xca, 0x94, 0x8c, 0x59, 0x0d, 0x3a, 0xfd, 0x57, 0x1a, 0x18, 0x53, 0xc6, 0xb5,
+	0xec, 0xbb, 0x27, 0xb3, 0x1a, 0x47, 0x33, 0xb3, 0x4a, 0x90, 0x47, 0x0c, 0xe4, 0xdb, 0xe6, 0x83,
+	0x94, 0xf5, 0xd8, 0x39, 0x8b, 0xf6, 0xa8, 0xa3, 0xfe, 0x14, 0x89, 0xa3, 0x8d, 0xaf, 0xbd, 0x7c,
+	0x55, 0xd5, 0x3e, 0x7b, 0x55, 0xd5, 0xfe, 0xfe, 0xaa, 0xaa, 0x7d, 0xff, 0x75, 0xf5, 0xda, 0x67,
+	0xaf, 0xab, 0xd7, 0xfe, 0xf2, 0xba, 0x7a, 0xed, 0xeb, 0xef, 0x2a, 0xc3, 0xf8, 0x97, 0xb9, 0xb8,
+	0x43, 0x3e, 0xde, 0x67, 0x97, 0xbd, 0xc8, 0xed, 0x07, 0xa8, 0x7e, 0x29, 0x6f, 0x65, 0x93, 0x7a,
+	0xe7, 0x3a, 0x9b, 0x40, 0xff, 0xff, 0x9f, 0x03, 0x00, 0xa6, 0xcf, 0x34, 0xcf, 0x3e, 0x1c, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2506,6 +2784,7 @@ type MsgClient interface {
 	ERC20DeployedClaim(ctx context.Context, in *MsgERC20DeployedClaim, opts ...grpc.CallOption) (*MsgERC20DeployedClaimResponse, error)
 	LogicCallExecutedClaim(ctx context.Context, in *MsgLogicCallExecutedClaim, opts ...grpc.CallOption) (*MsgLogicCallExecutedClaimResponse, error)
 	SetOrchestratorAddress(ctx context.Context, in *MsgSetOrchestratorAddress, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressResponse, error)
+	RotateDelegateKeys(ctx context.Context, in *MsgRotateDelegateKeys, opts ...grpc.CallOption) (*MsgRotateDelegateKeysResponse, error)
 	CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth, opts ...grpc.CallOption) (*MsgCancelSendToEthResponse, error)
 	SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error)
 }
@@ -2626,6 +2905,15 @@ func (c *msgClient) SetOrchestratorAddress(ctx context.Context, in *MsgSetOrches
 	return out, nil
 }
 
+func (c *msgClient) RotateDelegateKeys(ctx context.Context, in *MsgRotateDelegateKeys, opts ...grpc.CallOption) (*MsgRotateDelegateKeysResponse, error) {
+	out := new(MsgRotateDelegateKeysResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/RotateDelegateKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth, opts ...grpc.CallOption) (*MsgCancelSendToEthResponse, error) {
 	out := new(MsgCancelSendToEthResponse)
 	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/CancelSendToEth", in, out, opts...)
@@ -2658,6 +2946,7 @@ type MsgServer interface {
 	ERC20DeployedClaim(context.Context, *MsgERC20DeployedClaim) (*MsgERC20DeployedClaimResponse, error)
 	LogicCallExecutedClaim(context.Context, *MsgLogicCallExecutedClaim) (*MsgLogicCallExecutedClaimResponse, error)
 	SetOrchestratorAddress(context.Context, *MsgSetOrchestratorAddress) (*MsgSetOrchestratorAddressResponse, error)
+	RotateDelegateKeys(context.Context, *MsgRotateDelegateKeys) (*MsgRotateDelegateKeysResponse, error)
 	CancelSendToEth(context.Context, *MsgCancelSendToEth) (*MsgCancelSendToEthResponse, error)
 	SubmitBadSignatureEvidence(context.Context, *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error)
 }
@@ -2702,6 +2991,9 @@ func (*UnimplementedMsgServer) LogicCallExecutedClaim(ctx context.Context, req *
 func (*UnimplementedMsgServer) SetOrchestratorAddress(ctx context.Context, req *MsgSetOrchestratorAddress) (*MsgSetOrchestratorAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetOrchestratorAddress not implemented")
 }
+func (*UnimplementedMsgServer) RotateDelegateKeys(ctx context.Context, req *MsgRotateDelegateKeys) (*MsgRotateDelegateKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateDelegateKeys not implemented")
+}
 func (*UnimplementedMsgServer) CancelSendToEth(ctx context.Context, req *MsgCancelSendToEth) (*MsgCancelSendToEthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSendToEth not implemented")
 }
@@ -2929,26 +3221,44 @@ func _Msg_SetOrchestratorAddress_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_CancelSendToEth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelSendToEth)
+func _Msg_RotateDelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateDelegateKeys)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).CancelSendToEth(ctx, in)
+		return srv.(MsgServer).RotateDelegateKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gravity.v1.Msg/CancelSendToEth",
+		FullMethod: "/gravity.v1.Msg/RotateDelegateKeys",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelSendToEth(ctx, req.(*MsgCancelSendToEth))
+		return srv.(MsgServer).RotateDelegateKeys(ctx, req.(*MsgRotateDelegateKeys))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_SubmitBadSignatureEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitBadSignatureEvidence)
+func _Msg_CancelSendToEth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSendToEth)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSendToEth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/CancelSendToEth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSendToEth(ctx, req.(*MsgCancelSendToEth))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitBadSignatureEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBadSignatureEvidence)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -3017,6 +3327,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetOrchestratorAddress",
 			Handler:    _Msg_SetOrchestratorAddress_Handler,
 		},
+		{
+			MethodName: "RotateDelegateKeys",
+			Handler:    _Msg_RotateDelegateKeys_Handler,
+		},
 		{
 			MethodName: "CancelSendToEth",
 			Handler:    _Msg_CancelSendToEth_Handler,
@@ -3050,6 +3364,13 @@ func (m *MsgSetOrchestratorAddress) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.EthSignature) > 0 {
+		i -= len(m.EthSignature)
+		copy(dAtA[i:], m.EthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.EthAddress) > 0 {
 		i -= len(m.EthAddress)
 		copy(dAtA[i:], m.EthAddress)
@@ -3074,6 +3395,55 @@ func (m *MsgSetOrchestratorAddress) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegateKeysSignMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysSignMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysSignMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSetOrchestratorAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3097,6 +3467,80 @@ func (m *MsgSetOrchestratorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRotateDelegateKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateDelegateKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateDelegateKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthSignature) > 0 {
+		i -= len(m.EthSignature)
+		copy(dAtA[i:], m.EthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateDelegateKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateDelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateDelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgValsetConfirm) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4131,6 +4575,57 @@ func (m *EventSetOperatorAddress) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventRotateDelegateKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRotateDelegateKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRotateDelegateKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventValsetConfirmKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4618,6 +5113,34 @@ func (m *MsgSetOrchestratorAddress) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EthSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysSignMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovMsgs(uint64(m.Nonce))
+	}
 	return n
 }
 
@@ -4630,6 +5153,40 @@ func (m *MsgSetOrchestratorAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRotateDelegateKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateDelegateKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgValsetConfirm) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5092,7 +5649,7 @@ func (m *EventSetOperatorAddress) Size() (n int) {
 	return n
 }
 
-func (m *EventValsetConfirmKey) Size() (n int) {
+func (m *EventRotateDelegateKeys) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5102,14 +5659,22 @@ func (m *EventValsetConfirmKey) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.Key)
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthAddress)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
 	return n
 }
 
-func (m *EventBatchCreated) Size() (n int) {
+func (m *EventValsetConfirmKey) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5119,14 +5684,14 @@ func (m *EventBatchCreated) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.BatchNonce)
+	l = len(m.Key)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
 	return n
 }
 
-func (m *EventBatchConfirmKey) Size() (n int) {
+func (m *EventBatchCreated) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5136,20 +5701,37 @@ func (m *EventBatchConfirmKey) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.BatchConfirmKey)
+	l = len(m.BatchNonce)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
 	return n
 }
 
-func (m *EventBatchSendToEthClaim) Size() (n int) {
+func (m *EventBatchConfirmKey) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Nonce)
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.BatchConfirmKey)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBatchSendToEthClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nonce)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
@@ -5284,33 +5866,426 @@ func (m *EventSignatureSlashing) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *EventOutgoingTxId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOrchestratorAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOrchestratorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysSignMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysSignMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOrchestratorAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOrchestratorAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOrchestratorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventOutgoingTxId) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.TxId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateDelegateKeys) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5333,10 +6308,10 @@ func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetOrchestratorAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateDelegateKeys: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetOrchestratorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateDelegateKeys: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5435,6 +6410,38 @@ func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
 			}
 			m.EthAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -5456,7 +6463,7 @@ func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetOrchestratorAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateDelegateKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5479,10 +6486,10 @@ func (m *MsgSetOrchestratorAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetOrchestratorAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateDelegateKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetOrchestratorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateDelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8575,6 +9582,184 @@ func (m *EventSetOperatorAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventRotateDelegateKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRotateDelegateKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRotateDelegateKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventValsetConfirmKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,47 +14,60 @@ func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
 		ethAddress                   = "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"
 		cosmosAddress sdk.AccAddress = bytes.Repeat([]byte{0x1}, 20)
 		valAddress    sdk.ValAddress = bytes.Repeat([]byte{0x1}, 20)
+		signature                    = bytes.Repeat([]byte{0x1}, 65)
 	)
 	specs := map[string]struct {
 		srcCosmosAddr sdk.AccAddress
 		srcValAddr    sdk.ValAddress
 		srcETHAddr    string
+		srcSignature  []byte
 		expErr        bool
 	}{
 		"all good": {
 			srcCosmosAddr: cosmosAddress,
 			srcValAddr:    valAddress,
 			srcETHAddr:    ethAddress,
+			srcSignature:  signature,
 		},
 		"empty validator address": {
 			srcETHAddr:    ethAddress,
 			srcCosmosAddr: cosmosAddress,
+			srcSignature:  signature,
 			expErr:        true,
 		},
 		"short validator address": {
 			srcValAddr:    []byte{0x1},
 			srcCosmosAddr: cosmosAddress,
 			srcETHAddr:    ethAddress,
+			srcSignature:  signature,
 			expErr:        false,
 		},
 		"empty cosmos address": {
-			srcValAddr: valAddress,
-			srcETHAddr: ethAddress,
-			expErr:     true,
+			srcValAddr:   valAddress,
+			srcETHAddr:   ethAddress,
+			srcSignature: signature,
+			expErr:       true,
 		},
 		"short cosmos address": {
 			srcCosmosAddr: []byte{0x1},
 			srcValAddr:    valAddress,
 			srcETHAddr:    ethAddress,
+			srcSignature:  signature,
 			expErr:        false,
 		},
+		"empty eth signature": {
+			srcCosmosAddr: cosmosAddress,
+			srcValAddr:    valAddress,
+			srcETHAddr:    ethAddress,
+			expErr:        true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
 			println(fmt.Sprintf("Spec is %v", msg))
 			ethAddr, err := NewEthAddress(spec.srcETHAddr)
 			assert.NoError(t, err)
-			msg := NewMsgSetOrchestratorAddress(spec.srcValAddr, spec.srcCosmosAddr, *ethAddr)
+			msg := NewMsgSetOrchestratorAddress(spec.srcValAddr, spec.srcCosmosAddr, *ethAddr, spec.srcSignature)
 			// when
 			err = msg.ValidateBasic()
 			if spec.expErr {
@@ -258,44 +258,114 @@ func (m *EventWithdrawCanceled) GetBridgeChainId() string {
 	return ""
 }
 
+type EventWithdrawSeized struct {
+	Sender         string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TxId           string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	BridgeContract string `protobuf:"bytes,3,opt,name=bridge_contract,json=bridgeContract,proto3" json:"bridge_contract,omitempty"`
+	BridgeChainId  string `protobuf:"bytes,4,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
+}
+
+func (m *EventWithdrawSeized) Reset()         { *m = EventWithdrawSeized{} }
+func (m *EventWithdrawSeized) String() string { return proto.CompactTextString(m) }
+func (*EventWithdrawSeized) ProtoMessage()    {}
+func (*EventWithdrawSeized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18d107f7cfc31f22, []int{4}
+}
+func (m *EventWithdrawSeized) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventWithdrawSeized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventWithdrawSeized.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventWithdrawSeized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWithdrawSeized.Merge(m, src)
+}
+func (m *EventWithdrawSeized) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventWithdrawSeized) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWithdrawSeized.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventWithdrawSeized proto.InternalMessageInfo
+
+func (m *EventWithdrawSeized) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventWithdrawSeized) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *EventWithdrawSeized) GetBridgeContract() string {
+	if m != nil {
+		return m.BridgeContract
+	}
+	return ""
+}
+
+func (m *EventWithdrawSeized) GetBridgeChainId() string {
+	if m != nil {
+		return m.BridgeChainId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*IDSet)(nil), "gravity.v1.IDSet")
 	proto.RegisterType((*BatchFees)(nil), "gravity.v1.BatchFees")
 	proto.RegisterType((*EventWithdrawalReceived)(nil), "gravity.v1.EventWithdrawalReceived")
 	proto.RegisterType((*EventWithdrawCanceled)(nil), "gravity.v1.EventWithdrawCanceled")
+	proto.RegisterType((*EventWithdrawSeized)(nil), "gravity.v1.EventWithdrawSeized")
 }
 
 func init() { proto.RegisterFile("gravity/v1/pool.proto", fileDescriptor_18d107f7cfc31f22) }
 
 var fileDescriptor_18d107f7cfc31f22 = []byte{
-	// 426 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xce, 0x62, 0xa7, 0x90, 0x15, 0x14, 0xb4, 0xb4, 0xe0, 0x72, 0x70, 0xa3, 0x08, 0x95, 0x5c,
-	0x6a, 0xab, 0xe2, 0x01, 0x90, 0x12, 0x7e, 0x94, 0x03, 0x17, 0x83, 0x84, 0xe0, 0x62, 0x39, 0xbb,
-	0x83, 0xb3, 0xaa, 0xb3, 0x13, 0xd9, 0x13, 0xe3, 0x3e, 0x03, 0x17, 0x2e, 0xbc, 0x02, 0xcf, 0xd2,
-	0x63, 0x8f, 0x88, 0x43, 0x85, 0x92, 0x17, 0x41, 0xde, 0x75, 0xc5, 0x8f, 0x7a, 0xe0, 0xe4, 0xf9,
-	0x3e, 0x7f, 0x3b, 0xf3, 0xcd, 0x0f, 0xdf, 0xcf, 0xcb, 0xac, 0xd6, 0x74, 0x16, 0xd7, 0x27, 0xf1,
-	0x0a, 0xb1, 0x88, 0x56, 0x25, 0x12, 0x0a, 0xde, 0xd1, 0x51, 0x7d, 0xf2, 0x68, 0x2f, 0xc7, 0x1c,
-	0x2d, 0x1d, 0xb7, 0x91, 0x53, 0x8c, 0x0e, 0x78, 0x7f, 0xf6, 0xfc, 0x0d, 0x90, 0xb8, 0xc7, 0x3d,
-	0xad, 0xaa, 0x80, 0x0d, 0xbd, 0xb1, 0x9f, 0xb4, 0xe1, 0xe8, 0x33, 0xe3, 0x83, 0x49, 0x46, 0x72,
-	0xf1, 0x12, 0xa0, 0x12, 0x7b, 0xbc, 0x4f, 0x78, 0x0a, 0x26, 0x60, 0x43, 0x36, 0x1e, 0x24, 0x0e,
-	0x88, 0xd7, 0x9c, 0x13, 0x52, 0x56, 0xa4, 0x1f, 0x01, 0xaa, 0xe0, 0x46, 0xfb, 0x6b, 0x12, 0x9d,
-	0x5f, 0x1e, 0xf6, 0x7e, 0x5c, 0x1e, 0x1e, 0xe5, 0x9a, 0x16, 0xeb, 0x79, 0x24, 0x71, 0x19, 0x4b,
-	0xac, 0x96, 0x58, 0x75, 0x9f, 0xe3, 0x4a, 0x9d, 0xc6, 0x74, 0xb6, 0x82, 0x2a, 0x9a, 0x19, 0x4a,
-	0x06, 0x36, 0x83, 0x2d, 0x72, 0xc0, 0x6f, 0x51, 0x93, 0x4a, 0x5c, 0x1b, 0x0a, 0xbc, 0x21, 0x1b,
-	0xfb, 0xc9, 0x4d, 0x6a, 0xa6, 0x2d, 0x1c, 0x7d, 0x63, 0xfc, 0xe1, 0x8b, 0x1a, 0x0c, 0xbd, 0xd3,
-	0xb4, 0x50, 0x65, 0xf6, 0x29, 0x2b, 0x12, 0x90, 0xa0, 0x6b, 0x50, 0xe2, 0x09, 0xbf, 0x3b, 0x2f,
-	0xb5, 0xca, 0x21, 0x95, 0x68, 0xa8, 0xcc, 0x24, 0x75, 0x2e, 0x77, 0x1d, 0x3d, 0xed, 0x58, 0x71,
-	0xf4, 0x5b, 0xb8, 0xc8, 0xb4, 0x49, 0xb5, 0x72, 0x9e, 0x93, 0x3b, 0x9d, 0xb0, 0x65, 0x67, 0x4a,
-	0x3c, 0xe6, 0xbb, 0xb8, 0xa6, 0x1c, 0xb5, 0xc9, 0x53, 0x6a, 0x5a, 0x99, 0x67, 0x65, 0xb7, 0xaf,
-	0xd8, 0xb7, 0xcd, 0x4c, 0xb5, 0x23, 0x31, 0x68, 0x24, 0x04, 0xbe, 0x1b, 0x89, 0x05, 0xa3, 0xaf,
-	0x8c, 0xef, 0xff, 0x65, 0x74, 0x9a, 0x19, 0x09, 0x05, 0x28, 0xf1, 0x80, 0xef, 0x54, 0x60, 0x14,
-	0x94, 0x9d, 0xbb, 0x0e, 0x89, 0xfb, 0xbc, 0xef, 0x8a, 0x38, 0x2f, 0x3e, 0xb5, 0xc9, 0xaf, 0xe9,
-	0xc9, 0xfb, 0xdf, 0x9e, 0xfc, 0x6b, 0x7a, 0x9a, 0xbc, 0x3f, 0xdf, 0x84, 0xec, 0x62, 0x13, 0xb2,
-	0x9f, 0x9b, 0x90, 0x7d, 0xd9, 0x86, 0xbd, 0x8b, 0x6d, 0xd8, 0xfb, 0xbe, 0x0d, 0x7b, 0x1f, 0x9e,
-	0xfd, 0xb1, 0xa8, 0x57, 0xee, 0x60, 0x8e, 0x27, 0xf6, 0xed, 0xbf, 0x70, 0x89, 0x6a, 0x5d, 0x40,
-	0xdc, 0xc4, 0x57, 0xe7, 0x66, 0xb7, 0x38, 0xdf, 0xb1, 0xb7, 0xf4, 0xf4, 0x57, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xa0, 0x6e, 0x11, 0xe9, 0x86, 0x02, 0x00, 0x00,
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x53, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xcd, 0x60, 0xa7, 0x90, 0x11, 0x14, 0x34, 0x6d, 0xc1, 0x65, 0xe1, 0x46, 0x16, 0x2a, 0xd9,
+	0xd4, 0x56, 0xc5, 0x07, 0x20, 0x25, 0x3c, 0xe4, 0x05, 0x1b, 0x17, 0x09, 0xc1, 0xc6, 0x72, 0x66,
+	0x2e, 0xce, 0xa8, 0xce, 0xdc, 0xc8, 0xbe, 0x31, 0x2e, 0xbf, 0xc0, 0x06, 0x09, 0xf1, 0x0b, 0x7c,
+	0x4b, 0x97, 0x5d, 0x22, 0x16, 0x15, 0x4a, 0x7e, 0x04, 0xf9, 0x51, 0x95, 0xa2, 0x2e, 0xd8, 0x75,
+	0x35, 0xf7, 0x9c, 0x39, 0x33, 0x73, 0xee, 0xd5, 0x19, 0xbe, 0x93, 0xe6, 0x49, 0xa9, 0xe9, 0x24,
+	0x28, 0x0f, 0x83, 0x05, 0x62, 0xe6, 0x2f, 0x72, 0x24, 0x14, 0xbc, 0xa3, 0xfd, 0xf2, 0xf0, 0xf1,
+	0x76, 0x8a, 0x29, 0x36, 0x74, 0x50, 0x57, 0xad, 0xc2, 0xdb, 0xe5, 0xfd, 0xf0, 0xc5, 0x11, 0x90,
+	0x78, 0xc0, 0x2d, 0xad, 0x0a, 0x87, 0x0d, 0xad, 0x91, 0x1d, 0xd5, 0xa5, 0xf7, 0x85, 0xf1, 0xc1,
+	0x38, 0x21, 0x39, 0x7b, 0x05, 0x50, 0x88, 0x6d, 0xde, 0x27, 0x3c, 0x06, 0xe3, 0xb0, 0x21, 0x1b,
+	0x0d, 0xa2, 0x16, 0x88, 0x37, 0x9c, 0x13, 0x52, 0x92, 0xc5, 0x1f, 0x01, 0x0a, 0xe7, 0x56, 0xbd,
+	0x35, 0xf6, 0x4f, 0xcf, 0xf7, 0x7a, 0xbf, 0xce, 0xf7, 0xf6, 0x53, 0x4d, 0xb3, 0xe5, 0xd4, 0x97,
+	0x38, 0x0f, 0x24, 0x16, 0x73, 0x2c, 0xba, 0xe5, 0xa0, 0x50, 0xc7, 0x01, 0x9d, 0x2c, 0xa0, 0xf0,
+	0x43, 0x43, 0xd1, 0xa0, 0xb9, 0xa1, 0x79, 0x64, 0x97, 0xdf, 0xa1, 0x2a, 0x96, 0xb8, 0x34, 0xe4,
+	0x58, 0x43, 0x36, 0xb2, 0xa3, 0xdb, 0x54, 0x4d, 0x6a, 0xe8, 0xfd, 0x60, 0xfc, 0xd1, 0xcb, 0x12,
+	0x0c, 0xbd, 0xd3, 0x34, 0x53, 0x79, 0xf2, 0x29, 0xc9, 0x22, 0x90, 0xa0, 0x4b, 0x50, 0xe2, 0x29,
+	0xbf, 0x3f, 0xcd, 0xb5, 0x4a, 0x21, 0x96, 0x68, 0x28, 0x4f, 0x24, 0x75, 0x2e, 0x37, 0x5b, 0x7a,
+	0xd2, 0xb1, 0x62, 0xff, 0x52, 0x38, 0x4b, 0xb4, 0x89, 0xb5, 0x6a, 0x3d, 0x47, 0xf7, 0x3a, 0x61,
+	0xcd, 0x86, 0x4a, 0x3c, 0xe1, 0x9b, 0xb8, 0xa4, 0x14, 0xb5, 0x49, 0x63, 0xaa, 0x6a, 0x99, 0xd5,
+	0xc8, 0xee, 0x5e, 0xb0, 0x6f, 0xab, 0x50, 0xd5, 0x23, 0x31, 0x68, 0x24, 0x38, 0x76, 0x3b, 0x92,
+	0x06, 0x78, 0xdf, 0x19, 0xdf, 0xb9, 0x62, 0x74, 0x92, 0x18, 0x09, 0x19, 0x28, 0xf1, 0x90, 0x6f,
+	0x14, 0x60, 0x14, 0xe4, 0x9d, 0xbb, 0x0e, 0x89, 0x2d, 0xde, 0xa7, 0xea, 0xd2, 0x8b, 0x4d, 0x55,
+	0x78, 0x6d, 0x4f, 0xd6, 0xff, 0xf6, 0x64, 0x5f, 0xd3, 0x93, 0xf7, 0x8d, 0xf1, 0xad, 0x2b, 0xbe,
+	0x8e, 0x40, 0x7f, 0xbe, 0x69, 0x57, 0xe3, 0xf7, 0xa7, 0x2b, 0x97, 0x9d, 0xad, 0x5c, 0xf6, 0x7b,
+	0xe5, 0xb2, 0xaf, 0x6b, 0xb7, 0x77, 0xb6, 0x76, 0x7b, 0x3f, 0xd7, 0x6e, 0xef, 0xc3, 0xf3, 0xbf,
+	0xe2, 0xf3, 0xba, 0x8d, 0xf1, 0xc1, 0xb8, 0x39, 0xfb, 0x2f, 0x9c, 0xa3, 0x5a, 0x66, 0x10, 0x54,
+	0xc1, 0xc5, 0x27, 0x68, 0xb2, 0x35, 0xdd, 0x68, 0x12, 0xfe, 0xec, 0xcf, 0x00, 0xc8, 0xe3, 0x56,
+	0x90, 0x1c, 0x03, 0x00, 0x00,
 }
 
 func (m *IDSet) Marshal() (dAtA []byte, err error) {
@@ -486,6 +556,57 @@ func (m *EventWithdrawCanceled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventWithdrawSeized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWithdrawSeized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWithdrawSeized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BridgeChainId) > 0 {
+		i -= len(m.BridgeChainId)
+		copy(dAtA[i:], m.BridgeChainId)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.BridgeChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BridgeContract) > 0 {
+		i -= len(m.BridgeContract)
+		copy(dAtA[i:], m.BridgeContract)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.BridgeContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPool(v)
 	base := offset
@@ -581,6 +702,31 @@ func (m *EventWithdrawCanceled) Size() (n int) {
 	return n
 }
 
+func (m *EventWithdrawSeized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.BridgeContract)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.BridgeChainId)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	return n
+}
+
 func sovPool(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1204,6 +1350,184 @@ func (m *EventWithdrawCanceled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventWithdrawSeized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdrawSeized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdrawSeized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPool(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,8 @@ package types
 import (
 	"bytes"
 	"encoding/hex"
+	"math"
+	"math/big"
 	mrand "math/rand"
 	"testing"
 
@@ -229,3 +231,30 @@ func shuffled(v InternalBridgeValidators) InternalBridgeValidators {
 	})
 	return v
 }
+
+// benchmarkValset builds a valset of n members, about the size of the active set on mainnet
+func benchmarkValset(n int) Valset {
+	members := make([]BridgeValidator, n)
+	for i := range members {
+		members[i] = BridgeValidator{
+			Power:           uint64(math.MaxUint32 / n),
+			EthereumAddress: gethcommon.BigToAddress(big.NewInt(int64(i + 1))).Hex(),
+		}
+	}
+	return Valset{
+		Nonce:        1,
+		Members:      members,
+		Height:       1,
+		RewardAmount: sdk.ZeroInt(),
+		RewardToken:  "0x0000000000000000000000000000000000000000",
+	}
+}
+
+func BenchmarkValsetCheckpoint150Validators(b *testing.B) {
+	valset := benchmarkValset(150)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		valset.GetCheckpoint("foo")
+	}
+}
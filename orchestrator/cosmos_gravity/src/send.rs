@@ -10,6 +10,7 @@ use ethereum_gravity::message_signatures::{
     encode_logic_call_confirm, encode_tx_batch_confirm, encode_valset_confirm,
 };
 use gravity_proto::cosmos_sdk_proto::cosmos::base::abci::v1beta1::TxResponse;
+use gravity_proto::gravity::DelegateKeysSignMsg;
 use gravity_proto::gravity::MsgErc20DeployedClaim;
 use gravity_proto::gravity::MsgLogicCallExecutedClaim;
 use gravity_proto::gravity::MsgRequestBatch;
@@ -23,6 +24,7 @@ use gravity_proto::gravity::{MsgCancelSendToEth, MsgConfirmBatch};
 use gravity_proto::gravity::{MsgConfirmLogicCall, MsgExecuteIbcAutoForwards};
 use gravity_utils::num_conversion::downcast_uint256;
 use gravity_utils::types::*;
+use prost::Message;
 use std::{collections::HashMap, time::Duration};
 
 use crate::utils::BadSignatureEvidence;
@@ -47,15 +49,21 @@ pub const MSG_SUBMIT_BAD_SIGNATURE_EVIDENCE_TYPE_URL: &str =
 pub const MSG_CANCEL_SEND_TO_ETH_TYPE_URL: &str = "/gravity.v1.MsgCancelSendToEth";
 pub const MSG_EXECUTE_IBC_AUTO_FORWARDS_TYPE_URL: &str = "/gravity.v1.MsgExecuteIbcAutoForwards";
 
+/// the domain separator prepended to the encoded DelegateKeysSignMsg before hashing
+pub const DELEGATE_KEYS_SIGN_DOMAIN: &[u8] = b"gravity-delegate-keys";
+
 /// Send a transaction updating the eth address for the sending
 /// Cosmos address. The sending Cosmos address should be a validator
 /// this can only be called once! Key rotation code is possible but
 /// not currently implemented
+/// The delegate Ethereum key signs over the delegate keys to prove that
+/// the validator controls it
 pub async fn set_gravity_delegate_addresses(
     contact: &Contact,
-    delegate_eth_address: EthAddress,
+    delegate_eth_key: EthPrivateKey,
     delegate_cosmos_address: Address,
     private_key: PrivateKey,
+    gravity_id: String,
     fee: Coin,
 ) -> Result<TxResponse, CosmosGrpcError> {
     trace!("Updating Gravity Delegate addresses");
@@ -69,10 +77,26 @@ pub async fn set_gravity_delegate_addresses(
         .to_bech32(format!("{}valoper", contact.get_prefix()))
         .unwrap();
 
+    // delegate keys can only be set while the validator has none, so the
+    // delegate key nonce signed over is always zero
+    let sign_msg = DelegateKeysSignMsg {
+        gravity_id,
+        validator: our_valoper_address.to_string(),
+        orchestrator: delegate_cosmos_address.to_string(),
+        nonce: 0,
+    };
+    let message = [
+        DELEGATE_KEYS_SIGN_DOMAIN,
+        sign_msg.encode_to_vec().as_slice(),
+    ]
+    .concat();
+    let eth_signature = delegate_eth_key.sign_ethereum_msg(&message);
+
     let msg_set_orch_address = MsgSetOrchestratorAddress {
         validator: our_valoper_address.to_string(),
         orchestrator: delegate_cosmos_address.to_string(),
-        eth_address: delegate_eth_address.to_string(),
+        eth_address: delegate_eth_key.to_address().to_string(),
+        eth_signature: bytes_to_hex_str(&eth_signature.to_bytes()),
     };
 
     let msg = Msg::new(MSG_SET_ORCHESTRATOR_ADDRESS_TYPE_URL, msg_set_orch_address);
@@ -8,6 +8,7 @@ use crate::config::save_keys;
 use crate::config::KeyStorage;
 use crate::utils::TIMEOUT;
 use clarity::PrivateKey as EthPrivateKey;
+use cosmos_gravity::query::get_gravity_params;
 use cosmos_gravity::send::set_gravity_delegate_addresses;
 use deep_space::{mnemonic::Mnemonic, private_key::PrivateKey as CosmosPrivateKey};
 use gravity_utils::connection_prep::check_for_fee;
@@ -33,6 +34,7 @@ pub async fn register_orchestrator_address(
 
     let connections = create_rpc_connections(prefix, Some(cosmos_grpc), None, TIMEOUT).await;
     let contact = connections.contact.unwrap();
+    let mut grpc = connections.grpc.unwrap();
     wait_for_cosmos_node_ready(&contact).await;
 
     let validator_addr = validator_key.to_address(&contact.get_prefix()).unwrap();
@@ -78,13 +80,18 @@ pub async fn register_orchestrator_address(
         key.unwrap()
     };
 
-    let ethereum_address = ethereum_key.to_address();
+    // the delegate Ethereum key signs over the gravity id to prove possession
+    let params = get_gravity_params(&mut grpc)
+        .await
+        .expect("Failed to get Gravity Bridge module parameters!");
+
     let cosmos_address = cosmos_key.to_address(&contact.get_prefix()).unwrap();
     let res = set_gravity_delegate_addresses(
         &contact,
-        ethereum_address,
+        ethereum_key,
         cosmos_address,
         validator_key,
+        params.gravity_id,
         fee.clone(),
     )
     .await
@@ -134,6 +134,9 @@ pub struct PendingIbcAutoForward {
 /// ETH_ADDRESS
 /// This is a hex encoded 0x Ethereum public key that will be used by this validator
 /// on Ethereum
+/// ETH_SIGNATURE
+/// This is a hex encoded Ethereum signature by the ETH_ADDRESS key over the hash of
+/// a DelegateKeysSignMsg, proving that the validator controls the key
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgSetOrchestratorAddress {
     #[prost(string, tag="1")]
@@ -142,6 +145,26 @@ pub struct MsgSetOrchestratorAddress {
     pub orchestrator: ::prost::alloc::string::String,
     #[prost(string, tag="3")]
     pub eth_address: ::prost::alloc::string::String,
+    #[prost(string, tag="4")]
+    pub eth_signature: ::prost::alloc::string::String,
+}
+/// DelegateKeysSignMsg
+/// this is the payload an Ethereum delegate key signs to prove that the validator
+/// registering it controls the key. The signed hash is the keccak256 of the
+/// domain separator "gravity-delegate-keys" followed by the protobuf encoding of
+/// this message. The nonce is the delegate key nonce of the validator, which is
+/// incremented every time the validator's delegate keys are set, so that a
+/// signature can not be replayed
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DelegateKeysSignMsg {
+    #[prost(string, tag="1")]
+    pub gravity_id: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub orchestrator: ::prost::alloc::string::String,
+    #[prost(uint64, tag="4")]
+    pub nonce: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgSetOrchestratorAddressResponse {